@@ -0,0 +1,121 @@
+package morph
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap/nodevalidation/policy"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/unwrap"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	admissionPolicyFlag     = "policy"
+	admissionCandidatesFlag = "candidates"
+)
+
+func checkAdmissionPolicy(cmd *cobra.Command, _ []string) error {
+	p, err := policy.ReadFile(viper.GetString(admissionPolicyFlag))
+	if err != nil {
+		return err
+	}
+
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	inv := invoker.New(c, nil)
+
+	cs, err := c.GetContractStateByID(1)
+	if err != nil {
+		return fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	nmHash, err := nnsResolveHash(inv, cs.Hash, netmapContract+".frostfs")
+	if err != nil {
+		return fmt.Errorf("can't get netmap contract hash: %w", err)
+	}
+
+	netmapNodes, err := fetchNodes(inv, nmHash, "netmap")
+	if err != nil {
+		return err
+	}
+
+	// the network map is evaluated as if all its nodes entered it anew,
+	// candidates are evaluated the way the Inner Ring admits them
+	var members, nodes []netmap.NodeInfo
+	if candidates, _ := cmd.Flags().GetBool(admissionCandidatesFlag); candidates {
+		members = netmapNodes
+
+		nodes, err = fetchNodes(inv, nmHash, "netmapCandidates")
+		if err != nil {
+			return err
+		}
+	} else {
+		nodes = netmapNodes
+	}
+
+	res := p.Evaluate(members, nodes)
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	var rejected int
+
+	for i := range nodes {
+		status := "admitted"
+		if res[i] != nil {
+			status = "rejected: " + res[i].Error()
+			rejected++
+		}
+
+		_, _ = tw.Write([]byte(fmt.Sprintf("%s\t%s\t%s\n",
+			hex.EncodeToString(nodes[i].PublicKey()), nodes[i].LOCODE(), status)))
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+
+	if rejected != 0 {
+		return fmt.Errorf("%d of %d nodes are rejected by the admission policy", rejected, len(nodes))
+	}
+
+	cmd.Printf("All %d nodes are admitted by the admission policy.\n", len(nodes))
+
+	return nil
+}
+
+// fetchNodes returns the list of the storage nodes returned
+// by the netmap contract method.
+func fetchNodes(inv *invoker.Invoker, nmHash util.Uint160, method string) ([]netmap.NodeInfo, error) {
+	arr, err := unwrap.Array(inv.Call(nmHash, method))
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch the list of storage nodes: %w", err)
+	}
+
+	nodes := make([]netmap.NodeInfo, len(arr))
+	for i := range arr {
+		node, ok := arr[i].Value().([]stackitem.Item)
+		if !ok || len(node) == 0 {
+			return nil, errors.New("can't parse the list of storage nodes")
+		}
+		bs, err := node[0].TryBytes()
+		if err != nil {
+			return nil, errors.New("can't parse the list of storage nodes")
+		}
+		if err := nodes[i].Unmarshal(bs); err != nil {
+			return nil, fmt.Errorf("can't parse the list of storage nodes: %w", err)
+		}
+	}
+
+	return nodes, nil
+}
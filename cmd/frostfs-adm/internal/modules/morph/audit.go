@@ -0,0 +1,325 @@
+package morph
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/audit"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/unwrap"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	auditEpochFlag     = "epoch"
+	auditContainerFlag = "cid"
+	auditResultIDFlag  = "id"
+	auditJSONFlag      = "json"
+)
+
+var (
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect data audit results stored in the audit contract",
+	}
+
+	auditListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List data audit results of the epoch",
+		Long: `List data audit results written by the inner ring in the epoch
+(current epoch by default), optionally only the ones of the container.`,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: listAuditResults,
+	}
+
+	auditGetCmd = &cobra.Command{
+		Use:   "get",
+		Short: "Get data audit result with the storage groups and nodes passed or failed each check",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: getAuditResult,
+	}
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{auditListCmd, auditGetCmd} {
+		cmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+		cmd.Flags().Bool(auditJSONFlag, false, "Print results in JSON format")
+	}
+
+	auditListCmd.Flags().Uint64(auditEpochFlag, 0, "Epoch of the audit (current epoch if not set)")
+	auditListCmd.Flags().String(auditContainerFlag, "", "Container ID to list audit results of")
+
+	auditGetCmd.Flags().String(auditResultIDFlag, "", "Hex-encoded audit result ID")
+	_ = auditGetCmd.MarkFlagRequired(auditResultIDFlag)
+
+	auditCmd.AddCommand(auditListCmd, auditGetCmd)
+}
+
+// auditResultJSON is a JSON representation of the audit.Result.
+type auditResultJSON struct {
+	ID        string `json:"id"`
+	Epoch     uint64 `json:"epoch"`
+	Container string `json:"container"`
+	Auditor   string `json:"auditor"`
+	Completed bool   `json:"completed"`
+
+	PoR struct {
+		Requests uint32   `json:"requests"`
+		Retries  uint32   `json:"retries"`
+		Passed   []string `json:"passed_storage_groups"`
+		Failed   []string `json:"failed_storage_groups"`
+	} `json:"por"`
+
+	PoP struct {
+		Hits     uint32 `json:"hits"`
+		Misses   uint32 `json:"misses"`
+		Failures uint32 `json:"failures"`
+	} `json:"pop"`
+
+	PDP struct {
+		Passed []string `json:"passed_nodes"`
+		Failed []string `json:"failed_nodes"`
+	} `json:"pdp"`
+}
+
+func newAuditResultJSON(id []byte, r *audit.Result) auditResultJSON {
+	var res auditResultJSON
+
+	res.ID = hex.EncodeToString(id)
+	res.Epoch = r.Epoch()
+	if cnr, ok := r.Container(); ok {
+		res.Container = cnr.EncodeToString()
+	}
+	res.Auditor = hex.EncodeToString(r.AuditorKey())
+	res.Completed = r.Completed()
+
+	res.PoR.Requests = r.RequestsPoR()
+	res.PoR.Retries = r.RetriesPoR()
+	res.PoR.Passed = make([]string, 0)
+	r.IteratePassedStorageGroups(func(id oid.ID) bool {
+		res.PoR.Passed = append(res.PoR.Passed, id.EncodeToString())
+		return true
+	})
+	res.PoR.Failed = make([]string, 0)
+	r.IterateFailedStorageGroups(func(id oid.ID) bool {
+		res.PoR.Failed = append(res.PoR.Failed, id.EncodeToString())
+		return true
+	})
+
+	res.PoP.Hits = r.Hits()
+	res.PoP.Misses = r.Misses()
+	res.PoP.Failures = r.Failures()
+
+	res.PDP.Passed = make([]string, 0)
+	r.IteratePassedStorageNodes(func(key []byte) bool {
+		res.PDP.Passed = append(res.PDP.Passed, hex.EncodeToString(key))
+		return true
+	})
+	res.PDP.Failed = make([]string, 0)
+	r.IterateFailedStorageNodes(func(key []byte) bool {
+		res.PDP.Failed = append(res.PDP.Failed, hex.EncodeToString(key))
+		return true
+	})
+
+	return res
+}
+
+func listAuditResults(cmd *cobra.Command, _ []string) error {
+	inv, nnsHash, auditHash, err := newAuditInvoker()
+	if err != nil {
+		return err
+	}
+
+	epoch, _ := cmd.Flags().GetUint64(auditEpochFlag)
+	if !cmd.Flags().Changed(auditEpochFlag) {
+		nmHash, err := nnsResolveHash(inv, nnsHash, netmapContract+".frostfs")
+		if err != nil {
+			return fmt.Errorf("can't get netmap contract hash: %w", err)
+		}
+
+		curr, err := unwrap.Int64(inv.Call(nmHash, "epoch"))
+		if err != nil {
+			return fmt.Errorf("can't fetch current epoch from the netmap contract: %w", err)
+		}
+
+		epoch = uint64(curr)
+	}
+
+	var ids [][]byte
+
+	if s, _ := cmd.Flags().GetString(auditContainerFlag); s != "" {
+		var cnr cid.ID
+		if err := cnr.DecodeString(s); err != nil {
+			return fmt.Errorf("invalid container ID: %w", err)
+		}
+
+		binCnr := make([]byte, sha256.Size)
+		cnr.Encode(binCnr)
+
+		ids, err = unwrap.ArrayOfBytes(inv.Call(auditHash, "listByCID", epoch, binCnr))
+	} else {
+		ids, err = unwrap.ArrayOfBytes(inv.Call(auditHash, "listByEpoch", epoch))
+	}
+	if err != nil {
+		return fmt.Errorf("can't fetch the list of audit results: %w", err)
+	}
+
+	results := make([]auditResultJSON, len(ids))
+	for i := range ids {
+		r, err := fetchAuditResult(inv, auditHash, ids[i])
+		if err != nil {
+			return fmt.Errorf("can't fetch audit result %s: %w", hex.EncodeToString(ids[i]), err)
+		}
+
+		results[i] = newAuditResultJSON(ids[i], r)
+	}
+
+	if jsonOut, _ := cmd.Flags().GetBool(auditJSONFlag); jsonOut {
+		return printJSON(cmd, results)
+	}
+
+	printAuditResults(cmd, epoch, results)
+
+	return nil
+}
+
+func getAuditResult(cmd *cobra.Command, _ []string) error {
+	s, _ := cmd.Flags().GetString(auditResultIDFlag)
+
+	id, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid audit result ID: %w", err)
+	}
+
+	inv, _, auditHash, err := newAuditInvoker()
+	if err != nil {
+		return err
+	}
+
+	r, err := fetchAuditResult(inv, auditHash, id)
+	if err != nil {
+		return fmt.Errorf("can't fetch audit result %s: %w", s, err)
+	}
+
+	res := newAuditResultJSON(id, r)
+
+	if jsonOut, _ := cmd.Flags().GetBool(auditJSONFlag); jsonOut {
+		return printJSON(cmd, res)
+	}
+
+	printAuditResult(cmd, res)
+
+	return nil
+}
+
+// printAuditResults prints the table of the epoch audit results.
+func printAuditResults(cmd *cobra.Command, epoch uint64, results []auditResultJSON) {
+	if len(results) == 0 {
+		cmd.Printf("No audit results in epoch %d.\n", epoch)
+		return
+	}
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	_, _ = tw.Write([]byte("ID\tContainer\tCompleted\tPoR passed/failed\tPoP hits/misses/failures\tPDP passed/failed\n"))
+	for _, r := range results {
+		_, _ = tw.Write([]byte(fmt.Sprintf("%s\t%s\t%t\t%d/%d\t%d/%d/%d\t%d/%d\n",
+			r.ID, r.Container, r.Completed,
+			len(r.PoR.Passed), len(r.PoR.Failed),
+			r.PoP.Hits, r.PoP.Misses, r.PoP.Failures,
+			len(r.PDP.Passed), len(r.PDP.Failed))))
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+}
+
+// printAuditResult prints the audit result with the lists
+// of the checked storage groups and nodes.
+func printAuditResult(cmd *cobra.Command, res auditResultJSON) {
+	cmd.Printf("ID: %s\n", res.ID)
+	cmd.Printf("Epoch: %d\n", res.Epoch)
+	cmd.Printf("Container: %s\n", res.Container)
+	cmd.Printf("Auditor: %s\n", res.Auditor)
+	cmd.Printf("Completed: %t\n", res.Completed)
+
+	cmd.Printf("PoR: %d requests, %d retries\n", res.PoR.Requests, res.PoR.Retries)
+	printAuditList(cmd, "passed storage groups", res.PoR.Passed)
+	printAuditList(cmd, "failed storage groups", res.PoR.Failed)
+
+	cmd.Printf("PoP: %d hits, %d misses, %d failures\n", res.PoP.Hits, res.PoP.Misses, res.PoP.Failures)
+
+	cmd.Println("PDP:")
+	printAuditList(cmd, "passed nodes", res.PDP.Passed)
+	printAuditList(cmd, "failed nodes", res.PDP.Failed)
+}
+
+func printAuditList(cmd *cobra.Command, name string, list []string) {
+	cmd.Printf("  %s (%d):\n", name, len(list))
+	for i := range list {
+		cmd.Printf("    %s\n", list[i])
+	}
+}
+
+func printJSON(cmd *cobra.Command, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't encode JSON: %w", err)
+	}
+
+	cmd.Println(string(data))
+
+	return nil
+}
+
+// newAuditInvoker returns the read-only invoker connected to the endpoint
+// from the flag, the NNS contract hash and the audit contract hash
+// resolved via NNS.
+func newAuditInvoker() (*invoker.Invoker, util.Uint160, util.Uint160, error) {
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return nil, util.Uint160{}, util.Uint160{}, fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	inv := invoker.New(c, nil)
+
+	cs, err := c.GetContractStateByID(1)
+	if err != nil {
+		return nil, util.Uint160{}, util.Uint160{}, fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	auditHash, err := nnsResolveHash(inv, cs.Hash, auditContract+".frostfs")
+	if err != nil {
+		return nil, util.Uint160{}, util.Uint160{}, fmt.Errorf("can't get audit contract hash: %w", err)
+	}
+
+	return inv, cs.Hash, auditHash, nil
+}
+
+// fetchAuditResult returns the audit result stored in the audit contract.
+func fetchAuditResult(inv *invoker.Invoker, auditHash util.Uint160, id []byte) (*audit.Result, error) {
+	data, err := unwrap.Bytes(inv.Call(auditHash, "get", id))
+	if err != nil {
+		return nil, err
+	}
+
+	var r audit.Result
+	if err := r.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("can't decode audit result: %w", err)
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,150 @@
+package morph
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/audit"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditResultJSON(t *testing.T) {
+	id := []byte{1, 2, 3}
+
+	t.Run("empty", func(t *testing.T) {
+		res := newAuditResultJSON(id, new(audit.Result))
+
+		require.Equal(t, "010203", res.ID)
+		require.Empty(t, res.Container)
+		require.False(t, res.Completed)
+
+		// lists are encoded as empty arrays, not nulls
+		data, err := json.Marshal(res)
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "null")
+	})
+
+	t.Run("full", func(t *testing.T) {
+		cnr := cidtest.ID()
+		sgPassed, sgFailed := oidtest.ID(), oidtest.ID()
+		auditor := []byte{4, 5}
+		nodePassed, nodeFailed := []byte{6}, []byte{7, 8}
+
+		var r audit.Result
+		r.ForEpoch(13)
+		r.ForContainer(cnr)
+		r.SetAuditorKey(auditor)
+		r.Complete()
+		r.SetRequestsPoR(10)
+		r.SetRetriesPoR(2)
+		r.SubmitPassedStorageGroup(sgPassed)
+		r.SubmitFailedStorageGroup(sgFailed)
+		r.SetHits(5)
+		r.SetMisses(1)
+		r.SetFailures(3)
+		r.SubmitPassedStorageNodes([][]byte{nodePassed})
+		r.SubmitFailedStorageNodes([][]byte{nodeFailed})
+
+		res := newAuditResultJSON(id, &r)
+
+		require.Equal(t, "010203", res.ID)
+		require.EqualValues(t, 13, res.Epoch)
+		require.Equal(t, cnr.EncodeToString(), res.Container)
+		require.Equal(t, hex.EncodeToString(auditor), res.Auditor)
+		require.True(t, res.Completed)
+		require.EqualValues(t, 10, res.PoR.Requests)
+		require.EqualValues(t, 2, res.PoR.Retries)
+		require.Equal(t, []string{sgPassed.EncodeToString()}, res.PoR.Passed)
+		require.Equal(t, []string{sgFailed.EncodeToString()}, res.PoR.Failed)
+		require.EqualValues(t, 5, res.PoP.Hits)
+		require.EqualValues(t, 1, res.PoP.Misses)
+		require.EqualValues(t, 3, res.PoP.Failures)
+		require.Equal(t, []string{"06"}, res.PDP.Passed)
+		require.Equal(t, []string{"0708"}, res.PDP.Failed)
+	})
+}
+
+func TestPrintAuditResults(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		cmd, buf := newAuditTestCommand()
+
+		printAuditResults(cmd, 7, nil)
+		require.Equal(t, "No audit results in epoch 7.\n", buf.String())
+	})
+
+	t.Run("table", func(t *testing.T) {
+		cmd, buf := newAuditTestCommand()
+
+		var res auditResultJSON
+		res.ID = "0102"
+		res.Container = "cnr"
+		res.Completed = true
+		res.PoR.Passed = []string{"a", "b"}
+		res.PoR.Failed = []string{"c"}
+		res.PoP.Hits = 4
+		res.PoP.Misses = 5
+		res.PoP.Failures = 6
+		res.PDP.Failed = []string{"d"}
+
+		printAuditResults(cmd, 7, []auditResultJSON{res})
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Len(t, lines, 2)
+		require.Equal(t, []string{"ID", "Container", "Completed", "PoR", "passed/failed",
+			"PoP", "hits/misses/failures", "PDP", "passed/failed"}, strings.Fields(lines[0]))
+		require.Equal(t, []string{"0102", "cnr", "true", "2/1", "4/5/6", "0/1"}, strings.Fields(lines[1]))
+	})
+}
+
+func TestPrintAuditResult(t *testing.T) {
+	cmd, buf := newAuditTestCommand()
+
+	var res auditResultJSON
+	res.ID = "0102"
+	res.Epoch = 7
+	res.Container = "cnr"
+	res.Auditor = "03"
+	res.PoR.Requests = 1
+	res.PoR.Retries = 2
+	res.PoR.Passed = []string{"a", "b"}
+	res.PoR.Failed = []string{}
+	res.PoP.Hits = 4
+	res.PoP.Misses = 5
+	res.PoP.Failures = 6
+	res.PDP.Passed = []string{}
+	res.PDP.Failed = []string{"d"}
+
+	printAuditResult(cmd, res)
+
+	require.Equal(t, `ID: 0102
+Epoch: 7
+Container: cnr
+Auditor: 03
+Completed: false
+PoR: 1 requests, 2 retries
+  passed storage groups (2):
+    a
+    b
+  failed storage groups (0):
+PoP: 4 hits, 5 misses, 6 failures
+PDP:
+  passed nodes (0):
+  failed nodes (1):
+    d
+`, buf.String())
+}
+
+func newAuditTestCommand() (*cobra.Command, *bytes.Buffer) {
+	buf := bytes.NewBuffer(nil)
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	return cmd, buf
+}
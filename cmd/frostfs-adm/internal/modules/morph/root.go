@@ -180,6 +180,19 @@ var (
 		RunE: dumpBalances,
 	}
 
+	checkAdmissionPolicyCmd = &cobra.Command{
+		Use:   "check-admission-policy",
+		Short: "Evaluate the current network map against the node admission policy",
+		Long: `Evaluate the current network map against the node admission policy
+before setting it in the inner ring configuration. Lists the nodes which
+would be rejected by the policy and fails if there is at least one of them.`,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = viper.BindPFlag(admissionPolicyFlag, cmd.Flags().Lookup(admissionPolicyFlag))
+		},
+		RunE: checkAdmissionPolicy,
+	}
+
 	updateContractsCmd = &cobra.Command{
 		Use:   "update-contracts",
 		Short: "Update NeoFS contracts",
@@ -286,6 +299,12 @@ func init() {
 	dumpBalancesCmd.Flags().BoolP(dumpBalancesProxyFlag, "p", false, "Dump balances of the proxy contract")
 	dumpBalancesCmd.Flags().Bool(dumpBalancesUseScriptHashFlag, false, "Use script-hash format for addresses")
 
+	RootCmd.AddCommand(checkAdmissionPolicyCmd)
+	checkAdmissionPolicyCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	checkAdmissionPolicyCmd.Flags().String(admissionPolicyFlag, "", "Path to the node admission policy file")
+	checkAdmissionPolicyCmd.Flags().Bool(admissionCandidatesFlag, false, "Evaluate the network map candidates instead of the current network map")
+	_ = checkAdmissionPolicyCmd.MarkFlagRequired(admissionPolicyFlag)
+
 	RootCmd.AddCommand(updateContractsCmd)
 	updateContractsCmd.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
 	updateContractsCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
@@ -317,6 +336,8 @@ func init() {
 
 	RootCmd.AddCommand(cmdSubnet)
 
+	RootCmd.AddCommand(auditCmd)
+
 	RootCmd.AddCommand(depositNotaryCmd)
 	depositNotaryCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
 	depositNotaryCmd.Flags().String(storageWalletFlag, "", "Path to storage node wallet")
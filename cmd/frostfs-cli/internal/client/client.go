@@ -3,10 +3,13 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 
+	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
 	"github.com/TrueCloudLab/frostfs-sdk-go/accounting"
 	"github.com/TrueCloudLab/frostfs-sdk-go/client"
 	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
@@ -640,6 +643,12 @@ type SearchObjectsPrm struct {
 	containerIDPrm
 
 	filters object.SearchFilters
+
+	key *ecdsa.PrivateKey
+
+	limit  int
+	cursor string
+	attrs  []string
 }
 
 // SetFilters sets search filters.
@@ -647,9 +656,34 @@ func (x *SearchObjectsPrm) SetFilters(filters object.SearchFilters) {
 	x.filters = filters
 }
 
+// SetPrivateKey sets the key to sign the request with when the operation
+// can't be performed by the SDK client. Defaults to the client key otherwise.
+func (x *SearchObjectsPrm) SetPrivateKey(key *ecdsa.PrivateKey) {
+	x.key = key
+}
+
+// SetLimit sets the maximum number of the returned identifiers.
+// Zero means no limit.
+func (x *SearchObjectsPrm) SetLimit(limit int) {
+	x.limit = limit
+}
+
+// SetCursor sets the cursor returned with the previous page.
+func (x *SearchObjectsPrm) SetCursor(cursor string) {
+	x.cursor = cursor
+}
+
+// SetAttributes sets the keys of the attributes which values
+// must be returned with the identifiers.
+func (x *SearchObjectsPrm) SetAttributes(keys []string) {
+	x.attrs = keys
+}
+
 // SearchObjectsRes groups the resulting values of SearchObjects operation.
 type SearchObjectsRes struct {
 	ids []oid.ID
+
+	attrs [][]*string
 }
 
 // IDList returns identifiers of the matched objects.
@@ -657,10 +691,48 @@ func (x SearchObjectsRes) IDList() []oid.ID {
 	return x.ids
 }
 
+// AttributeList returns the values of the requested attributes of the
+// objects from IDList in the same order. The list is nil if no attributes
+// were requested, the values of an object are nil if the node didn't return
+// them, the value is nil if the object has no such attribute.
+func (x SearchObjectsRes) AttributeList() [][]*string {
+	return x.attrs
+}
+
+// NextCursor returns the cursor to request the next page or an empty
+// string if there are no more objects.
+func (x SearchObjectsRes) NextCursor(limit int) string {
+	if limit <= 0 || len(x.ids) < limit {
+		return ""
+	}
+	return searchsvc.CursorAfter(x.ids[len(x.ids)-1])
+}
+
 // SearchObjects selects objects from the container which match the filters.
 //
 // Returns any error which prevented the operation from completing correctly in error return.
 func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
+	xHeaders := make([]string, len(prm.xHeaders), len(prm.xHeaders)+4+2*len(prm.attrs))
+	copy(xHeaders, prm.xHeaders)
+
+	if prm.limit > 0 {
+		xHeaders = append(xHeaders, searchsvc.XHeaderLimit, strconv.Itoa(prm.limit))
+	}
+
+	if prm.cursor != "" {
+		xHeaders = append(xHeaders, searchsvc.XHeaderCursor, prm.cursor)
+	}
+
+	for i := range prm.attrs {
+		xHeaders = append(xHeaders, searchsvc.XHeaderAttribute, prm.attrs[i])
+	}
+
+	if len(prm.attrs) != 0 || hasNumericFilters(prm.filters) {
+		// SDK client neither keeps numeric filters nor provides
+		// response X-headers, so the request is made manually
+		return searchObjectsRaw(prm, xHeaders)
+	}
+
 	var cliPrm client.PrmObjectSearch
 	cliPrm.InContainer(prm.cnrID)
 	cliPrm.SetFilters(prm.filters)
@@ -677,7 +749,7 @@ func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
 		cliPrm.MarkLocal()
 	}
 
-	cliPrm.WithXHeaders(prm.xHeaders...)
+	cliPrm.WithXHeaders(xHeaders...)
 
 	rdr, err := prm.cli.ObjectSearchInit(context.Background(), cliPrm)
 	if err != nil {
@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/acl"
+	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	rpcapi "github.com/TrueCloudLab/frostfs-api-go/v2/rpc"
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	v2session "github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/signature"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/version"
+)
+
+var errMissingSearchKey = errors.New("private key is required for the extended search")
+
+func hasNumericFilters(fs object.SearchFilters) bool {
+	for i := range fs {
+		if objectcore.IsNumericMatch(fs[i].Operation()) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchObjectsRaw performs the search request through the raw protocol client.
+// Unlike the SDK client, it keeps numeric filters and reads the attribute
+// values from the response meta headers.
+func searchObjectsRaw(prm SearchObjectsPrm, xHeaders []string) (*SearchObjectsRes, error) {
+	if prm.key == nil {
+		return nil, errMissingSearchKey
+	}
+
+	var cidV2 refs.ContainerID
+	prm.cnrID.WriteToV2(&cidV2)
+
+	var body v2object.SearchRequestBody
+	body.SetVersion(1)
+	body.SetContainerID(&cidV2)
+	body.SetFilters(objectcore.SearchFiltersToV2(prm.filters))
+
+	var verV2 refs.Version
+	version.Current().WriteToV2(&verV2)
+
+	var meta v2session.RequestMetaHeader
+	meta.SetVersion(&verV2)
+	meta.SetTTL(2)
+	if prm.local {
+		meta.SetTTL(1)
+	}
+
+	if prm.sessionToken != nil {
+		var tokV2 v2session.Token
+		prm.sessionToken.WriteToV2(&tokV2)
+		meta.SetSessionToken(&tokV2)
+	}
+
+	if prm.bearerToken != nil {
+		var tokV2 acl.BearerToken
+		prm.bearerToken.WriteToV2(&tokV2)
+		meta.SetBearerToken(&tokV2)
+	}
+
+	xs := make([]v2session.XHeader, len(xHeaders)/2)
+	for i := range xs {
+		xs[i].SetKey(xHeaders[2*i])
+		xs[i].SetValue(xHeaders[2*i+1])
+	}
+	meta.SetXHeaders(xs)
+
+	var req v2object.SearchRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(&meta)
+
+	err := signature.SignServiceMessage(prm.key, &req)
+	if err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+
+	var res SearchObjectsRes
+
+	err = prm.cli.ExecRaw(func(c *rawclient.Client) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := rpcapi.SearchObjects(c, &req, rawclient.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("open stream: %w", err)
+		}
+
+		for {
+			var resp v2object.SearchResponse
+			if err := stream.Read(&resp); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return fmt.Errorf("read object list: %w", err)
+			}
+
+			if err := signature.VerifyServiceMessage(&resp); err != nil {
+				return fmt.Errorf("invalid response signature: %w", err)
+			}
+
+			st := apistatus.FromStatusV2(resp.GetMetaHeader().GetStatus())
+			if err := apistatus.ErrFromStatus(st); err != nil {
+				return err
+			}
+
+			idsV2 := resp.GetBody().GetIDList()
+			for i := range idsV2 {
+				var id oid.ID
+				if err := id.ReadFromV2(idsV2[i]); err != nil {
+					return fmt.Errorf("invalid object ID in the response: %w", err)
+				}
+				res.ids = append(res.ids, id)
+			}
+
+			if len(prm.attrs) != 0 {
+				vals, err := searchsvc.AttributeValuesFromMeta(resp.GetMetaHeader(), len(idsV2), len(prm.attrs))
+				if err != nil {
+					return err
+				}
+				res.attrs = append(res.attrs, vals...)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -17,11 +17,15 @@ import (
 // flags of list-object command.
 const (
 	flagListObjectPrintAttr = "with-attr"
+	flagListObjectsLimit    = "limit"
+	flagListObjectsCursor   = "cursor"
 )
 
 // flag vars of list-objects command.
 var (
 	flagVarListObjectsPrintAttr bool
+	flagVarListObjectsLimit     int
+	flagVarListObjectsCursor    string
 )
 
 var listContainerObjectsCmd = &cobra.Command{
@@ -50,6 +54,8 @@ var listContainerObjectsCmd = &cobra.Command{
 
 		prmSearch.SetContainerID(id)
 		prmSearch.SetFilters(*filters)
+		prmSearch.SetLimit(flagVarListObjectsLimit)
+		prmSearch.SetCursor(flagVarListObjectsCursor)
 
 		res, err := internalclient.SearchObjects(prmSearch)
 		common.ExitOnErr(cmd, "rpc error: %w", err)
@@ -80,6 +86,10 @@ var listContainerObjectsCmd = &cobra.Command{
 				}
 			}
 		}
+
+		if next := res.NextCursor(flagVarListObjectsLimit); next != "" {
+			cmd.Printf("Next cursor: %s\n", next)
+		}
 	},
 }
 
@@ -93,4 +103,10 @@ func initContainerListObjectsCmd() {
 	flags.BoolVar(&flagVarListObjectsPrintAttr, flagListObjectPrintAttr, false,
 		"Request and print user attributes of each object",
 	)
+	flags.IntVar(&flagVarListObjectsLimit, flagListObjectsLimit, 0,
+		"Maximum number of objects to list, all objects if zero",
+	)
+	flags.StringVar(&flagVarListObjectsCursor, flagListObjectsCursor, "",
+		"Cursor printed with the previous page of objects",
+	)
 }
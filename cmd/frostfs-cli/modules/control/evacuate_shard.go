@@ -35,6 +35,7 @@ func evacuateShard(cmd *cobra.Command, _ []string) {
 	common.ExitOnErr(cmd, "rpc error: %w", err)
 
 	cmd.Printf("Objects moved: %d\n", resp.GetBody().GetCount())
+	cmd.Printf("Trees moved: %d\n", resp.GetBody().GetTreeCount())
 
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
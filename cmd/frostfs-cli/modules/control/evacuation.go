@@ -0,0 +1,206 @@
+package control
+
+import (
+	"crypto/ecdsa"
+	"time"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/TrueCloudLab/frostfs-sdk-go/client"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+const (
+	evacuationJobIDFlag = "job-id"
+	evacuationAwaitFlag = "await"
+)
+
+var evacuationCmd = &cobra.Command{
+	Use:   "evacuation",
+	Short: "Evacuate objects from shards in background",
+	Long:  "Manage background evacuation of objects from shards to other shards",
+}
+
+var evacuationStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start shard evacuation",
+	Long:  "Start background evacuation of objects from shards to other shards",
+	Run:   evacuationStart,
+}
+
+var evacuationStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get shard evacuation status",
+	Long:  "Get the progress of the current (or the last) shard evacuation",
+	Run:   evacuationStatus,
+}
+
+var evacuationStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop shard evacuation",
+	Long:  "Stop the running shard evacuation, already evacuated objects are kept",
+	Run:   evacuationStop,
+}
+
+func initControlEvacuationCmd() {
+	evacuationCmd.AddCommand(evacuationStartCmd)
+	evacuationCmd.AddCommand(evacuationStatusCmd)
+	evacuationCmd.AddCommand(evacuationStopCmd)
+
+	initControlFlags(evacuationStartCmd)
+	initControlFlags(evacuationStatusCmd)
+	initControlFlags(evacuationStopCmd)
+
+	flags := evacuationStartCmd.Flags()
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(shardAllFlag, false, "Process all shards")
+	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Bool(evacuationAwaitFlag, false, "Wait for the evacuation to finish and print the progress")
+
+	evacuationStartCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+
+	evacuationStatusCmd.Flags().String(evacuationJobIDFlag, "", "Evacuation identifier, the current (or the last) one if empty")
+	evacuationStopCmd.Flags().String(evacuationJobIDFlag, "", "Evacuation identifier, the current one if empty")
+}
+
+func evacuationStart(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StartShardEvacuationRequest{Body: new(control.StartShardEvacuationRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartShardEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.StartShardEvacuation(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	jobID := resp.GetBody().GetJobId()
+	cmd.Printf("Shard evacuation has been started, ID: %s\n", jobID)
+
+	if await, _ := cmd.Flags().GetBool(evacuationAwaitFlag); !await {
+		return
+	}
+
+	for {
+		time.Sleep(time.Second)
+
+		body := getEvacuationStatus(cmd, pk, cli, jobID)
+		if body.GetState() != control.ShardEvacuationState_EVACUATION_RUNNING {
+			printEvacuationStatus(cmd, body)
+			return
+		}
+
+		cmd.Printf("Progress: %d/%d evacuated, %d failed, ETA: %s\n",
+			body.GetEvacuated(), body.GetTotal(), body.GetFailed(), evacuationETA(body))
+	}
+}
+
+func evacuationStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	cli := getClient(cmd, pk)
+
+	jobID, _ := cmd.Flags().GetString(evacuationJobIDFlag)
+
+	printEvacuationStatus(cmd, getEvacuationStatus(cmd, pk, cli, jobID))
+}
+
+func evacuationStop(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StopShardEvacuationRequest{Body: new(control.StopShardEvacuationRequest_Body)}
+	req.Body.JobId, _ = cmd.Flags().GetString(evacuationJobIDFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StopShardEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.StopShardEvacuation(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard evacuation has been stopped.")
+}
+
+func getEvacuationStatus(cmd *cobra.Command, pk *ecdsa.PrivateKey, cli *client.Client, jobID string) *control.GetShardEvacuationStatusResponse_Body {
+	req := &control.GetShardEvacuationStatusRequest{Body: new(control.GetShardEvacuationStatusRequest_Body)}
+	req.Body.JobId = jobID
+
+	signRequest(cmd, pk, req)
+
+	var resp *control.GetShardEvacuationStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetShardEvacuationStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	return resp.GetBody()
+}
+
+func printEvacuationStatus(cmd *cobra.Command, body *control.GetShardEvacuationStatusResponse_Body) {
+	var state string
+	switch body.GetState() {
+	case control.ShardEvacuationState_EVACUATION_RUNNING:
+		state = "running"
+	case control.ShardEvacuationState_EVACUATION_COMPLETED:
+		state = "completed"
+	case control.ShardEvacuationState_EVACUATION_FAILED:
+		state = "failed"
+	case control.ShardEvacuationState_EVACUATION_CANCELLED:
+		state = "cancelled"
+	case control.ShardEvacuationState_EVACUATION_INTERRUPTED:
+		state = "interrupted"
+	default:
+		state = "undefined"
+	}
+
+	cmd.Printf("ID: %s\n", body.GetJobId())
+	cmd.Printf("State: %s\n", state)
+	for _, id := range body.GetShard_ID() {
+		cmd.Printf("Shard: %s\n", base58.Encode(id))
+	}
+	if body.GetStartedAt() != 0 {
+		cmd.Printf("Started at: %s\n", time.Unix(body.GetStartedAt(), 0).Format(time.RFC3339))
+	}
+	if body.GetFinishedAt() != 0 {
+		cmd.Printf("Finished at: %s\n", time.Unix(body.GetFinishedAt(), 0).Format(time.RFC3339))
+	}
+	cmd.Printf("Objects: %d total, %d evacuated, %d failed\n",
+		body.GetTotal(), body.GetEvacuated(), body.GetFailed())
+	cmd.Printf("Trees evacuated: %d\n", body.GetTreesEvacuated())
+	if body.GetState() == control.ShardEvacuationState_EVACUATION_RUNNING {
+		cmd.Printf("ETA: %s\n", evacuationETA(body))
+	}
+	if body.GetError() != "" {
+		cmd.Printf("Error: %s\n", body.GetError())
+	}
+}
+
+func evacuationETA(body *control.GetShardEvacuationStatusResponse_Body) string {
+	if body.GetEtaSeconds() == 0 {
+		return "unknown"
+	}
+	return (time.Duration(body.GetEtaSeconds()) * time.Second).String()
+}
@@ -0,0 +1,56 @@
+package control
+
+import (
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	ircontrol "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	ircontrolsrv "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir/server"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var listNotaryRequestsCmd = &cobra.Command{
+	Use:   "list-notary-requests",
+	Short: "List pending notary requests seen by the inner ring node",
+	Long:  "List side chain notary requests handled by the inner ring node which main transactions haven't expired yet",
+	Run:   listNotaryRequests,
+}
+
+func initControlIRListNotaryRequestsCmd() {
+	initControlFlags(listNotaryRequestsCmd)
+}
+
+func listNotaryRequests(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	req := new(ircontrol.ListNotaryRequestsRequest)
+	req.SetBody(new(ircontrol.ListNotaryRequestsRequest_Body))
+
+	err := ircontrolsrv.SignMessage(pk, req)
+	common.ExitOnErr(cmd, "could not sign request: %w", err)
+
+	var resp *ircontrol.ListNotaryRequestsResponse
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.ListNotaryRequests(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	reqs := resp.GetBody().GetRequests()
+
+	cmd.Printf("Found %d notary requests.\n", len(reqs))
+	for _, r := range reqs {
+		hash, err := util.Uint256DecodeBytesBE(r.GetHash())
+		common.ExitOnErr(cmd, "invalid transaction hash: %w", err)
+
+		contract, err := util.Uint160DecodeBytesBE(r.GetContract())
+		common.ExitOnErr(cmd, "invalid contract script hash: %w", err)
+
+		cmd.Printf("%s:\n\tContract: %s\n\tMethod: %s\n\tValid until block: %d\n\tSigned: %t\n",
+			hash.StringLE(), contract.StringLE(), r.GetMethod(), r.GetValidUntilBlock(), r.GetSigned())
+	}
+}
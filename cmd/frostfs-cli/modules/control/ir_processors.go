@@ -0,0 +1,118 @@
+package control
+
+import (
+	"fmt"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	ircontrol "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	ircontrolsrv "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	processorNameFlag   = "name"
+	processorStatusFlag = "status"
+
+	processorStatusEnabled  = "enabled"
+	processorStatusDisabled = "disabled"
+)
+
+var listProcessorsCmd = &cobra.Command{
+	Use:   "list-processors",
+	Short: "List event processors of the inner ring node",
+	Long:  "List event processors of the inner ring node with their status and worker pool load",
+	Run:   listProcessors,
+}
+
+var setProcessorStatusCmd = &cobra.Command{
+	Use:   "set-processor-status",
+	Short: "Enable or disable the event processor of the inner ring node",
+	Long: "Enable or disable the event processor of the inner ring node. " +
+		"Events received by the disabled processor are dropped. Only audit, settlement " +
+		"and reputation processors can be disabled.",
+	Run: setProcessorStatus,
+}
+
+func initControlIRListProcessorsCmd() {
+	initControlFlags(listProcessorsCmd)
+}
+
+func initControlIRSetProcessorStatusCmd() {
+	initControlFlags(setProcessorStatusCmd)
+
+	flags := setProcessorStatusCmd.Flags()
+	flags.String(processorNameFlag, "", "Name of the processor")
+	flags.String(processorStatusFlag, "",
+		fmt.Sprintf("New processor status ('%s', '%s')", processorStatusEnabled, processorStatusDisabled))
+
+	_ = setProcessorStatusCmd.MarkFlagRequired(processorNameFlag)
+	_ = setProcessorStatusCmd.MarkFlagRequired(processorStatusFlag)
+}
+
+func listProcessors(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	req := new(ircontrol.ListProcessorsRequest)
+	req.SetBody(new(ircontrol.ListProcessorsRequest_Body))
+
+	err := ircontrolsrv.SignMessage(pk, req)
+	common.ExitOnErr(cmd, "could not sign request: %w", err)
+
+	var resp *ircontrol.ListProcessorsResponse
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.ListProcessors(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	for _, p := range resp.GetBody().GetProcessors() {
+		st := processorStatusEnabled
+		if !p.GetEnabled() {
+			st = processorStatusDisabled
+		}
+
+		cmd.Printf("%s:\n\tStatus: %s\n\tSwitchable: %t\n\tWorkers busy: %d/%d\n\tQueue size: %d\n",
+			p.GetName(), st, p.GetSwitchable(), p.GetPoolRunning(), p.GetPoolCapacity(), p.GetPoolQueue())
+	}
+}
+
+func setProcessorStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	body := new(ircontrol.SetProcessorStatusRequest_Body)
+
+	name, _ := cmd.Flags().GetString(processorNameFlag)
+	body.SetName(name)
+
+	switch st, _ := cmd.Flags().GetString(processorStatusFlag); st {
+	default:
+		common.ExitOnErr(cmd, "", fmt.Errorf("unsupported status %s", st))
+	case processorStatusEnabled:
+		body.SetEnabled(true)
+	case processorStatusDisabled:
+		body.SetEnabled(false)
+	}
+
+	req := new(ircontrol.SetProcessorStatusRequest)
+	req.SetBody(body)
+
+	err := ircontrolsrv.SignMessage(pk, req)
+	common.ExitOnErr(cmd, "could not sign request: %w", err)
+
+	var resp *ircontrol.SetProcessorStatusResponse
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.SetProcessorStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Processor status successfully changed.")
+}
@@ -0,0 +1,64 @@
+package control
+
+import (
+	"encoding/hex"
+	"errors"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	ircontrol "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	ircontrolsrv "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir/server"
+	"github.com/spf13/cobra"
+)
+
+const irNodeKeyFlag = "node"
+
+var removeNodeCmd = &cobra.Command{
+	Use:   "remove-node",
+	Short: "Vote for the storage node removal from the network map (requires a quorum of Alphabet votes)",
+	Long: "Vote for the storage node removal from the network map. The vote of a single node is not " +
+		"enough: node is removed only when a quorum of Alphabet nodes have voted, so the command " +
+		"must be executed on the quorum of them.",
+	Run: removeNode,
+}
+
+func initControlIRRemoveNodeCmd() {
+	initControlFlags(removeNodeCmd)
+
+	flags := removeNodeCmd.Flags()
+	flags.String(irNodeKeyFlag, "", "Hex-encoded public key of the storage node")
+
+	_ = removeNodeCmd.MarkFlagRequired(irNodeKeyFlag)
+}
+
+func removeNode(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	nodeKeyStr, _ := cmd.Flags().GetString(irNodeKeyFlag)
+	if len(nodeKeyStr) == 0 {
+		common.ExitOnErr(cmd, "", errors.New("node key must be specified"))
+	}
+
+	nodeKey, err := hex.DecodeString(nodeKeyStr)
+	common.ExitOnErr(cmd, "can't decode node key: %w", err)
+
+	req := new(ircontrol.RemoveNodeRequest)
+	req.SetBody(new(ircontrol.RemoveNodeRequest_Body))
+	req.GetBody().SetKey(nodeKey)
+
+	err = ircontrolsrv.SignMessage(pk, req)
+	common.ExitOnErr(cmd, "could not sign request: %w", err)
+
+	var resp *ircontrol.RemoveNodeResponse
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.RemoveNode(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Voted for the node removal.")
+}
@@ -17,6 +17,10 @@ func initControlShardsCmd() {
 	shardsCmd.AddCommand(restoreShardCmd)
 	shardsCmd.AddCommand(evacuateShardCmd)
 	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(writeCacheInfoCmd)
+	shardsCmd.AddCommand(rebalanceShardsCmd)
+	shardsCmd.AddCommand(evacuationCmd)
+	shardsCmd.AddCommand(scrubShardsCmd)
 
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
@@ -24,4 +28,8 @@ func initControlShardsCmd() {
 	initControlRestoreShardCmd()
 	initControlEvacuateShardCmd()
 	initControlFlushCacheCmd()
+	initControlWriteCacheInfoCmd()
+	initControlRebalanceShardsCmd()
+	initControlEvacuationCmd()
+	initControlScrubShardsCmd()
 }
@@ -0,0 +1,169 @@
+package control
+
+import (
+	"time"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var rebalanceShardsCmd = &cobra.Command{
+	Use:   "rebalance",
+	Short: "Move objects to the shards preferred by HRW",
+	Long:  "Manage background moving of objects to the shards preferred by HRW",
+}
+
+var rebalanceStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start shard rebalancing",
+	Long:  "Start background moving of objects to the shards preferred by HRW",
+	Run:   rebalanceStart,
+}
+
+var rebalancePauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause shard rebalancing",
+	Long:  "Pause shard rebalancing, it can be continued with the resume command",
+	Run:   rebalancePause,
+}
+
+var rebalanceResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume shard rebalancing",
+	Long:  "Resume paused shard rebalancing",
+	Run:   rebalanceResume,
+}
+
+var rebalanceStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get shard rebalancing status",
+	Long:  "Get the progress of the current (or the last) shard rebalancing",
+	Run:   rebalanceStatus,
+}
+
+func initControlRebalanceShardsCmd() {
+	rebalanceShardsCmd.AddCommand(rebalanceStartCmd)
+	rebalanceShardsCmd.AddCommand(rebalancePauseCmd)
+	rebalanceShardsCmd.AddCommand(rebalanceResumeCmd)
+	rebalanceShardsCmd.AddCommand(rebalanceStatusCmd)
+
+	initControlFlags(rebalanceStartCmd)
+	initControlFlags(rebalancePauseCmd)
+	initControlFlags(rebalanceResumeCmd)
+	initControlFlags(rebalanceStatusCmd)
+}
+
+func rebalanceStart(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StartShardRebalanceRequest{Body: new(control.StartShardRebalanceRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartShardRebalanceResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.StartShardRebalance(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard rebalancing has been started.")
+}
+
+func rebalancePause(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.PauseShardRebalanceRequest{Body: new(control.PauseShardRebalanceRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.PauseShardRebalanceResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.PauseShardRebalance(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard rebalancing has been paused.")
+}
+
+func rebalanceResume(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.ResumeShardRebalanceRequest{Body: new(control.ResumeShardRebalanceRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ResumeShardRebalanceResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ResumeShardRebalance(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard rebalancing has been resumed.")
+}
+
+func rebalanceStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetShardRebalanceStatusRequest{Body: new(control.GetShardRebalanceStatusRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetShardRebalanceStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetShardRebalanceStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	var state string
+	switch body.GetState() {
+	case control.ShardRebalanceState_REBALANCE_RUNNING:
+		state = "running"
+	case control.ShardRebalanceState_REBALANCE_PAUSED:
+		state = "paused"
+	default:
+		state = "idle"
+	}
+
+	cmd.Printf("State: %s\n", state)
+	if body.GetStartedAt() != 0 {
+		cmd.Printf("Started at: %s\n", time.Unix(body.GetStartedAt(), 0).Format(time.RFC3339))
+	}
+	if body.GetFinishedAt() != 0 {
+		cmd.Printf("Finished at: %s\n", time.Unix(body.GetFinishedAt(), 0).Format(time.RFC3339))
+	}
+	cmd.Printf("Shards: %d/%d\n", body.GetShardsDone(), body.GetShardsTotal())
+	cmd.Printf("Objects: %d processed, %d moved, %d failed\n",
+		body.GetProcessed(), body.GetMoved(), body.GetFailed())
+	if body.GetError() != "" {
+		cmd.Printf("Error: %s\n", body.GetError())
+	}
+}
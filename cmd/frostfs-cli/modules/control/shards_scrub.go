@@ -0,0 +1,112 @@
+package control
+
+import (
+	"time"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+var scrubShardsCmd = &cobra.Command{
+	Use:   "scrub",
+	Short: "Verify payloads of the objects stored in the shards",
+	Long:  "Manage background verification of the objects stored in the shards",
+}
+
+var scrubStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start shard scrubbing",
+	Long:  "Start background verification of the objects stored in the shards, corrupted objects are marked as garbage",
+	Run:   scrubStart,
+}
+
+var scrubStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get shard scrubbing status",
+	Long:  "Get the progress of the current (or the last) shard scrubbing",
+	Run:   scrubStatus,
+}
+
+func initControlScrubShardsCmd() {
+	scrubShardsCmd.AddCommand(scrubStartCmd)
+	scrubShardsCmd.AddCommand(scrubStatusCmd)
+
+	for _, cmd := range []*cobra.Command{scrubStartCmd, scrubStatusCmd} {
+		initControlFlags(cmd)
+
+		ff := cmd.Flags()
+		ff.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+		ff.Bool(shardAllFlag, false, "Process all shards")
+
+		cmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+	}
+}
+
+func scrubStart(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StartShardScrubRequest{Body: new(control.StartShardScrubRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartShardScrubResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.StartShardScrub(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard scrubbing has been started.")
+}
+
+func scrubStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetShardScrubStatusRequest{Body: new(control.GetShardScrubStatusRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetShardScrubStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetShardScrubStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	for _, st := range resp.GetBody().GetShards() {
+		state := "idle"
+		if st.GetRunning() {
+			state = "running"
+		}
+
+		cmd.Printf("Shard %s:\n", base58.Encode(st.GetShard_ID()))
+		cmd.Printf("State: %s\n", state)
+		if st.GetStartedAt() != 0 {
+			cmd.Printf("Started at: %s\n", time.Unix(st.GetStartedAt(), 0).Format(time.RFC3339))
+		}
+		if st.GetFinishedAt() != 0 {
+			cmd.Printf("Finished at: %s\n", time.Unix(st.GetFinishedAt(), 0).Format(time.RFC3339))
+		}
+		cmd.Printf("Objects: %d checked, %d corrupted, %d read errors\n",
+			st.GetChecked(), st.GetCorrupted(), st.GetReadErrors())
+		if st.GetError() != "" {
+			cmd.Printf("Error: %s\n", st.GetError())
+		}
+	}
+}
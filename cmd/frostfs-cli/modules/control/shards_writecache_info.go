@@ -0,0 +1,99 @@
+package control
+
+import (
+	"bytes"
+	"encoding/json"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+var writeCacheInfoCmd = &cobra.Command{
+	Use:   "writecache-info",
+	Short: "Get write-cache statistics of the shards",
+	Long:  "Get the number and size of objects stored in the write-cache and flush statistics of the shards",
+	Run:   writeCacheInfo,
+}
+
+func initControlWriteCacheInfoCmd() {
+	initControlFlags(writeCacheInfoCmd)
+
+	ff := writeCacheInfoCmd.Flags()
+	ff.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	ff.Bool(shardAllFlag, false, "Process all shards")
+	ff.Bool(commonflags.JSON, false, "Print write-cache info as a JSON array")
+
+	writeCacheInfoCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+}
+
+func writeCacheInfo(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetWriteCacheInfoRequest{Body: new(control.GetWriteCacheInfoRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetWriteCacheInfoResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetWriteCacheInfo(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	isJSON, _ := cmd.Flags().GetBool(commonflags.JSON)
+	if isJSON {
+		prettyPrintWriteCacheInfoJSON(cmd, resp.GetBody().GetShards())
+	} else {
+		prettyPrintWriteCacheInfo(cmd, resp.GetBody().GetShards())
+	}
+}
+
+func prettyPrintWriteCacheInfoJSON(cmd *cobra.Command, ii []*control.WriteCacheInfo) {
+	out := make([]map[string]interface{}, 0, len(ii))
+	for _, i := range ii {
+		out = append(out, map[string]interface{}{
+			"shard_id":        base58.Encode(i.GetShard_ID()),
+			"objects_db":      i.GetObjectsDb(),
+			"size_db":         i.GetSizeDb(),
+			"objects_fs":      i.GetObjectsFs(),
+			"size_fs":         i.GetSizeFs(),
+			"flushed_objects": i.GetFlushedObjects(),
+			"flushed_size":    i.GetFlushedSize(),
+			"flush_errors":    i.GetFlushErrors(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode write-cache info to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintWriteCacheInfo(cmd *cobra.Command, ii []*control.WriteCacheInfo) {
+	for _, i := range ii {
+		cmd.Printf("Shard %s:\n"+
+			"Database: %d objects, %d bytes\n"+
+			"FSTree: %d objects, %d bytes\n"+
+			"Flushed: %d objects, %d bytes\n"+
+			"Flush errors: %d\n",
+			base58.Encode(i.GetShard_ID()),
+			i.GetObjectsDb(), i.GetSizeDb(),
+			i.GetObjectsFs(), i.GetSizeFs(),
+			i.GetFlushedObjects(), i.GetFlushedSize(),
+			i.GetFlushErrors(),
+		)
+	}
+}
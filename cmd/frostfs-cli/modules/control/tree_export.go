@@ -0,0 +1,149 @@
+package control
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	treeIDFlag             = "tree-id"
+	treeFilepathFlag       = "path"
+	treeExportSnapshotFlag = "snapshot"
+)
+
+var treeCmd = &cobra.Command{
+	Use:   "tree",
+	Short: "Operations with storage node's trees",
+	Long:  "Operations with storage node's trees",
+}
+
+var exportTreeCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export tree to a file",
+	Long: `Export tree to a file on the node in a portable format.
+By default the operation log is written together with the snapshot the log
+was compacted to. With --snapshot flag only the current tree state is written.`,
+	Run: exportTree,
+}
+
+var importTreeCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import tree from a file",
+	Long: `Import tree from a file on the node written by the export command.
+Node IDs and timestamps are preserved.`,
+	Run: importTree,
+}
+
+func initControlTreeCmd() {
+	treeCmd.AddCommand(exportTreeCmd)
+	treeCmd.AddCommand(importTreeCmd)
+
+	initControlFlags(exportTreeCmd)
+
+	ff := exportTreeCmd.Flags()
+	ff.String(commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
+	ff.String(treeIDFlag, "", "Tree ID")
+	ff.String(treeFilepathFlag, "", "File to write the tree to")
+	ff.Bool(treeExportSnapshotFlag, false, "Write only the current tree state without the operation log")
+
+	_ = exportTreeCmd.MarkFlagRequired(commonflags.CIDFlag)
+	_ = exportTreeCmd.MarkFlagRequired(treeIDFlag)
+	_ = exportTreeCmd.MarkFlagRequired(treeFilepathFlag)
+
+	initControlFlags(importTreeCmd)
+
+	ff = importTreeCmd.Flags()
+	ff.String(shardIDFlag, "", "Shard ID in base58 encoding, selected automatically if omitted")
+	ff.String(treeFilepathFlag, "", "File to read the tree from")
+
+	_ = importTreeCmd.MarkFlagRequired(treeFilepathFlag)
+}
+
+func exportTree(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	var cnr cid.ID
+	cidStr, _ := cmd.Flags().GetString(commonflags.CIDFlag)
+	common.ExitOnErr(cmd, "can't decode container ID: %w", cnr.DecodeString(cidStr))
+
+	treeID, _ := cmd.Flags().GetString(treeIDFlag)
+	if treeID == "" {
+		common.ExitOnErr(cmd, "", errors.New("tree ID must not be empty"))
+	}
+
+	p, _ := cmd.Flags().GetString(treeFilepathFlag)
+	snapshot, _ := cmd.Flags().GetBool(treeExportSnapshotFlag)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := &control.ExportTreeRequest{
+		Body: &control.ExportTreeRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+			Filepath:    p,
+			Snapshot:    snapshot,
+		},
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ExportTreeResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ExportTree(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+	cmd.Printf("Tree has been exported successfully: snapshot height %d, %d nodes, %d operations.\n",
+		body.GetHeight(), body.GetNodes(), body.GetOperations())
+}
+
+func importTree(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	p, _ := cmd.Flags().GetString(treeFilepathFlag)
+
+	req := &control.ImportTreeRequest{
+		Body: &control.ImportTreeRequest_Body{
+			Shard_ID: getShardID(cmd),
+			Filepath: p,
+		},
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ImportTreeResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ImportTree(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	var cnr cid.ID
+	common.ExitOnErr(cmd, "invalid container ID in the response: %w", cnr.Decode(body.GetContainerId()))
+
+	cmd.Printf("Tree %s/%s has been imported successfully: snapshot height %d, %d nodes, %d operations.\n",
+		cnr, body.GetTreeId(), body.GetHeight(), body.GetNodes(), body.GetOperations())
+}
@@ -9,12 +9,19 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oidSDK "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/spf13/cobra"
 )
 
+const (
+	searchLimitFlag      = "limit"
+	searchCursorFlag     = "cursor"
+	searchAttributesFlag = "attributes"
+)
+
 var (
 	searchFilters []string
 
@@ -41,6 +48,9 @@ func initObjectSearchCmd() {
 	flags.Bool("root", false, "Search for user objects")
 	flags.Bool("phy", false, "Search physically stored objects")
 	flags.String(commonflags.OIDFlag, "", "Search object by identifier")
+	flags.Int(searchLimitFlag, 0, "Maximum number of objects to return, all objects if zero")
+	flags.String(searchCursorFlag, "", "Cursor returned with the previous page of results")
+	flags.StringSlice(searchAttributesFlag, nil, "Attributes to return values of along with object identifiers")
 }
 
 func searchObject(cmd *cobra.Command, _ []string) {
@@ -60,15 +70,52 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	readSessionGlobal(cmd, &prm, pk, cnr)
 	prm.SetContainerID(cnr)
 	prm.SetFilters(sf)
+	prm.SetPrivateKey(pk)
+
+	limit, _ := cmd.Flags().GetInt(searchLimitFlag)
+	if limit < 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("invalid limit: %d", limit))
+	}
+	prm.SetLimit(limit)
+
+	cursor, _ := cmd.Flags().GetString(searchCursorFlag)
+	prm.SetCursor(cursor)
+
+	attrKeys, _ := cmd.Flags().GetStringSlice(searchAttributesFlag)
+	prm.SetAttributes(attrKeys)
 
 	res, err := internalclient.SearchObjects(prm)
 	common.ExitOnErr(cmd, "rpc error: %w", err)
 
 	ids := res.IDList()
+	attrs := res.AttributeList()
 
 	cmd.Printf("Found %d objects.\n", len(ids))
 	for i := range ids {
 		cmd.Println(ids[i].String())
+
+		if len(attrKeys) != 0 {
+			printAttributeValues(cmd, attrKeys, attrs[i])
+		}
+	}
+
+	if next := res.NextCursor(limit); next != "" {
+		cmd.Printf("Next cursor: %s\n", next)
+	}
+}
+
+// printAttributeValues prints the attribute values returned by the search,
+// missing attributes are skipped.
+func printAttributeValues(cmd *cobra.Command, keys []string, vals []*string) {
+	if vals == nil {
+		cmd.Println("  attributes were not returned")
+		return
+	}
+
+	for i := range keys {
+		if i < len(vals) && vals[i] != nil {
+			cmd.Printf("  %s: %s\n", keys[i], *vals[i])
+		}
 	}
 }
 
@@ -80,6 +127,10 @@ var searchBinaryOpVocabulary = map[string]object.SearchMatchType{
 	"EQ":            object.MatchStringEqual,
 	"NE":            object.MatchStringNotEqual,
 	"COMMON_PREFIX": object.MatchCommonPrefix,
+	"GT":            objectcore.MatchNumGT,
+	"GE":            objectcore.MatchNumGE,
+	"LT":            objectcore.MatchNumLT,
+	"LE":            objectcore.MatchNumLE,
 }
 
 func parseSearchFilters(cmd *cobra.Command) (object.SearchFilters, error) {
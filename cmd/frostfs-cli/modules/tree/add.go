@@ -24,6 +24,7 @@ var addCmd = &cobra.Command{
 
 func initAddCmd() {
 	commonflags.Init(addCmd)
+	initBearer(addCmd)
 	initCTID(addCmd)
 
 	ff := addCmd.Flags()
@@ -60,7 +61,7 @@ func add(cmd *cobra.Command, _ []string) {
 		TreeId:      tid,
 		ParentId:    pid,
 		Meta:        meta,
-		BearerToken: nil, // TODO: #1891 add token handling
+		BearerToken: readBearerToken(cmd),
 	}
 
 	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
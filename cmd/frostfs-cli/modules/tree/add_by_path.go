@@ -24,6 +24,7 @@ var addByPathCmd = &cobra.Command{
 
 func initAddByPathCmd() {
 	commonflags.Init(addByPathCmd)
+	initBearer(addByPathCmd)
 	initCTID(addByPathCmd)
 
 	ff := addByPathCmd.Flags()
@@ -71,7 +72,7 @@ func addByPath(cmd *cobra.Command, _ []string) {
 		//PathAttribute: pAttr,
 		Path:        strings.Split(path, "/"),
 		Meta:        meta,
-		BearerToken: nil, // TODO: #1891 add token handling
+		BearerToken: readBearerToken(cmd),
 	}
 
 	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
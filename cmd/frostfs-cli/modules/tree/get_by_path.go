@@ -24,6 +24,7 @@ var getByPathCmd = &cobra.Command{
 
 func initGetByPathCmd() {
 	commonflags.Init(getByPathCmd)
+	initBearer(getByPathCmd)
 	initCTID(getByPathCmd)
 
 	ff := getByPathCmd.Flags()
@@ -70,7 +71,7 @@ func getByPath(cmd *cobra.Command, _ []string) {
 		Path:          strings.Split(path, "/"),
 		LatestOnly:    latestOnly,
 		AllAttributes: true,
-		BearerToken:   nil, // TODO: #1891 add token handling
+		BearerToken:   readBearerToken(cmd),
 	}
 
 	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
@@ -0,0 +1,93 @@
+package tree
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/tree"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var getOpLogCmd = &cobra.Command{
+	Use:   "get-op-log",
+	Short: "Get logged operations starting with some height",
+	Run:   getOpLog,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func initGetOpLogCmd() {
+	commonflags.Init(getOpLogCmd)
+	initCTID(getOpLogCmd)
+
+	ff := getOpLogCmd.Flags()
+	ff.Uint64(heightFlagKey, 0, "Height to start with")
+	ff.Uint64(countFlagKey, 0, "Logged operations count, 0 means all operations")
+
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+}
+
+func getOpLog(cmd *cobra.Command, _ []string) {
+	pk := key.GetOrGenerate(cmd)
+
+	var cnr cid.ID
+	err := cnr.DecodeString(cmd.Flag(commonflags.CIDFlag).Value.String())
+	common.ExitOnErr(cmd, "decode container ID string: %w", err)
+
+	tid, _ := cmd.Flags().GetString(treeIDFlagKey)
+	height, _ := cmd.Flags().GetUint64(heightFlagKey)
+	count, _ := cmd.Flags().GetUint64(countFlagKey)
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	cli, err := _client(ctx)
+	common.ExitOnErr(cmd, "client: %w", err)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := new(tree.GetOpLogRequest)
+	req.Body = &tree.GetOpLogRequest_Body{
+		ContainerId: rawCID,
+		TreeId:      tid,
+		Height:      height,
+		Count:       count,
+	}
+
+	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
+
+	stream, err := cli.GetOpLog(ctx, req)
+	common.ExitOnErr(cmd, "rpc call: %w", err)
+
+	for i := uint64(0); count == 0 || i < count; i++ {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		common.ExitOnErr(cmd, "failed to read the response: %w", err)
+
+		op := resp.GetBody().GetOperation()
+
+		var meta pilorama.Meta
+		common.ExitOnErr(cmd, "invalid operation meta: %w", meta.FromBytes(op.GetMeta()))
+
+		cmd.Printf("%d:\n", meta.Time)
+
+		cmd.Println("\tParent ID: ", op.GetParentId())
+		cmd.Println("\tNode ID: ", op.GetChildId())
+
+		cmd.Println("\tMeta pairs: ")
+		for _, kv := range meta.Items {
+			cmd.Printf("\t\t%s: %s\n", kv.Key, string(kv.Value))
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package tree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/tree"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var getSubTreeCmd = &cobra.Command{
+	Use:   "get-subtree",
+	Short: "Get a subtree of the node",
+	Long: `Get a subtree of the node and print it as a hierarchy.
+Children can be sorted by the attribute, with --start-after and --start-after-id
+flags only the children of the root node following the attribute value and
+node ID pair are returned. Only the children of the root node are paged, so use
+--depth 2 to page through a single level with --limit.`,
+	Run: getSubTree,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func initGetSubTreeCmd() {
+	commonflags.Init(getSubTreeCmd)
+	initBearer(getSubTreeCmd)
+	initCTID(getSubTreeCmd)
+
+	ff := getSubTreeCmd.Flags()
+	ff.Uint64(rootIDFlagKey, 0, "Root node ID of the subtree")
+	ff.Uint32(depthFlagKey, 0, "Depth of the traversal, 1 returns only the root, 0 returns the whole subtree")
+	ff.String(orderByFlagKey, "", "Attribute to sort the children by, children without the attribute are skipped")
+	ff.String(startAfterFlagKey, "", "Attribute value of the last root child returned by the previous request")
+	ff.Uint64(startAfterIDFlagKey, 0, "ID of the last root child returned by the previous request")
+	ff.Uint32(limitFlagKey, 0, "Maximum number of the returned nodes including the root, 0 means no limit")
+	ff.Bool(commonflags.JSON, false, "Print nodes as a JSON array")
+
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+}
+
+func getSubTree(cmd *cobra.Command, _ []string) {
+	pk := key.GetOrGenerate(cmd)
+
+	var cnr cid.ID
+	err := cnr.DecodeString(cmd.Flag(commonflags.CIDFlag).Value.String())
+	common.ExitOnErr(cmd, "decode container ID string: %w", err)
+
+	tid, _ := cmd.Flags().GetString(treeIDFlagKey)
+	rid, _ := cmd.Flags().GetUint64(rootIDFlagKey)
+	depth, _ := cmd.Flags().GetUint32(depthFlagKey)
+	orderBy, _ := cmd.Flags().GetString(orderByFlagKey)
+	startAfter, _ := cmd.Flags().GetString(startAfterFlagKey)
+	startAfterID, _ := cmd.Flags().GetUint64(startAfterIDFlagKey)
+	limit, _ := cmd.Flags().GetUint32(limitFlagKey)
+
+	ctx := cmd.Context()
+
+	cli, err := _client(ctx)
+	common.ExitOnErr(cmd, "client: %w", err)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := new(tree.GetSubTreeRequest)
+	req.Body = &tree.GetSubTreeRequest_Body{
+		ContainerId:    rawCID,
+		TreeId:         tid,
+		RootId:         rid,
+		Depth:          depth,
+		BearerToken:    readBearerToken(cmd),
+		OrderAttribute: orderBy,
+		StartAfter:     []byte(startAfter),
+		StartAfterId:   startAfterID,
+		Limit:          limit,
+	}
+
+	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
+
+	stream, err := cli.GetSubTree(ctx, req)
+	common.ExitOnErr(cmd, "rpc call: %w", err)
+
+	var nodes []*tree.GetSubTreeResponse_Body
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		common.ExitOnErr(cmd, "failed to read the response: %w", err)
+
+		nodes = append(nodes, resp.GetBody())
+	}
+
+	if isJSON, _ := cmd.Flags().GetBool(commonflags.JSON); isJSON {
+		printSubTreeJSON(cmd, nodes)
+	} else {
+		printSubTree(cmd, nodes)
+	}
+}
+
+func printSubTreeJSON(cmd *cobra.Command, nodes []*tree.GetSubTreeResponse_Body) {
+	out := make([]map[string]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		meta := make([]map[string]string, 0, len(n.GetMeta()))
+		for _, kv := range n.GetMeta() {
+			meta = append(meta, map[string]string{
+				"key":   kv.GetKey(),
+				"value": string(kv.GetValue()),
+			})
+		}
+		out = append(out, map[string]interface{}{
+			"node_id":   n.GetNodeId(),
+			"parent_id": n.GetParentId(),
+			"timestamp": n.GetTimestamp(),
+			"meta":      meta,
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode nodes to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String())
+}
+
+// printSubTree prints the nodes as a hierarchy. Nodes are expected
+// to be sent after their parents with the root node being the first one.
+func printSubTree(cmd *cobra.Command, nodes []*tree.GetSubTreeResponse_Body) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	children := make(map[uint64][]*tree.GetSubTreeResponse_Body)
+	for _, n := range nodes[1:] {
+		children[n.GetParentId()] = append(children[n.GetParentId()], n)
+	}
+
+	cmd.Println(formatNode(nodes[0]))
+
+	var printChildren func(id uint64, prefix string)
+	printChildren = func(id uint64, prefix string) {
+		cs := children[id]
+		for i, n := range cs {
+			branch, indent := "├── ", "│   "
+			if i == len(cs)-1 {
+				branch, indent = "└── ", "    "
+			}
+			cmd.Println(prefix + branch + formatNode(n))
+			printChildren(n.GetNodeId(), prefix+indent)
+		}
+	}
+	printChildren(nodes[0].GetNodeId(), "")
+}
+
+func formatNode(n *tree.GetSubTreeResponse_Body) string {
+	var sb strings.Builder
+	sb.WriteString(strconv.FormatUint(n.GetNodeId(), 10))
+
+	for i, kv := range n.GetMeta() {
+		if i == 0 {
+			sb.WriteString(" ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(kv.GetKey())
+		sb.WriteString("=")
+		sb.WriteString(string(kv.GetValue()))
+	}
+	return sb.String()
+}
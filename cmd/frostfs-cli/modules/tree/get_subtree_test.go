@@ -0,0 +1,43 @@
+package tree
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/tree"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintSubTree(t *testing.T) {
+	node := func(id, parent uint64, name string) *tree.GetSubTreeResponse_Body {
+		return &tree.GetSubTreeResponse_Body{
+			NodeId:   id,
+			ParentId: parent,
+			Meta:     []*tree.KeyValue{{Key: "FileName", Value: []byte(name)}},
+		}
+	}
+
+	nodes := []*tree.GetSubTreeResponse_Body{
+		{NodeId: 0},
+		node(1, 0, "dir1"),
+		node(3, 1, "sub1"),
+		node(4, 3, "file"),
+		node(5, 1, "sub2"),
+		node(2, 0, "dir2"),
+	}
+
+	buf := bytes.NewBuffer(nil)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+	printSubTree(cmd, nodes)
+
+	expected := `0
+├── 1 FileName=dir1
+│   ├── 3 FileName=sub1
+│   │   └── 4 FileName=file
+│   └── 5 FileName=sub2
+└── 2 FileName=dir2
+`
+	require.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,77 @@
+package tree
+
+import (
+	"crypto/sha256"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/tree"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var moveCmd = &cobra.Command{
+	Use:   "move",
+	Short: "Move a node to another parent",
+	Long:  "Move a node to another parent. Node meta is replaced with the provided one.",
+	Run:   move,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func initMoveCmd() {
+	commonflags.Init(moveCmd)
+	initBearer(moveCmd)
+	initCTID(moveCmd)
+
+	ff := moveCmd.Flags()
+	ff.Uint64(nodeIDFlagKey, 0, "Node ID")
+	ff.Uint64(parentIDFlagKey, 0, "New parent node ID")
+	ff.StringSlice(metaFlagKey, nil, "Meta pairs in the form of Key1=[0x]Value1,Key2=[0x]Value2")
+
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+	_ = cobra.MarkFlagRequired(ff, nodeIDFlagKey)
+	_ = cobra.MarkFlagRequired(ff, parentIDFlagKey)
+}
+
+func move(cmd *cobra.Command, _ []string) {
+	pk := key.GetOrGenerate(cmd)
+
+	var cnr cid.ID
+	err := cnr.DecodeString(cmd.Flag(commonflags.CIDFlag).Value.String())
+	common.ExitOnErr(cmd, "decode container ID string: %w", err)
+
+	tid, _ := cmd.Flags().GetString(treeIDFlagKey)
+	nid, _ := cmd.Flags().GetUint64(nodeIDFlagKey)
+	pid, _ := cmd.Flags().GetUint64(parentIDFlagKey)
+
+	meta, err := parseMeta(cmd)
+	common.ExitOnErr(cmd, "meta data parsing: %w", err)
+
+	ctx := cmd.Context()
+
+	cli, err := _client(ctx)
+	common.ExitOnErr(cmd, "client: %w", err)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := new(tree.MoveRequest)
+	req.Body = &tree.MoveRequest_Body{
+		ContainerId: rawCID,
+		TreeId:      tid,
+		ParentId:    pid,
+		NodeId:      nid,
+		Meta:        meta,
+		BearerToken: readBearerToken(cmd),
+	}
+
+	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
+
+	_, err = cli.Move(ctx, req)
+	common.ExitOnErr(cmd, "rpc call: %w", err)
+
+	cmd.Println("Node has been moved.")
+}
@@ -0,0 +1,68 @@
+package tree
+
+import (
+	"crypto/sha256"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/tree"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var removeCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove a node from the tree service",
+	Long:  "Remove a node from the tree service. The node is moved to the trash together with its children.",
+	Run:   remove,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func initRemoveCmd() {
+	commonflags.Init(removeCmd)
+	initBearer(removeCmd)
+	initCTID(removeCmd)
+
+	ff := removeCmd.Flags()
+	ff.Uint64(nodeIDFlagKey, 0, "Node ID")
+
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+	_ = cobra.MarkFlagRequired(ff, nodeIDFlagKey)
+}
+
+func remove(cmd *cobra.Command, _ []string) {
+	pk := key.GetOrGenerate(cmd)
+
+	var cnr cid.ID
+	err := cnr.DecodeString(cmd.Flag(commonflags.CIDFlag).Value.String())
+	common.ExitOnErr(cmd, "decode container ID string: %w", err)
+
+	tid, _ := cmd.Flags().GetString(treeIDFlagKey)
+	nid, _ := cmd.Flags().GetUint64(nodeIDFlagKey)
+
+	ctx := cmd.Context()
+
+	cli, err := _client(ctx)
+	common.ExitOnErr(cmd, "client: %w", err)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := new(tree.RemoveRequest)
+	req.Body = &tree.RemoveRequest_Body{
+		ContainerId: rawCID,
+		TreeId:      tid,
+		NodeId:      nid,
+		BearerToken: readBearerToken(cmd),
+	}
+
+	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
+
+	_, err = cli.Remove(ctx, req)
+	common.ExitOnErr(cmd, "rpc call: %w", err)
+
+	cmd.Println("Node has been removed.")
+}
@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/common"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
 	"github.com/spf13/cobra"
 )
@@ -15,16 +16,26 @@ func init() {
 	Cmd.AddCommand(getByPathCmd)
 	Cmd.AddCommand(addByPathCmd)
 	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(removeCmd)
+	Cmd.AddCommand(moveCmd)
+	Cmd.AddCommand(getSubTreeCmd)
+	Cmd.AddCommand(getOpLogCmd)
 
 	initAddCmd()
 	initGetByPathCmd()
 	initAddByPathCmd()
 	initListCmd()
+	initRemoveCmd()
+	initMoveCmd()
+	initGetSubTreeCmd()
+	initGetOpLogCmd()
 }
 
 const (
 	treeIDFlagKey   = "tid"
 	parentIDFlagKey = "pid"
+	nodeIDFlagKey   = "nid"
+	rootIDFlagKey   = "root"
 
 	metaFlagKey = "meta"
 
@@ -32,6 +43,17 @@ const (
 	pathAttributeFlagKey = "pattr"
 
 	latestOnlyFlagKey = "latest"
+
+	depthFlagKey        = "depth"
+	orderByFlagKey      = "order-by"
+	startAfterFlagKey   = "start-after"
+	startAfterIDFlagKey = "start-after-id"
+	limitFlagKey        = "limit"
+
+	heightFlagKey = "height"
+	countFlagKey  = "count"
+
+	bearerFlagKey = "bearer"
 )
 
 func initCTID(cmd *cobra.Command) {
@@ -43,3 +65,16 @@ func initCTID(cmd *cobra.Command) {
 	ff.String(treeIDFlagKey, "", "Tree ID")
 	_ = cmd.MarkFlagRequired(treeIDFlagKey)
 }
+
+func initBearer(cmd *cobra.Command) {
+	cmd.Flags().String(bearerFlagKey, "", "Path to bearer token")
+}
+
+// readBearerToken returns the bearer token in V2 format, nil if the token is not provided.
+func readBearerToken(cmd *cobra.Command) []byte {
+	btok := common.ReadBearerToken(cmd, bearerFlagKey)
+	if btok == nil {
+		return nil
+	}
+	return btok.Marshal()
+}
@@ -113,6 +113,8 @@ func defaultConfiguration(cfg *viper.Viper) {
 
 	cfg.SetDefault("locode.db.path", "")
 
+	cfg.SetDefault("admission_policy.path", "")
+
 	// extra fee values for working mode without notary contract
 	cfg.SetDefault("fee.main_chain", 5000_0000)                  // 0.5 Fixed8
 	cfg.SetDefault("fee.side_chain", 2_0000_0000)                // 2.0 Fixed8
@@ -57,7 +57,7 @@ func main() {
 	log, err := logger.NewLogger(&logPrm)
 	exitErr(err)
 
-	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
 	intErr := make(chan error) // internal inner ring errors
@@ -79,6 +79,8 @@ func main() {
 	err = innerRing.Start(ctx, intErr)
 	exitErr(err)
 
+	go watchConfig(ctx, *configFile, &logPrm, innerRing, log)
+
 	log.Info("application started",
 		zap.String("version", misc.Version))
 
@@ -107,6 +109,53 @@ func main() {
 	log.Info("application stopped")
 }
 
+// watchConfig rereads the configuration on SIGHUP and applies it
+// to the logger and the inner ring node.
+func watchConfig(ctx context.Context, path string, logPrm *logger.Prm, ir *innerring.Server, log *logger.Logger) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ch:
+			log.Info("SIGHUP has been received, rereading configuration...")
+
+			cfg, err := newConfig(path)
+			if err != nil {
+				log.Error("configuration reading", zap.Error(err))
+				continue
+			}
+
+			err = logPrm.SetLevelString(cfg.GetString("logger.level"))
+			if err != nil {
+				log.Error("logger configuration preparation", zap.Error(err))
+				continue
+			}
+
+			err = logPrm.Reload()
+			if err != nil {
+				log.Error("updated configuration applying",
+					zap.String("component", "logger"),
+					zap.Error(err))
+			}
+
+			err = ir.Reload(cfg)
+			if err != nil {
+				log.Error("updated configuration applying",
+					zap.String("component", "inner ring"),
+					zap.Error(err))
+				continue
+			}
+
+			log.Info("configuration has been reloaded successfully")
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func initHTTPServers(cfg *viper.Viper, log *logger.Logger) []*httputil.Server {
 	items := []struct {
 		cfgPrefix string
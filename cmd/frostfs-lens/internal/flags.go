@@ -8,6 +8,7 @@ const (
 	flagAddress    = "address"
 	flagEnginePath = "path"
 	flagOutFile    = "out"
+	flagConfig     = "config"
 )
 
 // AddAddressFlag adds the address flag to the passed cobra command.
@@ -33,3 +34,11 @@ func AddOutputFileFlag(cmd *cobra.Command, v *string) {
 		"File to save object payload")
 	_ = cmd.MarkFlagFilename(flagOutFile)
 }
+
+// AddConfigFileFlag adds the storage node config file flag to the passed cobra command.
+func AddConfigFileFlag(cmd *cobra.Command, v *string) {
+	cmd.Flags().StringVar(v, flagConfig, "",
+		"Path to the storage node configuration file")
+	_ = cmd.MarkFlagFilename(flagConfig)
+	_ = cmd.MarkFlagRequired(flagConfig)
+}
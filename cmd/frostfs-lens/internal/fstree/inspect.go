@@ -0,0 +1,40 @@
+package fstree
+
+import (
+	"context"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	blobstorCommon "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+var inspectCMD = &cobra.Command{
+	Use:   "inspect",
+	Short: "Object inspection",
+	Long:  `Inspect specific object in an FSTree.`,
+	Run:   inspectFunc,
+}
+
+func init() {
+	common.AddAddressFlag(inspectCMD, &vAddress)
+	common.AddComponentPathFlag(inspectCMD, &vPath)
+	common.AddOutputFileFlag(inspectCMD, &vOut)
+	addLayoutFlags(inspectCMD)
+}
+
+func inspectFunc(cmd *cobra.Command, _ []string) {
+	var addr oid.Address
+
+	err := addr.DecodeString(vAddress)
+	common.ExitOnErr(cmd, common.Errf("invalid address argument: %w", err))
+
+	t := openFSTree(cmd)
+	defer t.Close()
+
+	res, err := t.Get(context.Background(), blobstorCommon.GetPrm{Address: addr})
+	common.ExitOnErr(cmd, common.Errf("could not fetch object: %w", err))
+
+	common.PrintObjectHeader(cmd, *res.Object)
+	common.WriteObjectToFile(cmd, vOut, res.RawData)
+}
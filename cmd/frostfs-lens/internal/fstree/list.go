@@ -0,0 +1,34 @@
+package fstree
+
+import (
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	blobstorCommon "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+var listCMD = &cobra.Command{
+	Use:   "list",
+	Short: "Object listing",
+	Long:  `List all objects stored in an FSTree.`,
+	Run:   listFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(listCMD, &vPath)
+	addLayoutFlags(listCMD)
+}
+
+func listFunc(cmd *cobra.Command, _ []string) {
+	t := openFSTree(cmd)
+	defer t.Close()
+
+	var prm blobstorCommon.IteratePrm
+	prm.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
+		cmd.Println(addr)
+		return nil
+	}
+
+	_, err := t.Iterate(prm)
+	common.ExitOnErr(cmd, common.Errf("FSTree iterator failure: %w", err))
+}
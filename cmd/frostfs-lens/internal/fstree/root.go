@@ -0,0 +1,54 @@
+package fstree
+
+import (
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagDepth      = "depth"
+	flagDirNameLen = "dir-name-len"
+)
+
+var (
+	vAddress    string
+	vPath       string
+	vOut        string
+	vDepth      uint64
+	vDirNameLen int
+)
+
+// Root contains `fstree` command definition.
+var Root = &cobra.Command{
+	Use:   "fstree",
+	Short: "Operations with an FSTree",
+}
+
+func init() {
+	Root.AddCommand(listCMD, inspectCMD)
+}
+
+// addLayoutFlags adds the FSTree directory layout flags to the passed cobra command.
+func addLayoutFlags(cmd *cobra.Command) {
+	ff := cmd.Flags()
+	ff.Uint64Var(&vDepth, flagDepth, 4, "Depth of the nested directories")
+	ff.IntVar(&vDirNameLen, flagDirNameLen, fstree.DirNameLen, "Length of the nested directory names")
+}
+
+func openFSTree(cmd *cobra.Command) *fstree.FSTree {
+	t := fstree.New(
+		fstree.WithPath(vPath),
+		fstree.WithDepth(vDepth),
+		fstree.WithDirNameLen(vDirNameLen),
+	)
+	common.ExitOnErr(cmd, common.Errf("could not open FSTree: %w", t.Open(true)))
+
+	// Objects can be compressed with any algorithm regardless of the current configuration.
+	var cc compression.Config
+	common.ExitOnErr(cmd, common.Errf("could not init decompressor: %w", cc.Init()))
+	t.SetCompressor(&cc)
+
+	return t
+}
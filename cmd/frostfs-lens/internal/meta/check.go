@@ -0,0 +1,438 @@
+package meta
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+	engineconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine"
+	shardconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard"
+	blobovniczaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/blobovnicza"
+	fstreeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/fstree"
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	blobstorCommon "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	"github.com/TrueCloudLab/frostfs-sdk-go/client"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+)
+
+const flagRepair = "repair"
+
+var (
+	vConfig string
+	vRepair bool
+)
+
+var checkCMD = &cobra.Command{
+	Use:   "check",
+	Short: "Metabase consistency check",
+	Long: `Cross-validate the metabase with the blobstor of the same shard: objects indexed
+but missing in the blobstor, blobs without index entries, wrong storage IDs, object counters,
+dangling lock and graveyard records. Blobstor and write-cache configuration is taken from the
+storage node config for the shard with the given metabase path. Objects which are not flushed
+from the write-cache yet are not considered missing. With --repair flag the found inconsistencies
+are fixed, the storage node must be stopped in this case.`,
+	Run: checkFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(checkCMD, &vPath)
+	common.AddConfigFileFlag(checkCMD, &vConfig)
+	checkCMD.Flags().BoolVar(&vRepair, flagRepair, false, "Fix the found inconsistencies")
+}
+
+// checkReport groups the numbers of found inconsistencies.
+type checkReport struct {
+	missing    int
+	unindexed  int
+	storageIDs int
+	counters   bool
+	locks      int
+	graves     int
+}
+
+func (r checkReport) total() int {
+	n := r.missing + r.unindexed + r.storageIDs + r.locks + r.graves
+	if r.counters {
+		n++
+	}
+	return n
+}
+
+func checkFunc(cmd *cobra.Command, _ []string) {
+	sc := findShardConfig(cmd)
+
+	db := meta.New(
+		meta.WithPath(vPath),
+		meta.WithBoltDBOptions(&bbolt.Options{
+			ReadOnly: !vRepair,
+			Timeout:  100 * time.Millisecond,
+		}),
+		meta.WithEpochState(epochState{}),
+	)
+	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open(!vRepair)))
+	defer db.Close()
+
+	if vRepair {
+		common.ExitOnErr(cmd, common.Errf("could not initialize metabase: %w", db.Init()))
+	}
+
+	bs := openBlobStor(cmd, sc)
+	defer bs.Close()
+
+	wc := openWriteCache(cmd, sc)
+	if wc != nil {
+		defer wc.close()
+	}
+
+	var rep checkReport
+
+	common.ExitOnErr(cmd, checkBlobs(cmd, db, bs, &rep))
+	common.ExitOnErr(cmd, checkIndexedObjects(cmd, db, bs, wc, &rep))
+	common.ExitOnErr(cmd, checkIntegrity(cmd, db, &rep))
+
+	cmd.Printf("Objects indexed but missing in the blobstor: %d\n", rep.missing)
+	cmd.Printf("Blobs without index entries: %d\n", rep.unindexed)
+	cmd.Printf("Wrong storage IDs: %d\n", rep.storageIDs)
+	cmd.Printf("Dangling lock records: %d\n", rep.locks)
+	cmd.Printf("Dangling graveyard records: %d\n", rep.graves)
+
+	switch {
+	case rep.total() == 0:
+		cmd.Println("Metabase is consistent.")
+	case vRepair:
+		cmd.Printf("%d inconsistencies have been fixed.\n", rep.total())
+	default:
+		cmd.Printf("%d inconsistencies found, run with --%s flag to fix them.\n", rep.total(), flagRepair)
+	}
+}
+
+// findShardConfig returns the configuration of the shard which metabase path is vPath.
+func findShardConfig(cmd *cobra.Command) *shardconfig.Config {
+	appCfg := config.New(config.Prm{}, config.WithConfigFile(vConfig))
+
+	var res *shardconfig.Config
+	err := engineconfig.IterateShards(appCfg, false, func(sc *shardconfig.Config) error {
+		if filepath.Clean(sc.Metabase().Path()) == filepath.Clean(vPath) {
+			res = sc
+		}
+		return nil
+	})
+	common.ExitOnErr(cmd, common.Errf("could not read shards configuration: %w", err))
+
+	if res == nil {
+		common.ExitOnErr(cmd, fmt.Errorf("shard with metabase %s is not found in the configuration", vPath))
+	}
+	return res
+}
+
+func openBlobStor(cmd *cobra.Command, sc *shardconfig.Config) *blobstor.BlobStor {
+	storagesCfg := sc.BlobStor().Storages()
+	ss := make([]blobstor.SubStorage, 0, len(storagesCfg))
+
+	for i := range storagesCfg {
+		switch storagesCfg[i].Type() {
+		case blobovniczatree.Type:
+			sub := blobovniczaconfig.From((*config.Config)(storagesCfg[i]))
+			ss = append(ss, blobstor.SubStorage{
+				Storage: blobovniczatree.NewBlobovniczaTree(
+					blobovniczatree.WithRootPath(storagesCfg[i].Path()),
+					blobovniczatree.WithPermissions(storagesCfg[i].Perm()),
+					blobovniczatree.WithBlobovniczaSize(sub.Size()),
+					blobovniczatree.WithBlobovniczaShallowDepth(sub.ShallowDepth()),
+					blobovniczatree.WithBlobovniczaShallowWidth(sub.ShallowWidth()),
+					blobovniczatree.WithOpenedCacheSize(sub.OpenedCacheSize())),
+			})
+		case fstree.Type:
+			sub := fstreeconfig.From((*config.Config)(storagesCfg[i]))
+			ss = append(ss, blobstor.SubStorage{
+				Storage: fstree.New(
+					fstree.WithPath(storagesCfg[i].Path()),
+					fstree.WithPerm(storagesCfg[i].Perm()),
+					fstree.WithDepth(sub.Depth())),
+			})
+		default:
+			common.ExitOnErr(cmd, fmt.Errorf("invalid storage type: %s", storagesCfg[i].Type()))
+		}
+	}
+
+	bs := blobstor.New(
+		blobstor.WithStorages(ss),
+		blobstor.WithCompressObjects(sc.Compress()),
+	)
+	common.ExitOnErr(cmd, common.Errf("could not open blobstor: %w", bs.Open(!vRepair)))
+	common.ExitOnErr(cmd, common.Errf("could not initialize blobstor: %w", bs.Init()))
+
+	return bs
+}
+
+// writeCache is a read-only view of the shard write-cache.
+type writeCache struct {
+	db     *bbolt.DB
+	fsTree *fstree.FSTree
+}
+
+// openWriteCache opens the write-cache of the shard in read-only mode.
+// Returns nil if the write-cache is disabled.
+func openWriteCache(cmd *cobra.Command, sc *shardconfig.Config) *writeCache {
+	wcCfg := sc.WriteCache()
+	if !wcCfg.Enabled() {
+		return nil
+	}
+
+	db, err := writecache.OpenDB(wcCfg.Path(), true)
+	common.ExitOnErr(cmd, common.Errf("could not open write-cache database: %w", err))
+
+	fsTree := writecache.NewFSTree(wcCfg.Path(), true)
+	err = fsTree.Open(true)
+	if err != nil {
+		_ = db.Close()
+		common.ExitOnErr(cmd, fmt.Errorf("could not open write-cache file tree: %w", err))
+	}
+
+	return &writeCache{
+		db:     db,
+		fsTree: fsTree,
+	}
+}
+
+// exists checks whether the object is stored in the write-cache.
+func (w *writeCache) exists(addr oid.Address) (bool, error) {
+	_, err := writecache.Get(w.db, []byte(addr.EncodeToString()))
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, writecache.ErrNoDefaultBucket), client.IsErrObjectNotFound(err):
+	default:
+		return false, err
+	}
+
+	res, err := w.fsTree.Exists(blobstorCommon.ExistsPrm{Address: addr})
+	if err != nil {
+		return false, err
+	}
+	return res.Exists, nil
+}
+
+func (w *writeCache) close() {
+	_ = w.fsTree.Close()
+	_ = w.db.Close()
+}
+
+// checkBlobs finds blobs without index entries and blobs with wrong storage IDs.
+func checkBlobs(cmd *cobra.Command, db *meta.DB, bs *blobstor.BlobStor, rep *checkReport) error {
+	// Blobs are deleted after the iteration, because blobovniczas
+	// can't be modified while being iterated over.
+	var removed []blobstorCommon.DeletePrm
+
+	var prm blobstorCommon.IteratePrm
+	prm.IgnoreErrors = true
+	prm.ErrorHandler = func(addr oid.Address, err error) error {
+		cmd.Printf("could not read blob %s: %v\n", addr, err)
+		return nil
+	}
+	prm.Handler = func(elem blobstorCommon.IterationElement) error {
+		obj, ok, err := db.GetIndexedObject(elem.Address)
+		if err != nil {
+			return fmt.Errorf("could not get indexed object %s: %w", elem.Address, err)
+		}
+
+		if !ok {
+			rep.unindexed++
+			cmd.Printf("blob without index entry: %s\n", elem.Address)
+
+			if !vRepair {
+				return nil
+			}
+
+			isRemoved, err := indexBlob(cmd, db, elem)
+			if isRemoved {
+				removed = append(removed, blobstorCommon.DeletePrm{
+					Address:   elem.Address,
+					StorageID: elem.StorageID,
+				})
+			}
+			return err
+		}
+
+		if obj.StorageID() != nil && !bytes.Equal(obj.StorageID(), elem.StorageID) {
+			rep.storageIDs++
+			cmd.Printf("wrong storage ID: %s\n", elem.Address)
+
+			if vRepair {
+				err := db.RepairStorageID(elem.Address, elem.StorageID)
+				if err != nil {
+					return fmt.Errorf("could not update storage ID of %s: %w", elem.Address, err)
+				}
+			}
+		}
+		return nil
+	}
+
+	_, err := bs.Iterate(prm)
+	if err != nil {
+		return fmt.Errorf("could not iterate over blobstor: %w", err)
+	}
+
+	for i := range removed {
+		_, err = bs.Delete(removed[i])
+		if err != nil {
+			return fmt.Errorf("could not delete blob of the removed object %s: %w", removed[i].Address, err)
+		}
+	}
+	return nil
+}
+
+// indexBlob puts the object to the metabase in the same way metabase refill does.
+// Returns true if the object has already been removed, so its blob must be deleted.
+func indexBlob(cmd *cobra.Command, db *meta.DB, elem blobstorCommon.IterationElement) (bool, error) {
+	obj := object.New()
+	if err := obj.Unmarshal(elem.ObjectData); err != nil {
+		cmd.Printf("could not unmarshal object %s: %v\n", elem.Address, err)
+		return false, nil
+	}
+
+	//nolint: exhaustive
+	switch obj.Type() {
+	case object.TypeTombstone:
+		tombstone := object.NewTombstone()
+		if err := tombstone.Unmarshal(obj.Payload()); err != nil {
+			return false, fmt.Errorf("could not unmarshal tombstone content: %w", err)
+		}
+
+		tombAddr := objectCore.AddressOf(obj)
+		memberIDs := tombstone.Members()
+		tombMembers := make([]oid.Address, 0, len(memberIDs))
+
+		for i := range memberIDs {
+			a := tombAddr
+			a.SetObject(memberIDs[i])
+
+			tombMembers = append(tombMembers, a)
+		}
+
+		var inhumePrm meta.InhumePrm
+		inhumePrm.SetTombstoneAddress(tombAddr)
+		inhumePrm.SetAddresses(tombMembers...)
+
+		if _, err := db.Inhume(inhumePrm); err != nil {
+			return false, fmt.Errorf("could not inhume objects: %w", err)
+		}
+	case object.TypeLock:
+		var lock object.Lock
+		if err := lock.Unmarshal(obj.Payload()); err != nil {
+			return false, fmt.Errorf("could not unmarshal lock content: %w", err)
+		}
+
+		locked := make([]oid.ID, lock.NumberOfMembers())
+		lock.ReadMembers(locked)
+
+		cnr, _ := obj.ContainerID()
+		id, _ := obj.ID()
+		if err := db.Lock(cnr, id, locked); err != nil {
+			return false, fmt.Errorf("could not lock objects: %w", err)
+		}
+	}
+
+	var putPrm meta.PutPrm
+	putPrm.SetObject(obj)
+	putPrm.SetStorageID(elem.StorageID)
+
+	_, err := db.Put(context.Background(), putPrm)
+	if meta.IsErrRemoved(err) || errors.Is(err, meta.ErrObjectIsExpired) {
+		return true, nil
+	}
+	return false, common.Errf("could not put object to the metabase: %w", err)
+}
+
+// checkIndexedObjects finds objects indexed in the metabase but missing both in the
+// blobstor and in the write-cache (if any). Objects are indexed on the write-cache put,
+// so the ones not flushed yet must be kept.
+func checkIndexedObjects(cmd *cobra.Command, db *meta.DB, bs *blobstor.BlobStor, wc *writeCache, rep *checkReport) error {
+	var missing []oid.Address
+
+	err := db.IterateIndexedObjects(func(obj meta.IndexedObject) error {
+		var prm blobstorCommon.ExistsPrm
+		prm.Address = obj.Address()
+		prm.StorageID = obj.StorageID()
+
+		res, err := bs.Exists(prm)
+		if err == nil && !res.Exists && prm.StorageID != nil {
+			// Wrong storage IDs are reported by checkBlobs.
+			prm.StorageID = nil
+			res, err = bs.Exists(prm)
+		}
+		if err != nil {
+			return fmt.Errorf("could not check blob %s existence: %w", obj.Address(), err)
+		}
+
+		if !res.Exists && wc != nil {
+			res.Exists, err = wc.exists(obj.Address())
+			if err != nil {
+				return fmt.Errorf("could not check object %s existence in the write-cache: %w", obj.Address(), err)
+			}
+		}
+
+		if !res.Exists {
+			missing = append(missing, obj.Address())
+			cmd.Printf("object indexed but missing in the blobstor: %s\n", obj.Address())
+		}
+		return nil
+	})
+	if err != nil {
+		return common.Errf("could not iterate over metabase: %w", err)
+	}
+
+	rep.missing = len(missing)
+
+	if vRepair && len(missing) != 0 {
+		var prm meta.DeletePrm
+		prm.SetAddresses(missing...)
+
+		_, err = db.Delete(prm)
+		return common.Errf("could not delete index entries: %w", err)
+	}
+	return nil
+}
+
+// checkIntegrity checks the internal consistency of the metabase.
+func checkIntegrity(cmd *cobra.Command, db *meta.DB, rep *checkReport) error {
+	var prm meta.CheckIntegrityPrm
+	prm.SetRepair(vRepair)
+
+	res, err := db.CheckIntegrity(prm)
+	if err != nil {
+		return fmt.Errorf("could not check metabase integrity: %w", err)
+	}
+
+	stored, actual := res.StoredCounters(), res.ActualCounters()
+	if stored != actual {
+		rep.counters = true
+		cmd.Printf("object counters mismatch: phy %d (actual %d), logic %d (actual %d)\n",
+			stored.Phy(), actual.Phy(), stored.Logic(), actual.Logic())
+	}
+
+	for _, addr := range res.DanglingLocks() {
+		cmd.Printf("dangling lock record: %s\n", addr)
+	}
+	for _, addr := range res.DanglingGraves() {
+		cmd.Printf("dangling graveyard record: %s\n", addr)
+	}
+
+	rep.locks = len(res.DanglingLocks())
+	rep.graves = len(res.DanglingGraves())
+
+	return nil
+}
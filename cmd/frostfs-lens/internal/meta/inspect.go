@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -51,7 +52,7 @@ func inspectFunc(cmd *cobra.Command, _ []string) {
 
 	siErr := new(object.SplitInfoError)
 
-	res, err := db.Get(prm)
+	res, err := db.Get(context.Background(), prm)
 	if errors.As(err, &siErr) {
 		link, linkSet := siErr.SplitInfo().Link()
 		last, lastSet := siErr.SplitInfo().LastPart()
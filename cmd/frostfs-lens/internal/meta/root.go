@@ -31,6 +31,7 @@ func init() {
 		inspectCMD,
 		listGraveyardCMD,
 		listGarbageCMD,
+		checkCMD,
 	)
 }
 
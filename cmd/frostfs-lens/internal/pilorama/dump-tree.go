@@ -0,0 +1,65 @@
+package pilorama
+
+import (
+	"sort"
+	"strings"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/spf13/cobra"
+)
+
+var dumpTreeCMD = &cobra.Command{
+	Use:   "dump-tree",
+	Short: "Tree dump",
+	Long: `Print the tree structure starting from the given node (root by default).
+Each line contains the node ID, the timestamp of the last operation with the node
+and the node attributes.`,
+	Run: dumpTreeFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(dumpTreeCMD, &vPath)
+	addTreeFlags(dumpTreeCMD)
+	dumpTreeCMD.Flags().Uint64Var(&vNode, flagNode, pilorama.RootID, "ID of the node to start from")
+}
+
+func dumpTreeFunc(cmd *cobra.Command, _ []string) {
+	cnr := getCID(cmd)
+
+	f := openPilorama(cmd)
+	defer f.Close()
+
+	type stackItem struct {
+		id    pilorama.Node
+		level int
+	}
+
+	stack := []stackItem{{id: vNode}}
+	visited := make(map[pilorama.Node]struct{})
+	for len(stack) != 0 {
+		item := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		indent := strings.Repeat("  ", item.level)
+		if _, ok := visited[item.id]; ok {
+			cmd.Printf("%s%s (cycle)\n", indent, formatNode(item.id))
+			continue
+		}
+		visited[item.id] = struct{}{}
+
+		m, _, err := f.TreeGetMeta(cnr, vTreeID, item.id)
+		common.ExitOnErr(cmd, common.Errf("could not get node meta: %w", err))
+
+		cmd.Printf("%s%s [%d] %s\n", indent, formatNode(item.id), m.Time, formatMeta(m))
+
+		children, err := f.TreeGetChildren(cnr, vTreeID, item.id)
+		common.ExitOnErr(cmd, common.Errf("could not get node children: %w", err))
+
+		// Push in reverse order to print the children in ascending order.
+		sort.Slice(children, func(i, j int) bool { return children[i] > children[j] })
+		for i := range children {
+			stack = append(stack, stackItem{id: children[i], level: item.level + 1})
+		}
+	}
+}
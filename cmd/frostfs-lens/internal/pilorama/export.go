@@ -0,0 +1,56 @@
+package pilorama
+
+import (
+	"os"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/spf13/cobra"
+)
+
+const flagSnapshot = "snapshot"
+
+var vSnapshot bool
+
+var exportCMD = &cobra.Command{
+	Use:   "export",
+	Short: "Export tree to a file",
+	Long: `Export tree to a file in the portable format, the same as the one used by
+'frostfs-cli control tree export'. By default the operation log is written together
+with the snapshot the log was compacted to. With --snapshot flag only the current
+tree state is written. The storage node must be stopped or the pilorama must be
+in read-only mode.`,
+	Run: exportFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(exportCMD, &vPath)
+	addTreeFlags(exportCMD)
+
+	ff := exportCMD.Flags()
+	ff.StringVar(&vOut, "out", "", "File to write the tree to")
+	ff.BoolVar(&vSnapshot, flagSnapshot, false, "Write only the current tree state without the operation log")
+
+	_ = exportCMD.MarkFlagFilename("out")
+	_ = exportCMD.MarkFlagRequired("out")
+}
+
+func exportFunc(cmd *cobra.Command, _ []string) {
+	cnr := getCID(cmd)
+
+	f := openPilorama(cmd)
+	defer f.Close()
+
+	out, err := os.OpenFile(vOut, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	common.ExitOnErr(cmd, common.Errf("could not create output file: %w", err))
+
+	info, err := pilorama.ExportTree(out, f, cnr, vTreeID, vSnapshot)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	common.ExitOnErr(cmd, common.Errf("could not export tree: %w", err))
+
+	cmd.Printf("Snapshot height: %d\n", info.Height)
+	cmd.Printf("Nodes: %d\n", info.Nodes)
+	cmd.Printf("Operations: %d\n", info.Operations)
+}
@@ -0,0 +1,85 @@
+package pilorama
+
+import (
+	"sort"
+	"strings"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/spf13/cobra"
+)
+
+var inspectNodeCMD = &cobra.Command{
+	Use:   "inspect-node",
+	Short: "Node inspection",
+	Long:  `Print the attributes, the parent, the children and the path from the root of the tree node.`,
+	Run:   inspectNodeFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(inspectNodeCMD, &vPath)
+	addTreeFlags(inspectNodeCMD)
+	inspectNodeCMD.Flags().Uint64Var(&vNode, flagNode, 0, "Node ID")
+	_ = inspectNodeCMD.MarkFlagRequired(flagNode)
+}
+
+func inspectNodeFunc(cmd *cobra.Command, _ []string) {
+	cnr := getCID(cmd)
+
+	f := openPilorama(cmd)
+	defer f.Close()
+
+	m, parent, err := f.TreeGetMeta(cnr, vTreeID, vNode)
+	common.ExitOnErr(cmd, common.Errf("could not get node meta: %w", err))
+
+	children, err := f.TreeGetChildren(cnr, vTreeID, vNode)
+	common.ExitOnErr(cmd, common.Errf("could not get node children: %w", err))
+
+	sort.Slice(children, func(i, j int) bool { return children[i] < children[j] })
+
+	cmd.Println("ID:", formatNode(vNode))
+	cmd.Println("Parent:", formatNode(parent))
+	cmd.Println("Timestamp:", m.Time)
+	cmd.Println("Attributes:")
+	for _, kv := range m.Items {
+		cmd.Printf("  %s: %q\n", kv.Key, kv.Value)
+	}
+
+	ids := make([]string, len(children))
+	for i := range children {
+		ids[i] = formatNode(children[i])
+	}
+	cmd.Println("Children:", strings.Join(ids, " "))
+
+	// Walk up to the root collecting node IDs and file names.
+	// Removed nodes end up in the trash instead of the root.
+	idPath := []string{formatNode(vNode)}
+	var namePath []string
+	visited := map[pilorama.Node]struct{}{vNode: {}}
+	for id, p := vNode, parent; id != pilorama.RootID; {
+		if name := m.GetAttr(pilorama.AttributeFilename); name != nil {
+			namePath = append(namePath, string(name))
+		}
+
+		idPath = append(idPath, formatNode(p))
+		if _, ok := visited[p]; ok || p == pilorama.TrashID {
+			break
+		}
+		visited[p] = struct{}{}
+
+		id = p
+		m, p, err = f.TreeGetMeta(cnr, vTreeID, id)
+		common.ExitOnErr(cmd, common.Errf("could not get node meta: %w", err))
+	}
+
+	reverse(idPath)
+	reverse(namePath)
+	cmd.Println("Path:", strings.Join(idPath, "/"))
+	cmd.Println("FilePath:", strings.Join(namePath, "/"))
+}
+
+func reverse(s []string) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,42 @@
+package pilorama
+
+import (
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/spf13/cobra"
+)
+
+var listTreesCMD = &cobra.Command{
+	Use:   "list-trees",
+	Short: "Tree listing",
+	Long:  `List all trees stored in a pilorama. With --cid flag only the trees of the container are listed.`,
+	Run:   listTreesFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(listTreesCMD, &vPath)
+	listTreesCMD.Flags().StringVar(&vCID, flagCID, "", "Container ID")
+}
+
+func listTreesFunc(cmd *cobra.Command, _ []string) {
+	f := openPilorama(cmd)
+	defer f.Close()
+
+	if vCID != "" {
+		cnr := getCID(cmd)
+
+		ids, err := f.TreeList(cnr)
+		common.ExitOnErr(cmd, common.Errf("could not list trees: %w", err))
+
+		for i := range ids {
+			cmd.Printf("%s %s\n", cnr, ids[i])
+		}
+		return
+	}
+
+	trees, err := f.TreeListTrees()
+	common.ExitOnErr(cmd, common.Errf("could not list trees: %w", err))
+
+	for i := range trees {
+		cmd.Printf("%s %s\n", trees[i].CID, trees[i].TreeID)
+	}
+}
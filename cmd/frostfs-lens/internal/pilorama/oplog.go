@@ -0,0 +1,55 @@
+package pilorama
+
+import (
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagHeight = "height"
+	flagCount  = "count"
+)
+
+var (
+	vHeight uint64
+	vCount  uint64
+)
+
+var opLogCMD = &cobra.Command{
+	Use:   "oplog",
+	Short: "Operation log listing",
+	Long: `Print the operation log of the tree starting from the given height.
+Each line contains the operation timestamp, the parent and the child node IDs
+and the node attributes.`,
+	Run: opLogFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(opLogCMD, &vPath)
+	addTreeFlags(opLogCMD)
+
+	ff := opLogCMD.Flags()
+	ff.Uint64Var(&vHeight, flagHeight, 0, "Timestamp of the first operation to print")
+	ff.Uint64Var(&vCount, flagCount, 0, "Maximum number of operations to print, zero means no limit")
+}
+
+func opLogFunc(cmd *cobra.Command, _ []string) {
+	cnr := getCID(cmd)
+
+	f := openPilorama(cmd)
+	defer f.Close()
+
+	h := vHeight
+	for i := uint64(0); vCount == 0 || i < vCount; i++ {
+		lm, err := f.TreeGetOpLog(cnr, vTreeID, h)
+		common.ExitOnErr(cmd, common.Errf("could not get operation: %w", err))
+
+		if lm.Time == 0 {
+			break
+		}
+
+		cmd.Printf("%d %s -> %s %s\n", lm.Time, formatNode(lm.Parent), formatNode(lm.Child), formatMeta(lm.Meta))
+
+		h = lm.Time + 1
+	}
+}
@@ -0,0 +1,88 @@
+package pilorama
+
+import (
+	"strconv"
+	"strings"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagCID    = "cid"
+	flagTreeID = "tree-id"
+	flagNode   = "node"
+)
+
+var (
+	vPath   string
+	vCID    string
+	vTreeID string
+	vNode   uint64
+	vOut    string
+)
+
+// Root contains `pilorama` command definition.
+var Root = &cobra.Command{
+	Use:   "pilorama",
+	Short: "Operations with a pilorama",
+}
+
+func init() {
+	Root.AddCommand(
+		listTreesCMD,
+		dumpTreeCMD,
+		opLogCMD,
+		inspectNodeCMD,
+		exportCMD,
+	)
+}
+
+func openPilorama(cmd *cobra.Command) pilorama.ForestStorage {
+	f := pilorama.NewBoltForest(pilorama.WithPath(vPath))
+	common.ExitOnErr(cmd, common.Errf("could not open pilorama: %w", f.Open(true)))
+	common.ExitOnErr(cmd, common.Errf("could not init pilorama: %w", f.Init()))
+
+	return f
+}
+
+// addTreeFlags adds the container ID and the tree ID flags to the passed cobra command.
+func addTreeFlags(cmd *cobra.Command) {
+	ff := cmd.Flags()
+	ff.StringVar(&vCID, flagCID, "", "Container ID")
+	ff.StringVar(&vTreeID, flagTreeID, "", "Tree ID")
+
+	_ = cmd.MarkFlagRequired(flagCID)
+	_ = cmd.MarkFlagRequired(flagTreeID)
+}
+
+func getCID(cmd *cobra.Command) cid.ID {
+	var cnr cid.ID
+	common.ExitOnErr(cmd, common.Errf("invalid container ID: %w", cnr.DecodeString(vCID)))
+
+	return cnr
+}
+
+// formatNode returns the string representation of the node ID.
+func formatNode(id pilorama.Node) string {
+	if id == pilorama.TrashID {
+		return "trash"
+	}
+	return strconv.FormatUint(id, 10)
+}
+
+// formatMeta returns the string representation of the meta attributes.
+func formatMeta(m pilorama.Meta) string {
+	var sb strings.Builder
+	for i, kv := range m.Items {
+		if i != 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(kv.Key)
+		sb.WriteByte('=')
+		sb.WriteString(strconv.Quote(string(kv.Value)))
+	}
+	return sb.String()
+}
@@ -4,7 +4,9 @@ import (
 	"os"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal/blobovnicza"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal/fstree"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal/meta"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal/pilorama"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal/writecache"
 	"github.com/TrueCloudLab/frostfs-node/misc"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/gendoc"
@@ -36,7 +38,9 @@ func init() {
 	command.Flags().Bool("version", false, "Application version")
 	command.AddCommand(
 		blobovnicza.Root,
+		fstree.Root,
 		meta.Root,
+		pilorama.Root,
 		writecache.Root,
 		gendoc.Command(command),
 	)
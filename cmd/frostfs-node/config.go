@@ -32,6 +32,7 @@ import (
 	netmapCore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
@@ -94,9 +95,12 @@ type applicationConfiguration struct {
 	}
 
 	EngineCfg struct {
-		errorThreshold uint32
-		shardPoolSize  uint32
-		shards         []shardCfg
+		errorThreshold      uint32
+		shardPoolSize       uint32
+		rebalanceRateLimit  uint32
+		rebalanceOnShardAdd bool
+		evacuationStatePath string
+		shards              []shardCfg
 	}
 }
 
@@ -104,6 +108,11 @@ type shardCfg struct {
 	compress                  bool
 	smallSizeObjectLimit      uint64
 	uncompressableContentType []string
+	compressionAlgorithm      compression.Algorithm
+	compressionLevel          int
+	compressionContentTypes   []compression.ContentTypeAlgorithm
+	estimateCompressibility   bool
+	estimateCompressibilityTh float64
 	refillMetabase            bool
 	mode                      shardmode.Mode
 
@@ -121,6 +130,11 @@ type shardCfg struct {
 		removerSleepInterval time.Duration
 	}
 
+	scrubCfg struct {
+		interval  time.Duration
+		rateLimit uint32
+	}
+
 	writecacheCfg struct {
 		enabled          bool
 		path             string
@@ -197,6 +211,9 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 
 	a.EngineCfg.errorThreshold = engineconfig.ShardErrorThreshold(c)
 	a.EngineCfg.shardPoolSize = engineconfig.ShardPoolSize(c)
+	a.EngineCfg.rebalanceRateLimit = engineconfig.RebalanceRateLimit(c)
+	a.EngineCfg.rebalanceOnShardAdd = engineconfig.RebalanceOnShardAdd(c)
+	a.EngineCfg.evacuationStatePath = engineconfig.EvacuationStatePath(c)
 
 	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
 		var sh shardCfg
@@ -205,6 +222,11 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		sh.mode = sc.Mode()
 		sh.compress = sc.Compress()
 		sh.uncompressableContentType = sc.UncompressableContentTypes()
+		sh.compressionAlgorithm = sc.CompressionAlgorithm()
+		sh.compressionLevel = sc.CompressionLevel()
+		sh.compressionContentTypes = sc.CompressionContentTypeAlgorithms()
+		sh.estimateCompressibility = sc.CompressionEstimateCompressibility()
+		sh.estimateCompressibilityTh = sc.CompressionEstimateCompressibilityThreshold()
 		sh.smallSizeObjectLimit = sc.SmallSizeLimit()
 
 		// write-cache
@@ -230,6 +252,7 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		storagesCfg := blobStorCfg.Storages()
 		metabaseCfg := sc.Metabase()
 		gcCfg := sc.GC()
+		scrubCfg := sc.Scrub()
 
 		if config.BoolSafe(c.Sub("tree"), "enabled") {
 			piloramaCfg := sc.Pilorama()
@@ -286,6 +309,11 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		sh.gcCfg.removerBatchSize = gcCfg.RemoverBatchSize()
 		sh.gcCfg.removerSleepInterval = gcCfg.RemoverSleepInterval()
 
+		// scrub
+
+		sh.scrubCfg.interval = scrubCfg.Interval()
+		sh.scrubCfg.rateLimit = scrubCfg.RateLimit()
+
 		a.EngineCfg.shards = append(a.EngineCfg.shards, sh)
 
 		return nil
@@ -625,6 +653,9 @@ func (c *cfg) engineOpts() []engine.Option {
 	opts = append(opts,
 		engine.WithShardPoolSize(c.EngineCfg.shardPoolSize),
 		engine.WithErrorThreshold(c.EngineCfg.errorThreshold),
+		engine.WithRebalanceRateLimit(c.EngineCfg.rebalanceRateLimit),
+		engine.WithRebalanceOnShardAdd(c.EngineCfg.rebalanceOnShardAdd),
+		engine.WithEvacuationStatePath(c.EngineCfg.evacuationStatePath),
 
 		engine.WithLogger(c.log),
 	)
@@ -715,6 +746,10 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 			shard.WithBlobStorOptions(
 				blobstor.WithCompressObjects(shCfg.compress),
 				blobstor.WithUncompressableContentTypes(shCfg.uncompressableContentType),
+				blobstor.WithCompressionAlgorithm(shCfg.compressionAlgorithm),
+				blobstor.WithCompressionLevel(shCfg.compressionLevel),
+				blobstor.WithCompressionContentTypeAlgorithms(shCfg.compressionContentTypes),
+				blobstor.WithCompressibilityEstimation(shCfg.estimateCompressibility, shCfg.estimateCompressibilityTh),
 				blobstor.WithStorages(ss),
 
 				blobstor.WithLogger(c.log),
@@ -736,6 +771,8 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 			shard.WithWriteCacheOptions(writeCacheOpts...),
 			shard.WithRemoverBatchSize(shCfg.gcCfg.removerBatchSize),
 			shard.WithGCRemoverSleepInterval(shCfg.gcCfg.removerSleepInterval),
+			shard.WithScrubInterval(shCfg.scrubCfg.interval),
+			shard.WithScrubRateLimit(shCfg.scrubCfg.rateLimit),
 			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
 				pool, err := ants.NewPool(sz)
 				fatalOnErr(err)
@@ -148,6 +148,14 @@ func IntSafe(c *Config, name string) int64 {
 	return cast.ToInt64(c.Value(name))
 }
 
+// FloatSafe reads a configuration value
+// from c by name and casts it to float64.
+//
+// Returns 0 if the value can not be casted.
+func FloatSafe(c *Config, name string) float64 {
+	return cast.ToFloat64(c.Value(name))
+}
+
 // SizeInBytesSafe reads a configuration value
 // from c by name and casts it to size in bytes (uint64).
 //
@@ -111,8 +111,13 @@ func TestNumbers(t *testing.T) {
 		require.Panics(t, func() { config.Int(c, incorrect) })
 		require.Panics(t, func() { config.Uint(c, incorrect) })
 
+		require.EqualValues(t, 1, config.FloatSafe(c, intPos))
+		require.EqualValues(t, 2.5, config.FloatSafe(c, fractPos))
+		require.EqualValues(t, -2.5, config.FloatSafe(c, fractNeg))
+
 		require.Zero(t, config.IntSafe(c, incorrect))
 		require.Zero(t, config.UintSafe(c, incorrect))
+		require.Zero(t, config.FloatSafe(c, incorrect))
 	})
 }
 
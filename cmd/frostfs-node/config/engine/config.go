@@ -15,6 +15,10 @@ const (
 	// ShardPoolSizeDefault is a default value of routine pool size per-shard to
 	// process object PUT operations in a storage engine.
 	ShardPoolSizeDefault = 20
+
+	// RebalanceRateLimitDefault is a default number of objects checked
+	// by the shard rebalancing per second.
+	RebalanceRateLimitDefault = 100
 )
 
 // ErrNoShardConfigured is returned when at least 1 shard is required but none are found.
@@ -83,3 +87,29 @@ func ShardPoolSize(c *config.Config) uint32 {
 func ShardErrorThreshold(c *config.Config) uint32 {
 	return config.Uint32Safe(c.Sub(subsection), "shard_ro_error_threshold")
 }
+
+// RebalanceRateLimit returns the value of "rebalance_rate_limit" config parameter from "storage" section.
+//
+// Returns RebalanceRateLimitDefault if the value is not a positive number.
+func RebalanceRateLimit(c *config.Config) uint32 {
+	v := config.Uint32Safe(c.Sub(subsection), "rebalance_rate_limit")
+	if v > 0 {
+		return v
+	}
+
+	return RebalanceRateLimitDefault
+}
+
+// RebalanceOnShardAdd returns the value of "rebalance_on_shard_add" config parameter from "storage" section.
+//
+// Returns false if the value is not a valid bool.
+func RebalanceOnShardAdd(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "rebalance_on_shard_add")
+}
+
+// EvacuationStatePath returns the value of "evacuation_state_path" config parameter from "storage" section.
+//
+// Returns empty string if the value is not a valid string.
+func EvacuationStatePath(c *config.Config) string {
+	return config.StringSafe(c.Sub(subsection), "evacuation_state_path")
+}
@@ -11,7 +11,9 @@ import (
 	blobovniczaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/blobovnicza"
 	fstreeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/fstree"
 	piloramaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/pilorama"
+	scrubconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/scrub"
 	configtest "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/test"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +38,9 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
+		require.EqualValues(t, engineconfig.RebalanceRateLimitDefault, engineconfig.RebalanceRateLimit(empty))
+		require.False(t, engineconfig.RebalanceOnShardAdd(empty))
+		require.Empty(t, engineconfig.EvacuationStatePath(empty))
 		require.EqualValues(t, mode.ReadWrite, shardconfig.From(empty).Mode())
 	})
 
@@ -46,6 +51,9 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 100, engineconfig.ShardErrorThreshold(c))
 		require.EqualValues(t, 15, engineconfig.ShardPoolSize(c))
+		require.EqualValues(t, 50, engineconfig.RebalanceRateLimit(c))
+		require.True(t, engineconfig.RebalanceOnShardAdd(c))
+		require.Equal(t, "/srv/neofs/evacuation.json", engineconfig.EvacuationStatePath(c))
 
 		err := engineconfig.IterateShards(c, true, func(sc *shardconfig.Config) error {
 			defer func() {
@@ -58,6 +66,7 @@ func TestEngineSection(t *testing.T) {
 			ss := blob.Storages()
 			pl := sc.Pilorama()
 			gc := sc.GC()
+			scrub := sc.Scrub()
 
 			switch num {
 			case 0:
@@ -83,6 +92,14 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, true, sc.Compress())
 				require.Equal(t, []string{"audio/*", "video/*"}, sc.UncompressableContentTypes())
+				require.Equal(t, compression.AlgorithmZSTD, sc.CompressionAlgorithm())
+				require.Equal(t, 5, sc.CompressionLevel())
+				require.Equal(t, []compression.ContentTypeAlgorithm{
+					{ContentType: "text/*", Algorithm: compression.AlgorithmLZ4},
+					{ContentType: "application/json", Algorithm: compression.AlgorithmNone},
+				}, sc.CompressionContentTypeAlgorithms())
+				require.Equal(t, true, sc.CompressionEstimateCompressibility())
+				require.Equal(t, 0.7, sc.CompressionEstimateCompressibilityThreshold())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -104,6 +121,9 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 150, gc.RemoverBatchSize())
 				require.Equal(t, 2*time.Minute, gc.RemoverSleepInterval())
 
+				require.Equal(t, 24*time.Hour, scrub.Interval())
+				require.EqualValues(t, 50, scrub.RateLimit())
+
 				require.Equal(t, false, sc.RefillMetabase())
 				require.Equal(t, mode.ReadOnly, sc.Mode())
 			case 1:
@@ -129,6 +149,11 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, false, sc.Compress())
 				require.Equal(t, []string(nil), sc.UncompressableContentTypes())
+				require.Equal(t, compression.AlgorithmZSTD, sc.CompressionAlgorithm())
+				require.Equal(t, 0, sc.CompressionLevel())
+				require.Nil(t, sc.CompressionContentTypeAlgorithms())
+				require.Equal(t, false, sc.CompressionEstimateCompressibility())
+				require.Equal(t, shardconfig.EstimateCompressibilityThresholdDefault, sc.CompressionEstimateCompressibilityThreshold())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -150,6 +175,9 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 200, gc.RemoverBatchSize())
 				require.Equal(t, 5*time.Minute, gc.RemoverSleepInterval())
 
+				require.Equal(t, time.Duration(0), scrub.Interval())
+				require.EqualValues(t, scrubconfig.RateLimitDefault, scrub.RateLimit())
+
 				require.Equal(t, true, sc.RefillMetabase())
 				require.Equal(t, mode.ReadWrite, sc.Mode())
 			}
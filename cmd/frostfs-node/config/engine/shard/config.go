@@ -2,13 +2,16 @@ package shardconfig
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
 	blobstorconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor"
 	gcconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/gc"
 	metabaseconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/pilorama"
+	scrubconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/scrub"
 	writecacheconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 )
 
@@ -16,8 +19,14 @@ import (
 // which provides access to Shard configurations.
 type Config config.Config
 
-// SmallSizeLimitDefault is a default limit of small objects payload in bytes.
-const SmallSizeLimitDefault = 1 << 20
+const (
+	// SmallSizeLimitDefault is a default limit of small objects payload in bytes.
+	SmallSizeLimitDefault = 1 << 20
+
+	// EstimateCompressibilityThresholdDefault is a default compression ratio
+	// threshold of the compressibility estimation.
+	EstimateCompressibilityThresholdDefault = compression.DefaultEstimateCompressibilityThreshold
+)
 
 // From wraps config section into Config.
 func From(c *config.Config) *Config {
@@ -43,6 +52,97 @@ func (x *Config) UncompressableContentTypes() []string {
 		"compression_exclude_content_types")
 }
 
+// CompressionAlgorithm returns the value of "compression_algorithm" config parameter.
+//
+// Returns compression.AlgorithmZSTD if the value is missing.
+// Panics if the value is not one of the supported algorithms.
+func (x *Config) CompressionAlgorithm() compression.Algorithm {
+	s := config.StringSafe(
+		(*config.Config)(x),
+		"compression_algorithm",
+	)
+
+	return parseCompressionAlgorithm(s)
+}
+
+// CompressionLevel returns the value of "compression_level" config parameter.
+//
+// Returns 0 (default level) if the value is not a valid integer.
+func (x *Config) CompressionLevel() int {
+	return int(config.IntSafe(
+		(*config.Config)(x),
+		"compression_level",
+	))
+}
+
+// CompressionContentTypeAlgorithms returns the value of
+// "compression_content_type_algorithms" config parameter.
+// Each element has "content-type:algorithm" format.
+//
+// Returns nil if the value is missing.
+// Panics if any element has invalid format.
+func (x *Config) CompressionContentTypeAlgorithms() []compression.ContentTypeAlgorithm {
+	ss := config.StringSliceSafe(
+		(*config.Config)(x),
+		"compression_content_type_algorithms",
+	)
+
+	var res []compression.ContentTypeAlgorithm
+	for _, s := range ss {
+		i := strings.LastIndexByte(s, ':')
+		if i <= 0 {
+			panic(fmt.Sprintf("invalid content type compression algorithm: %s", s))
+		}
+
+		res = append(res, compression.ContentTypeAlgorithm{
+			ContentType: s[:i],
+			Algorithm:   parseCompressionAlgorithm(s[i+1:]),
+		})
+	}
+
+	return res
+}
+
+// CompressionEstimateCompressibility returns the value of
+// "compression_estimate_compressibility" config parameter.
+//
+// Returns false if the value is not a valid bool.
+func (x *Config) CompressionEstimateCompressibility() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"compression_estimate_compressibility",
+	)
+}
+
+// CompressionEstimateCompressibilityThreshold returns the value of
+// "compression_estimate_compressibility_threshold" config parameter.
+//
+// Returns EstimateCompressibilityThresholdDefault if the value
+// is not a positive number.
+func (x *Config) CompressionEstimateCompressibilityThreshold() float64 {
+	v := config.FloatSafe(
+		(*config.Config)(x),
+		"compression_estimate_compressibility_threshold",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return EstimateCompressibilityThresholdDefault
+}
+
+func parseCompressionAlgorithm(s string) compression.Algorithm {
+	switch a := compression.Algorithm(s); a {
+	case "":
+		return compression.AlgorithmZSTD
+	case compression.AlgorithmNone, compression.AlgorithmZSTD, compression.AlgorithmLZ4:
+		return a
+	default:
+		panic(fmt.Sprintf("unknown compression algorithm: %s", s))
+	}
+}
+
 // SmallSizeLimit returns the value of "small_object_size" config parameter.
 //
 // Returns SmallSizeLimitDefault if the value is not a positive number.
@@ -99,6 +199,14 @@ func (x *Config) GC() *gcconfig.Config {
 	)
 }
 
+// Scrub returns "scrub" subsection as a scrubconfig.Config.
+func (x *Config) Scrub() *scrubconfig.Config {
+	return scrubconfig.From(
+		(*config.Config)(x).
+			Sub("scrub"),
+	)
+}
+
 // RefillMetabase returns the value of "resync_metabase" config parameter.
 //
 // Returns false if the value is not a valid bool.
@@ -0,0 +1,54 @@
+package scrubconfig
+
+import (
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's scrubbing configurations.
+type Config config.Config
+
+const (
+	// RateLimitDefault is a default number of objects verified by the scrubbing per second.
+	RateLimitDefault = 100
+)
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Interval returns the value of "interval" config parameter.
+//
+// Returns 0 if the value is not a positive number,
+// periodic scrubbing is disabled in this case.
+func (x *Config) Interval() time.Duration {
+	v := config.DurationSafe(
+		(*config.Config)(x),
+		"interval",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return 0
+}
+
+// RateLimit returns the value of "rate_limit" config parameter.
+//
+// Returns RateLimitDefault if the value is not a positive number.
+func (x *Config) RateLimit() uint32 {
+	v := config.Uint32Safe(
+		(*config.Config)(x),
+		"rate_limit",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return RateLimitDefault
+}
@@ -71,3 +71,11 @@ func (c TreeConfig) ReplicationWorkerCount() int {
 func (c TreeConfig) SyncInterval() time.Duration {
 	return config.DurationSafe(c.cfg, "sync_interval")
 }
+
+// LogCompaction returns the value of "log_compaction"
+// config parameter from the "tree" section.
+//
+// Returns `false` if config value is not specified.
+func (c TreeConfig) LogCompaction() bool {
+	return config.BoolSafe(c.cfg, "log_compaction")
+}
@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
+	"io"
 	"net"
 
 	controlconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/control"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
 	controlSvc "github.com/TrueCloudLab/frostfs-node/pkg/services/control/server"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/tree"
@@ -21,6 +24,20 @@ func (t treeSynchronizer) Synchronize(ctx context.Context, cnr cid.ID, treeID st
 	return t.treeSvc.SynchronizeTree(ctx, cnr, treeID)
 }
 
+func (t treeSynchronizer) ReplicateTree(ctx context.Context, cnr cid.ID, treeID string, forest pilorama.Forest) error {
+	if t.treeSvc == nil {
+		return errors.New("tree service is disabled")
+	}
+	return t.treeSvc.ReplicateTree(ctx, cnr, treeID, forest)
+}
+
+func (t treeSynchronizer) ImportTree(r io.Reader, forest pilorama.Forest) (pilorama.ExportInfo, error) {
+	if t.treeSvc == nil {
+		return pilorama.ImportTree(r, forest)
+	}
+	return t.treeSvc.ImportTree(r, forest)
+}
+
 func initControlService(c *cfg) {
 	endpoint := controlconfig.GRPC(c.appCfg).Endpoint()
 	if endpoint == controlconfig.GRPCEndpointDefault {
@@ -64,6 +81,10 @@ func initControlService(c *cfg) {
 
 	control.RegisterControlServiceServer(c.cfgControlService.server, ctlSvc)
 
+	if err := ctlSvc.ResumeEvacuation(); err != nil {
+		c.log.Error("could not resume shard evacuation", zap.Error(err))
+	}
+
 	c.workers = append(c.workers, newWorkerFromFunc(func(ctx context.Context) {
 		runAndLog(c, "control", false, func(c *cfg) {
 			fatalOnErr(c.cfgControlService.server.Serve(lis))
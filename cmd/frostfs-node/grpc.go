@@ -8,6 +8,7 @@ import (
 	"time"
 
 	grpcconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/grpc"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -19,6 +20,8 @@ func initGRPC(c *cfg) {
 	grpcconfig.IterateEndpoints(c.appCfg, func(sc *grpcconfig.Config) {
 		serverOpts := []grpc.ServerOption{
 			grpc.MaxSendMsgSize(maxMsgSize),
+			grpc.UnaryInterceptor(tracing.NewUnaryServerInterceptor()),
+			grpc.StreamInterceptor(tracing.NewStreamServerInterceptor()),
 		}
 
 		tlsCfg := sc.TLS()
@@ -88,6 +88,7 @@ func initApp(c *cfg) {
 		fatalOnErr(c.cfgObject.cfgLocalStorage.localStorage.Init())
 	})
 
+	initAndLog(c, "tracing", initTracing)
 	initAndLog(c, "gRPC", initGRPC)
 	initAndLog(c, "netmap", initNetmapService)
 	initAndLog(c, "accounting", initAccountingService)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 
@@ -71,7 +72,7 @@ func (n *notificationSource) processAddress(
 	var prm engine.HeadPrm
 	prm.WithAddress(a)
 
-	res, err := n.e.Head(prm)
+	res, err := n.e.Head(context.Background(), prm)
 	if err != nil {
 		return err
 	}
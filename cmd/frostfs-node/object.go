@@ -27,6 +27,8 @@ import (
 	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
 	getsvcV2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get/v2"
 	headsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/head"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
+	patchsvcV2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch/v2"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	putsvcV2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put/v2"
 	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
@@ -56,6 +58,8 @@ type objectSvc struct {
 	get *getsvcV2.Service
 
 	delete *deletesvcV2.Service
+
+	patch *patchsvcV2.Service
 }
 
 func (c *cfg) MaxObjectSize() uint64 {
@@ -89,6 +93,10 @@ func (s *objectSvc) Delete(ctx context.Context, req *object.DeleteRequest) (*obj
 	return s.delete.Delete(ctx, req)
 }
 
+func (s *objectSvc) Patch(ctx context.Context) (objectService.PatchObjectStream, error) {
+	return s.patch.Patch(ctx)
+}
+
 func (s *objectSvc) GetRange(req *object.GetRangeRequest, stream objectService.GetObjectRangeStream) error {
 	return s.get.GetRange(req, stream)
 }
@@ -215,6 +223,11 @@ func initObjectService(c *cfg) {
 		policer.WithRemoteHeader(
 			headsvc.NewRemoteHeader(keyStorage, clientConstructor),
 		),
+		policer.WithRemoteChunkReader(
+			headsvc.NewRemoteChunkReader(keyStorage, clientConstructor),
+		),
+		policer.WithKeyStorage(keyStorage),
+		policer.WithNetworkState(c.cfgNetmap.state),
 		policer.WithNetmapKeys(c),
 		policer.WithHeadTimeout(
 			policerconfig.HeadTimeout(c.appCfg),
@@ -300,6 +313,7 @@ func initObjectService(c *cfg) {
 		),
 		getsvc.WithNetMapSource(c.netMapSource),
 		getsvc.WithKeyStorage(keyStorage),
+		getsvc.WithContainerSource(c.cfgObject.cnrSource),
 	)
 
 	*c.cfgObject.getSvc = *sGet // need smth better
@@ -321,12 +335,23 @@ func initObjectService(c *cfg) {
 			cfg: c,
 		}),
 		deletesvc.WithKeyStorage(keyStorage),
+		deletesvc.WithContainerSource(c.cfgObject.cnrSource),
 	)
 
 	sDeleteV2 := deletesvcV2.NewService(
 		deletesvcV2.WithInternalService(sDelete),
 	)
 
+	sPatch := patchsvc.New(
+		patchsvc.WithLogger(c.log),
+		patchsvc.WithGetService(sGet),
+		patchsvc.WithPutService(sPut),
+	)
+
+	sPatchV2 := patchsvcV2.NewService(
+		patchsvcV2.WithInternalService(sPatch),
+	)
+
 	// build service pipeline
 	// grpc | <metrics> | signature | response | acl | split
 
@@ -338,6 +363,7 @@ func initObjectService(c *cfg) {
 			search: sSearchV2,
 			get:    sGetV2,
 			delete: sDeleteV2,
+			patch:  sPatchV2,
 		},
 	)
 
@@ -381,6 +407,7 @@ func initObjectService(c *cfg) {
 
 	for _, srv := range c.cfgGRPC.servers {
 		objectGRPC.RegisterObjectServiceServer(srv, server)
+		patchsvc.RegisterPatchServiceServer(srv, server)
 	}
 }
 
@@ -552,8 +579,8 @@ func (e engineWithNotifications) Lock(locker oid.Address, toLock []oid.ID) error
 	return e.base.Lock(locker, toLock)
 }
 
-func (e engineWithNotifications) Put(o *objectSDK.Object) error {
-	if err := e.base.Put(o); err != nil {
+func (e engineWithNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	if err := e.base.Put(ctx, o); err != nil {
 		return err
 	}
 
@@ -596,6 +623,6 @@ func (e engineWithoutNotifications) Lock(locker oid.Address, toLock []oid.ID) er
 	return e.engine.Lock(locker.Container(), locker.Object(), toLock)
 }
 
-func (e engineWithoutNotifications) Put(o *objectSDK.Object) error {
-	return engine.Put(e.engine, o)
+func (e engineWithoutNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	return engine.Put(ctx, e.engine, o)
 }
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	tracingconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/tracing"
+	"github.com/TrueCloudLab/frostfs-node/misc"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
+	"go.uber.org/zap"
+)
+
+const tracingShutdownTimeout = 5 * time.Second
+
+func initTracing(c *cfg) {
+	shutdown, err := tracing.Setup(c.ctx, tracing.Config{
+		Enabled:    tracingconfig.Enabled(c.appCfg),
+		Exporter:   tracingconfig.Exporter(c.appCfg),
+		Endpoint:   tracingconfig.Endpoint(c.appCfg),
+		Service:    "frostfs-node",
+		InstanceID: hex.EncodeToString(c.key.PublicKey().Bytes()),
+		Version:    misc.Version,
+	})
+	fatalOnErr(err)
+
+	c.onShutdown(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		if err := shutdown(ctx); err != nil {
+			c.log.Error("could not shutdown tracing",
+				zap.String("error", err.Error()),
+			)
+		}
+	})
+}
@@ -54,7 +54,8 @@ func initTreeService(c *cfg) {
 		tree.WithContainerCacheSize(treeConfig.CacheSize()),
 		tree.WithReplicationTimeout(treeConfig.ReplicationTimeout()),
 		tree.WithReplicationChannelCapacity(treeConfig.ReplicationChannelCapacity()),
-		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()))
+		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()),
+		tree.WithLogCompaction(treeConfig.LogCompaction()))
 
 	for _, srv := range c.cfgGRPC.servers {
 		tree.RegisterTreeServiceServer(srv, c.treeService)
@@ -0,0 +1,59 @@
+package object
+
+import (
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+)
+
+// Numeric search match types. Filter value and the header value are compared
+// as decimal integers, headers with non-integer values never match.
+//
+// SDK doesn't support them yet, so values are chosen to continue the
+// protocol MatchType enumeration.
+const (
+	// MatchNumGT matches header values greater than the filter value.
+	MatchNumGT object.SearchMatchType = object.MatchCommonPrefix + 1 + iota
+	// MatchNumGE matches header values greater than or equal to the filter value.
+	MatchNumGE
+	// MatchNumLT matches header values less than the filter value.
+	MatchNumLT
+	// MatchNumLE matches header values less than or equal to the filter value.
+	MatchNumLE
+)
+
+// IsNumericMatch returns true if m is one of the numeric match types.
+func IsNumericMatch(m object.SearchMatchType) bool {
+	return MatchNumGT <= m && m <= MatchNumLE
+}
+
+// SearchFiltersFromV2 converts search filters from the protocol
+// representation. Unlike object.NewSearchFiltersFromV2 it keeps
+// the numeric match types.
+func SearchFiltersFromV2(v2 []objectV2.SearchFilter) object.SearchFilters {
+	fs := object.NewSearchFiltersFromV2(v2)
+
+	for i := range v2 {
+		if m := object.SearchMatchType(v2[i].GetMatchType()); IsNumericMatch(m) {
+			var f object.SearchFilters
+			f.AddFilter(v2[i].GetKey(), v2[i].GetValue(), m)
+			fs[i] = f[0]
+		}
+	}
+
+	return fs
+}
+
+// SearchFiltersToV2 converts search filters to the protocol
+// representation. Unlike object.SearchFilters.ToV2 it keeps
+// the numeric match types.
+func SearchFiltersToV2(fs object.SearchFilters) []objectV2.SearchFilter {
+	v2 := fs.ToV2()
+
+	for i := range fs {
+		if IsNumericMatch(fs[i].Operation()) {
+			v2[i].SetMatchType(objectV2.MatchType(fs[i].Operation()))
+		}
+	}
+
+	return v2
+}
@@ -0,0 +1,49 @@
+package object
+
+import (
+	"testing"
+
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchFiltersFromV2(t *testing.T) {
+	v2 := make([]objectV2.SearchFilter, 3)
+	v2[0].SetKey("Timestamp")
+	v2[0].SetValue("100")
+	v2[0].SetMatchType(objectV2.MatchType(MatchNumLE))
+	v2[1].SetKey("FileName")
+	v2[1].SetValue("cat.jpg")
+	v2[1].SetMatchType(objectV2.MatchStringEqual)
+	v2[2].SetKey("Unknown")
+	v2[2].SetMatchType(objectV2.MatchType(MatchNumLE + 1))
+
+	fs := SearchFiltersFromV2(v2)
+	require.Len(t, fs, 3)
+
+	require.Equal(t, "Timestamp", fs[0].Header())
+	require.Equal(t, "100", fs[0].Value())
+	require.Equal(t, MatchNumLE, fs[0].Operation())
+	require.True(t, IsNumericMatch(fs[0].Operation()))
+
+	require.Equal(t, "FileName", fs[1].Header())
+	require.Equal(t, object.MatchStringEqual, fs[1].Operation())
+	require.False(t, IsNumericMatch(fs[1].Operation()))
+
+	require.Equal(t, object.MatchUnknown, fs[2].Operation())
+}
+
+func TestSearchFiltersToV2(t *testing.T) {
+	var fs object.SearchFilters
+	fs.AddFilter("Timestamp", "100", MatchNumGT)
+	fs.AddFilter("FileName", "cat.jpg", object.MatchStringEqual)
+
+	v2 := SearchFiltersToV2(fs)
+	require.Len(t, v2, 2)
+	require.Equal(t, objectV2.MatchType(MatchNumGT), v2[0].GetMatchType())
+	require.Equal(t, "100", v2[0].GetValue())
+	require.Equal(t, objectV2.MatchStringEqual, v2[1].GetMatchType())
+
+	require.Equal(t, fs, SearchFiltersFromV2(v2))
+}
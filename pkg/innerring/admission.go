@@ -0,0 +1,63 @@
+package innerring
+
+import (
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap/nodevalidation/policy"
+	apinetmap "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+)
+
+// admissionValidator is a netmap.NodeValidator checking nodes against
+// the admission policy file which can be reread at runtime.
+type admissionValidator struct {
+	mtx     sync.RWMutex
+	network policy.NetworkSource
+	v       netmap.NodeValidator // nil if the policy is not set
+}
+
+func newAdmissionValidator(path string, network policy.NetworkSource) (*admissionValidator, error) {
+	v := &admissionValidator{
+		network: network,
+	}
+
+	return v, v.reload(path)
+}
+
+// VerifyAndUpdate passes the node to the validator of the current admission
+// policy. Does nothing if the policy is not set.
+func (x *admissionValidator) VerifyAndUpdate(n *apinetmap.NodeInfo) error {
+	x.mtx.RLock()
+	defer x.mtx.RUnlock()
+
+	if x.v == nil {
+		return nil
+	}
+
+	return x.v.VerifyAndUpdate(n)
+}
+
+// reload reads the admission policy file located at the path and replaces
+// the current policy with it. Empty path disables the policy. The current
+// policy is kept if the file is invalid.
+func (x *admissionValidator) reload(path string) error {
+	var v netmap.NodeValidator
+
+	if path != "" {
+		p, err := policy.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		v = policy.New(policy.Prm{
+			Policy:  p,
+			Network: x.network,
+		})
+	}
+
+	x.mtx.Lock()
+	x.v = v
+	x.mtx.Unlock()
+
+	return nil
+}
@@ -40,7 +40,7 @@ func connectListenerWithProcessor(l event.Listener, p ContractProcessor) {
 
 // bindMorphProcessor connects morph chain listener handlers.
 func bindMorphProcessor(proc ContractProcessor, s *Server) error {
-	connectListenerWithProcessor(s.morphListener, proc)
+	connectListenerWithProcessor(s.morphListener, notaryTrackedProcessor{proc, s.notaryRequests})
 	return nil
 }
 
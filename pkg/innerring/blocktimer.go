@@ -53,6 +53,14 @@ type (
 	awaiter   func(context.Context, util.Uint256) error
 )
 
+// Indexes of the epoch timer delta-interval handlers
+// in the order of their registration.
+const (
+	stopEstimationDelta = iota
+	collectBasicIncomeDelta
+	distributeBasicIncomeDelta
+)
+
 func (s *Server) addBlockTimer(t *timer.BlockTimer) {
 	s.blockTimers = append(s.blockTimers, t)
 }
@@ -0,0 +1,186 @@
+package innerring
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
+	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
+	control "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	"github.com/panjf2000/ants/v2"
+	"go.uber.org/atomic"
+)
+
+// Names of the event processors, the processors label
+// their metrics with the same names.
+const (
+	netmapProcessorName     = processors.NetmapProcessorName
+	containerProcessorName  = processors.ContainerProcessorName
+	balanceProcessorName    = processors.BalanceProcessorName
+	frostfsProcessorName    = processors.FrostFSProcessorName
+	alphabetProcessorName   = processors.AlphabetProcessorName
+	reputationProcessorName = processors.ReputationProcessorName
+	governanceProcessorName = processors.GovernanceProcessorName
+	auditProcessorName      = processors.AuditProcessorName
+	settlementProcessorName = processors.SettlementProcessorName
+)
+
+var errNotAlphabet = errors.New("node is not in the alphabet")
+
+type (
+	// processorState is an event processor
+	// managed via the Control service.
+	processorState struct {
+		name string
+		pool *ants.Pool
+
+		// enabled is nil if the processor can't be disabled
+		enabled *atomic.Bool
+	}
+
+	// switchableProcessor is a ContractProcessor which
+	// handlers are executed only if it is enabled.
+	switchableProcessor struct {
+		ContractProcessor
+
+		enabled *atomic.Bool
+	}
+)
+
+// addProcessor registers the event processor and returns the flag
+// to check whether it is enabled if the processor is switchable.
+func (s *Server) addProcessor(name string, pool *ants.Pool, switchable bool) *atomic.Bool {
+	p := processorState{
+		name: name,
+		pool: pool,
+	}
+
+	if switchable {
+		p.enabled = atomic.NewBool(true)
+	}
+
+	s.processors = append(s.processors, p)
+
+	return p.enabled
+}
+
+// onlyEnabledEventHandler wrapper around event handler that executes it
+// only if the processor is enabled.
+func onlyEnabledEventHandler(enabled *atomic.Bool, f event.Handler) event.Handler {
+	return func(ev event.Event) {
+		if enabled.Load() {
+			f(ev)
+		}
+	}
+}
+
+// ListenerNotificationHandlers wraps the notification handlers of the processor.
+func (p switchableProcessor) ListenerNotificationHandlers() []event.NotificationHandlerInfo {
+	hh := p.ContractProcessor.ListenerNotificationHandlers()
+	for i := range hh {
+		hh[i].SetHandler(onlyEnabledEventHandler(p.enabled, hh[i].Handler()))
+	}
+
+	return hh
+}
+
+// ListenerNotaryHandlers wraps the notary handlers of the processor.
+func (p switchableProcessor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
+	hh := p.ContractProcessor.ListenerNotaryHandlers()
+	for i := range hh {
+		hh[i].SetHandler(onlyEnabledEventHandler(p.enabled, hh[i].Handler()))
+	}
+
+	return hh
+}
+
+// Processors returns event processors of the IR node.
+func (s *Server) Processors() []*control.ProcessorInfo {
+	res := make([]*control.ProcessorInfo, 0, len(s.processors))
+	for _, p := range s.processors {
+		info := new(control.ProcessorInfo)
+		info.SetName(p.name)
+		info.SetSwitchable(p.enabled != nil)
+		info.SetEnabled(p.enabled == nil || p.enabled.Load())
+		info.SetPoolCapacity(uint32(p.pool.Cap()))
+		info.SetPoolRunning(uint32(p.pool.Running()))
+		info.SetPoolQueue(uint32(p.pool.Waiting()))
+
+		res = append(res, info)
+	}
+
+	return res
+}
+
+// SetProcessorEnabled enables or disables the event processor.
+func (s *Server) SetProcessorEnabled(name string, enabled bool) error {
+	for _, p := range s.processors {
+		if p.name != name {
+			continue
+		}
+
+		if p.enabled == nil {
+			return fmt.Errorf("processor %s can't be disabled", name)
+		}
+
+		p.enabled.Store(enabled)
+
+		return nil
+	}
+
+	return fmt.Errorf("unknown processor %s", name)
+}
+
+// TickEpoch votes for the next epoch.
+func (s *Server) TickEpoch() (uint64, error) {
+	if !s.IsAlphabet() {
+		return 0, errNotAlphabet
+	}
+
+	epoch := s.EpochCounter() + 1
+
+	err := s.netmapClient.NewEpoch(epoch)
+	if err != nil {
+		return 0, fmt.Errorf("can't invoke netmap.NewEpoch: %w", err)
+	}
+
+	return epoch, nil
+}
+
+// RemoveNode votes for the storage node removal from the network map.
+func (s *Server) RemoveNode(key []byte) error {
+	if !s.IsAlphabet() {
+		return errNotAlphabet
+	}
+
+	nm, err := s.netmapClient.NetMap()
+	if err != nil {
+		return fmt.Errorf("can't get network map: %w", err)
+	}
+
+	var found bool
+
+	nodes := nm.Nodes()
+	for i := range nodes {
+		if found = bytes.Equal(nodes[i].PublicKey(), key); found {
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("node %s is not in the network map", hex.EncodeToString(key))
+	}
+
+	var prm nmClient.UpdatePeerPrm
+	prm.SetKey(key)
+
+	err = s.netmapClient.UpdatePeerState(prm)
+	if err != nil {
+		return fmt.Errorf("can't invoke netmap.UpdateState: %w", err)
+	}
+
+	return nil
+}
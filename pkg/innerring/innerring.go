@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sync"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/config"
+	irmetrics "github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/alphabet"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/audit"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/balance"
@@ -50,6 +52,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/panjf2000/ants/v2"
 	"github.com/spf13/viper"
 	"go.uber.org/atomic"
@@ -68,6 +71,7 @@ type (
 		mainnetListener event.Listener
 		blockTimers     []*timer.BlockTimer
 		epochTimer      *timer.BlockTimer
+		emissionTimer   *timer.BlockTimer
 
 		// global state
 		morphClient   *client.Client
@@ -83,7 +87,7 @@ type (
 		persistate    *state.PersistentStorage
 
 		// metrics
-		metrics *metrics.InnerRingServiceMetrics
+		metrics irmetrics.Register
 
 		// notary configuration
 		feeConfig        *config.FeeConfig
@@ -101,6 +105,26 @@ type (
 		// runtime processors
 		netmapProcessor *netmap.Processor
 
+		// processors managed via the Control service
+		processors []processorState
+
+		// side chain notary requests handled by the processors
+		notaryRequests *notaryRequests
+
+		// components reconfigured on Reload
+		auditPool          *ants.Pool
+		auditTaskManager   *audittask.Manager
+		auditProcessor     *audit.Processor
+		clientCache        *ClientCache
+		locodeValidator    *locodeValidator
+		admissionValidator *admissionValidator
+		pdpPoolSize        atomic.Int64
+		porPoolSize        atomic.Int64
+		emitDuration       uint32
+
+		// reloadMtx serializes Reload calls
+		reloadMtx sync.Mutex
+
 		workers []func(context.Context)
 
 		// Set of local resources that must be
@@ -135,6 +159,8 @@ type (
 		name string
 		sgn  *transaction.Signer
 		from uint32 // block height
+
+		notarySignCb func(util.Uint256)
 	}
 )
 
@@ -244,6 +270,7 @@ func (s *Server) Start(ctx context.Context, intError chan<- error) (err error) {
 		}
 
 		s.tickTimers(b.Index)
+		s.notaryRequests.expire(b.Index)
 	})
 
 	if !s.withoutMainNet {
@@ -319,7 +346,16 @@ func (s *Server) registerStarter(f func() error) {
 // New creates instance of inner ring sever structure.
 func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan<- error) (*Server, error) {
 	var err error
-	server := &Server{log: log}
+	server := &Server{
+		log:     log,
+		metrics: irmetrics.DefaultRegister{},
+	}
+
+	if cfg.GetBool("prometheus.enabled") {
+		server.metrics = metrics.NewInnerRingMetrics()
+	}
+
+	server.notaryRequests = newNotaryRequests(server.metrics)
 
 	server.setHealthStatus(control.HealthStatus_HEALTH_STATUS_UNDEFINED)
 
@@ -355,6 +391,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		key:  server.key,
 		name: morphPrefix,
 		from: fromSideChainBlock,
+
+		notarySignCb: server.notaryRequests.markSigned,
 	}
 
 	// create morph client
@@ -459,7 +497,7 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 
 	server.pubKey = server.key.PublicKey().Bytes()
 
-	auditPool, err := ants.NewPool(cfg.GetInt("audit.task.exec_pool_size"))
+	server.auditPool, err = ants.NewPool(cfg.GetInt("audit.task.exec_pool_size"))
 	if err != nil {
 		return nil, err
 	}
@@ -554,7 +592,7 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		cfg.GetDuration("indexer.cache_timeout"),
 	)
 
-	clientCache := newClientCache(&clientCacheParams{
+	server.clientCache = newClientCache(&clientCacheParams{
 		Log:           log,
 		Key:           &server.key.PrivateKey,
 		SGTimeout:     cfg.GetDuration("audit.timeout.get"),
@@ -563,53 +601,57 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		AllowExternal: cfg.GetBool("audit.allow_external"),
 	})
 
-	server.registerNoErrCloser(clientCache.cache.CloseAll)
+	server.registerNoErrCloser(server.clientCache.cache.CloseAll)
 
-	pdpPoolSize := cfg.GetInt("audit.pdp.pairs_pool_size")
-	porPoolSize := cfg.GetInt("audit.por.pool_size")
+	server.pdpPoolSize.Store(cfg.GetInt64("audit.pdp.pairs_pool_size"))
+	server.porPoolSize.Store(cfg.GetInt64("audit.por.pool_size"))
 
 	// create audit processor dependencies
-	auditTaskManager := audittask.New(
+	server.auditTaskManager = audittask.New(
 		audittask.WithQueueCapacity(cfg.GetUint32("audit.task.queue_capacity")),
-		audittask.WithWorkerPool(auditPool),
+		audittask.WithWorkerPool(server.auditPool),
 		audittask.WithLogger(log),
-		audittask.WithContainerCommunicator(clientCache),
+		audittask.WithContainerCommunicator(server.clientCache),
 		audittask.WithMaxPDPSleepInterval(cfg.GetDuration("audit.pdp.max_sleep_interval")),
 		audittask.WithPDPWorkerPoolGenerator(func() (util2.WorkerPool, error) {
-			return ants.NewPool(pdpPoolSize)
+			return ants.NewPool(int(server.pdpPoolSize.Load()))
 		}),
 		audittask.WithPoRWorkerPoolGenerator(func() (util2.WorkerPool, error) {
-			return ants.NewPool(porPoolSize)
+			return ants.NewPool(int(server.porPoolSize.Load()))
 		}),
 	)
 
-	server.workers = append(server.workers, auditTaskManager.Listen)
+	server.workers = append(server.workers, server.auditTaskManager.Listen)
 
 	// create audit processor
-	auditProcessor, err := audit.New(&audit.Params{
+	server.auditProcessor, err = audit.New(&audit.Params{
 		Log:              log,
+		Metrics:          server.metrics,
 		NetmapClient:     server.netmapClient,
 		ContainerClient:  cnrClient,
 		IRList:           server,
 		EpochSource:      server,
-		SGSource:         clientCache,
+		SGSource:         server.clientCache,
 		Key:              &server.key.PrivateKey,
 		RPCSearchTimeout: cfg.GetDuration("audit.timeout.search"),
-		TaskManager:      auditTaskManager,
+		TaskManager:      server.auditTaskManager,
 		Reporter:         server,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	auditEnabled := server.addProcessor(auditProcessorName, server.auditProcessor.WorkerPool(), true)
+
 	// create settlement processor dependencies
 	settlementDeps := settlementDeps{
 		log:           server.log,
 		cnrSrc:        cntClient.AsContainerSource(cnrClient),
 		auditClient:   server.auditClient,
 		nmClient:      server.netmapClient,
-		clientCache:   clientCache,
+		clientCache:   server.clientCache,
 		balanceClient: server.balanceClient,
+		metrics:       server.metrics,
 	}
 
 	settlementDeps.settlementCtx = auditSettlementContext
@@ -644,13 +686,21 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 			State:          server,
 		},
 		settlement.WithLogger(server.log),
+		settlement.WithMetrics(server.metrics),
 	)
 
-	locodeValidator, err := server.newLocodeValidator(cfg)
+	settlementEnabled := server.addProcessor(settlementProcessorName, settlementProcessor.WorkerPool(), true)
+
+	server.locodeValidator, err = server.newLocodeValidator(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	server.admissionValidator, err = newAdmissionValidator(cfg.GetString("admission_policy.path"), server.netmapClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not load node admission policy: %w", err)
+	}
+
 	subnetValidator, err := subnetvalidator.New(
 		subnetvalidator.Prm{
 			SubnetClient: subnetClient,
@@ -670,6 +720,7 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		// create governance processor
 		governanceProcessor, err := governance.New(&governance.Params{
 			Log:            log,
+			Metrics:        server.metrics,
 			FrostFSClient:  frostfsCli,
 			NetmapClient:   server.netmapClient,
 			AlphabetState:  server,
@@ -685,6 +736,7 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		}
 
 		alphaSync = governanceProcessor.HandleAlphabetSync
+		server.addProcessor(governanceProcessorName, governanceProcessor.WorkerPool(), false)
 		err = bindMainnetProcessor(governanceProcessor, server)
 		if err != nil {
 			return nil, err
@@ -699,6 +751,7 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 	// create netmap processor
 	server.netmapProcessor, err = netmap.New(&netmap.Params{
 		Log:              log,
+		Metrics:          server.metrics,
 		PoolSize:         cfg.GetInt("workers.netmap"),
 		NetmapClient:     server.netmapClient,
 		EpochTimer:       server,
@@ -708,20 +761,21 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		CleanupThreshold: cfg.GetUint64("netmap_cleaner.threshold"),
 		ContainerWrapper: cnrClient,
 		HandleAudit: server.onlyActiveEventHandler(
-			auditProcessor.StartAuditHandler(),
+			onlyEnabledEventHandler(auditEnabled, server.auditProcessor.StartAuditHandler()),
 		),
 		NotaryDepositHandler: server.onlyAlphabetEventHandler(
 			server.notaryHandler,
 		),
 		AuditSettlementsHandler: server.onlyAlphabetEventHandler(
-			settlementProcessor.HandleAuditEvent,
+			onlyEnabledEventHandler(settlementEnabled, settlementProcessor.HandleAuditEvent),
 		),
 		AlphabetSyncHandler: alphaSync,
 		NodeValidator: nodevalidator.New(
 			&netMapCandidateStateValidator,
 			addrvalidator.New(),
-			locodeValidator,
+			server.locodeValidator,
 			subnetValidator,
+			server.admissionValidator,
 		),
 		NotaryDisabled: server.sideNotaryConfig.disabled,
 		SubnetContract: &server.contracts.subnet,
@@ -737,9 +791,12 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessor(netmapProcessorName, server.netmapProcessor.WorkerPool(), false)
+
 	// container processor
 	containerProcessor, err := container.New(&container.Params{
 		Log:             log,
+		Metrics:         server.metrics,
 		PoolSize:        cfg.GetInt("workers.container"),
 		AlphabetState:   server,
 		ContainerClient: cnrClient,
@@ -757,9 +814,12 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessor(containerProcessorName, containerProcessor.WorkerPool(), false)
+
 	// create balance processor
 	balanceProcessor, err := balance.New(&balance.Params{
 		Log:           log,
+		Metrics:       server.metrics,
 		PoolSize:      cfg.GetInt("workers.balance"),
 		FrostFSClient: frostfsCli,
 		BalanceSC:     server.contracts.balance,
@@ -775,10 +835,13 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessor(balanceProcessorName, balanceProcessor.WorkerPool(), false)
+
 	if !server.withoutMainNet {
 		// create mainnnet frostfs processor
 		frostfsProcessor, err := frostfs.New(&frostfs.Params{
 			Log:                 log,
+			Metrics:             server.metrics,
 			PoolSize:            cfg.GetInt("workers.frostfs"),
 			FrostFSContract:     server.contracts.frostfs,
 			FrostFSIDClient:     frostfsIDClient,
@@ -801,11 +864,14 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		if err != nil {
 			return nil, err
 		}
+
+		server.addProcessor(frostfsProcessorName, frostfsProcessor.WorkerPool(), false)
 	}
 
 	// create alphabet processor
 	alphabetProcessor, err := alphabet.New(&alphabet.Params{
 		Log:               log,
+		Metrics:           server.metrics,
 		PoolSize:          cfg.GetInt("workers.alphabet"),
 		AlphabetContracts: server.contracts.alphabet,
 		NetmapClient:      server.netmapClient,
@@ -822,9 +888,12 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessor(alphabetProcessorName, alphabetProcessor.WorkerPool(), false)
+
 	// create reputation processor
 	reputationProcessor, err := reputation.New(&reputation.Params{
 		Log:               log,
+		Metrics:           server.metrics,
 		PoolSize:          cfg.GetInt("workers.reputation"),
 		EpochState:        server,
 		AlphabetState:     server,
@@ -840,7 +909,9 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
-	err = bindMorphProcessor(reputationProcessor, server)
+	reputationEnabled := server.addProcessor(reputationProcessorName, reputationProcessor.WorkerPool(), true)
+
+	err = bindMorphProcessor(switchableProcessor{reputationProcessor, reputationEnabled}, server)
 	if err != nil {
 		return nil, err
 	}
@@ -854,12 +925,12 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		stopEstimationDMul: cfg.GetUint32("timers.stop_estimation.mul"),
 		stopEstimationDDiv: cfg.GetUint32("timers.stop_estimation.div"),
 		collectBasicIncome: subEpochEventHandler{
-			handler:     settlementProcessor.HandleIncomeCollectionEvent,
+			handler:     onlyEnabledEventHandler(settlementEnabled, settlementProcessor.HandleIncomeCollectionEvent),
 			durationMul: cfg.GetUint32("timers.collect_basic_income.mul"),
 			durationDiv: cfg.GetUint32("timers.collect_basic_income.div"),
 		},
 		distributeBasicIncome: subEpochEventHandler{
-			handler:     settlementProcessor.HandleIncomeDistributionEvent,
+			handler:     onlyEnabledEventHandler(settlementEnabled, settlementProcessor.HandleIncomeDistributionEvent),
 			durationMul: cfg.GetUint32("timers.distribute_basic_income.mul"),
 			durationDiv: cfg.GetUint32("timers.distribute_basic_income.div"),
 		},
@@ -868,12 +939,13 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 	server.addBlockTimer(server.epochTimer)
 
 	// initialize emission timer
-	emissionTimer := newEmissionTimer(&emitTimerArgs{
+	server.emitDuration = cfg.GetUint32("timers.emit")
+	server.emissionTimer = newEmissionTimer(&emitTimerArgs{
 		ap:           alphabetProcessor,
-		emitDuration: cfg.GetUint32("timers.emit"),
+		emitDuration: server.emitDuration,
 	})
 
-	server.addBlockTimer(emissionTimer)
+	server.addBlockTimer(server.emissionTimer)
 
 	controlSvcEndpoint := cfg.GetString("control.grpc.endpoint")
 	if controlSvcEndpoint != "" {
@@ -896,6 +968,9 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 
 		p.SetPrivateKey(*server.key)
 		p.SetHealthChecker(server)
+		p.SetNetmapManager(server)
+		p.SetNotaryRequestSource(server.notaryRequests)
+		p.SetProcessorManager(server)
 
 		controlSvc := controlsrv.New(p,
 			controlsrv.WithAllowedKeys(authKeys),
@@ -925,11 +1000,6 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		queueSize: cfg.GetUint32("workers.subnet"),
 	})
 
-	if cfg.GetString("metrics.address") != "" {
-		m := metrics.NewInnerRingMetrics()
-		server.metrics = &m
-	}
-
 	return server, nil
 }
 
@@ -969,20 +1039,41 @@ func createListener(ctx context.Context, cli *client.Client, p *chainParams) (ev
 }
 
 func createClient(ctx context.Context, p *chainParams, errChan chan<- error) (*client.Client, error) {
+	endpoints, err := parseEndpoints(p.cfg, p.name)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(
+		p.key,
+		client.WithContext(ctx),
+		client.WithLogger(p.log),
+		client.WithDialTimeout(p.cfg.GetDuration(p.name+".dial_timeout")),
+		client.WithSigner(p.sgn),
+		client.WithEndpoints(endpoints...),
+		client.WithConnLostCallback(func() {
+			errChan <- fmt.Errorf("%s chain connection has been lost", p.name)
+		}),
+		client.WithSwitchInterval(p.cfg.GetDuration(p.name+".switch_interval")),
+		client.WithNotarySignCallback(p.notarySignCb),
+	)
+}
+
+func parseEndpoints(cfg *viper.Viper, chain string) ([]client.Endpoint, error) {
 	// config name left unchanged for compatibility, may be its better to rename it to "endpoints" or "clients"
 	var endpoints []client.Endpoint
 
 	// defaultPriority is a default endpoint priority
 	const defaultPriority = 1
 
-	section := p.name + ".endpoint.client"
+	section := chain + ".endpoint.client"
 	for i := 0; ; i++ {
-		addr := p.cfg.GetString(fmt.Sprintf("%s.%d.%s", section, i, "address"))
+		addr := cfg.GetString(fmt.Sprintf("%s.%d.%s", section, i, "address"))
 		if addr == "" {
 			break
 		}
 
-		priority := p.cfg.GetInt(section + ".priority")
+		priority := cfg.GetInt(section + ".priority")
 		if priority <= 0 {
 			priority = defaultPriority
 		}
@@ -994,21 +1085,10 @@ func createClient(ctx context.Context, p *chainParams, errChan chan<- error) (*c
 	}
 
 	if len(endpoints) == 0 {
-		return nil, fmt.Errorf("%s chain client endpoints not provided", p.name)
+		return nil, fmt.Errorf("%s chain client endpoints not provided", chain)
 	}
 
-	return client.New(
-		p.key,
-		client.WithContext(ctx),
-		client.WithLogger(p.log),
-		client.WithDialTimeout(p.cfg.GetDuration(p.name+".dial_timeout")),
-		client.WithSigner(p.sgn),
-		client.WithEndpoints(endpoints...),
-		client.WithConnLostCallback(func() {
-			errChan <- fmt.Errorf("%s chain connection has been lost", p.name)
-		}),
-		client.WithSwitchInterval(p.cfg.GetDuration(p.name+".switch_interval")),
-	)
+	return endpoints, nil
 }
 
 func parsePredefinedValidators(cfg *viper.Viper) (keys.PublicKeys, error) {
@@ -1,27 +1,98 @@
 package innerring
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap"
 	irlocode "github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap/nodevalidation/locode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/locode"
 	locodedb "github.com/TrueCloudLab/frostfs-node/pkg/util/locode/db"
 	locodebolt "github.com/TrueCloudLab/frostfs-node/pkg/util/locode/db/boltdb"
+	apinetmap "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
 	"github.com/spf13/viper"
 )
 
-func (s *Server) newLocodeValidator(cfg *viper.Viper) (netmap.NodeValidator, error) {
-	locodeDB := locodebolt.New(locodebolt.Prm{
-		Path: cfg.GetString("locode.db.path"),
+// locodeValidator is a netmap.NodeValidator checking node locations
+// against the LOCODE database which can be replaced at runtime.
+type locodeValidator struct {
+	mtx  sync.RWMutex
+	path string
+	db   *locodebolt.DB
+	v    netmap.NodeValidator
+}
+
+func (s *Server) newLocodeValidator(cfg *viper.Viper) (*locodeValidator, error) {
+	v := new(locodeValidator)
+	v.set(cfg.GetString("locode.db.path"))
+
+	s.registerStarter(v.open)
+	s.registerIOCloser(v)
+
+	return v, nil
+}
+
+func (x *locodeValidator) set(path string) {
+	x.path = path
+	x.db = locodebolt.New(locodebolt.Prm{
+		Path: path,
 	},
 		locodebolt.ReadOnly(),
 	)
+	x.v = irlocode.New(irlocode.Prm{
+		DB: (*locodeBoltDBWrapper)(x.db),
+	})
+}
+
+func (x *locodeValidator) open() error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	return x.db.Open()
+}
+
+// Close closes the current LOCODE database.
+func (x *locodeValidator) Close() error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	return x.db.Close()
+}
 
-	s.registerStarter(locodeDB.Open)
-	s.registerIOCloser(locodeDB)
+// VerifyAndUpdate passes the node to the validator of the current LOCODE database.
+func (x *locodeValidator) VerifyAndUpdate(n *apinetmap.NodeInfo) error {
+	x.mtx.RLock()
+	defer x.mtx.RUnlock()
+
+	return x.v.VerifyAndUpdate(n)
+}
+
+// reload opens the LOCODE database located at the path and replaces the
+// current one with it. Does nothing if the path has not been changed.
+func (x *locodeValidator) reload(path string) error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if path == x.path {
+		return nil
+	}
+
+	prevPath, prevDB, prevV := x.path, x.db, x.v
+
+	x.set(path)
+
+	err := x.db.Open()
+	if err != nil {
+		x.path, x.db, x.v = prevPath, prevDB, prevV
+		return fmt.Errorf("can't open LOCODE database: %w", err)
+	}
+
+	err = prevDB.Close()
+	if err != nil {
+		return fmt.Errorf("can't close previous LOCODE database: %w", err)
+	}
 
-	return irlocode.New(irlocode.Prm{
-		DB: (*locodeBoltDBWrapper)(locodeDB),
-	}), nil
+	return nil
 }
 
 type locodeBoltEntryWrapper struct {
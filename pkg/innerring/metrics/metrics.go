@@ -0,0 +1,58 @@
+package metrics
+
+import "time"
+
+// Register is an interface of the inner ring metrics storage.
+type Register interface {
+	// SetEpoch updates the current epoch.
+	SetEpoch(epoch uint64)
+
+	// AddReceivedEvent registers the event received by the processor.
+	AddReceivedEvent(processor, event string)
+	// AddDroppedEvent registers the event dropped by the processor
+	// because of the drained worker pool.
+	AddDroppedEvent(processor, event string)
+	// AddHandledEvent registers the event handled by the processor
+	// and the handling duration.
+	AddHandledEvent(processor, event string, d time.Duration)
+
+	// AddNotarySignedRequest registers the notary request
+	// signed by the node.
+	AddNotarySignedRequest(method string)
+
+	// AddEmission registers the invocation of the alphabet contract emission.
+	AddEmission()
+	// AddEmittedGAS registers the GAS transferred to a storage node
+	// as a part of the emission, amount is in GAS fractions (10^-8).
+	AddEmittedGAS(amount int64)
+
+	// AddAuditRound registers the audit round and its duration.
+	AddAuditRound(d time.Duration)
+	// AddAuditResult registers the written audit result.
+	AddAuditResult(result string)
+
+	// AddSettlementTransfer registers the settlement transfer of the given
+	// type, amount is in balance contract units (GAS 10^-12).
+	AddSettlementTransfer(typ string, amount int64, success bool)
+}
+
+// Audit result labels.
+const (
+	AuditResultPassed     = "passed"
+	AuditResultFailed     = "failed"
+	AuditResultIncomplete = "incomplete"
+)
+
+// DefaultRegister is a Register which discards all the metrics.
+type DefaultRegister struct{}
+
+func (DefaultRegister) SetEpoch(uint64)                               {}
+func (DefaultRegister) AddReceivedEvent(string, string)               {}
+func (DefaultRegister) AddDroppedEvent(string, string)                {}
+func (DefaultRegister) AddHandledEvent(string, string, time.Duration) {}
+func (DefaultRegister) AddNotarySignedRequest(string)                 {}
+func (DefaultRegister) AddEmission()                                  {}
+func (DefaultRegister) AddEmittedGAS(int64)                           {}
+func (DefaultRegister) AddAuditRound(time.Duration)                   {}
+func (DefaultRegister) AddAuditResult(string)                         {}
+func (DefaultRegister) AddSettlementTransfer(string, int64, bool)     {}
@@ -0,0 +1,145 @@
+package innerring
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
+	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
+	control "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/network/payload"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+)
+
+type (
+	// notaryRequests tracks side chain notary requests handled by
+	// the processors until their main transactions expire.
+	notaryRequests struct {
+		metrics metrics.Register
+
+		mtx  sync.Mutex
+		reqs map[util.Uint256]*notaryRequestInfo
+	}
+
+	notaryRequestInfo struct {
+		contract util.Uint160
+		method   string
+		vub      uint32
+		signed   bool
+	}
+
+	// notaryTrackedProcessor is a ContractProcessor which
+	// notary handlers record the handled requests.
+	notaryTrackedProcessor struct {
+		ContractProcessor
+
+		reqs *notaryRequests
+	}
+)
+
+func newNotaryRequests(m metrics.Register) *notaryRequests {
+	return &notaryRequests{
+		metrics: m,
+		reqs:    make(map[util.Uint256]*notaryRequestInfo),
+	}
+}
+
+// handler returns the handler recording the notary request of the event
+// before passing it to the handler from info.
+func (x *notaryRequests) handler(info event.NotaryHandlerInfo) event.Handler {
+	h := info.Handler()
+	contract := info.ScriptHash()
+	method := info.RequestType().String()
+
+	return func(e event.Event) {
+		if ne, ok := e.(interface {
+			NotaryRequest() *payload.P2PNotaryRequest
+		}); ok {
+			if nr := ne.NotaryRequest(); nr != nil {
+				x.add(nr.MainTransaction, contract, method)
+			}
+		}
+
+		h(e)
+	}
+}
+
+func (x *notaryRequests) add(mainTx *transaction.Transaction, contract util.Uint160, method string) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	hash := mainTx.Hash()
+	if _, ok := x.reqs[hash]; ok {
+		return
+	}
+
+	x.reqs[hash] = &notaryRequestInfo{
+		contract: contract,
+		method:   method,
+		vub:      mainTx.ValidUntilBlock,
+	}
+}
+
+// markSigned marks the request with the given main transaction hash as signed
+// by the node. Requests which haven't been handled by the processors are ignored.
+func (x *notaryRequests) markSigned(mainTx util.Uint256) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if r, ok := x.reqs[mainTx]; ok && !r.signed {
+		r.signed = true
+		x.metrics.AddNotarySignedRequest(r.method)
+	}
+}
+
+// expire removes the requests which main transactions can't
+// be accepted at the given height.
+func (x *notaryRequests) expire(height uint32) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	for hash, r := range x.reqs {
+		if r.vub < height {
+			delete(x.reqs, hash)
+		}
+	}
+}
+
+// NotaryRequests returns the tracked notary requests sorted
+// by the main transaction expiration height.
+func (x *notaryRequests) NotaryRequests() []*control.NotaryRequestInfo {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	res := make([]*control.NotaryRequestInfo, 0, len(x.reqs))
+	for hash, r := range x.reqs {
+		info := new(control.NotaryRequestInfo)
+		info.SetHash(hash.BytesBE())
+		info.SetContract(r.contract.BytesBE())
+		info.SetMethod(r.method)
+		info.SetValidUntilBlock(r.vub)
+		info.SetSigned(r.signed)
+
+		res = append(res, info)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].GetValidUntilBlock() != res[j].GetValidUntilBlock() {
+			return res[i].GetValidUntilBlock() < res[j].GetValidUntilBlock()
+		}
+		return string(res[i].GetHash()) < string(res[j].GetHash())
+	})
+
+	return res
+}
+
+// ListenerNotaryHandlers wraps the notary handlers of the processor.
+func (p notaryTrackedProcessor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
+	hh := p.ContractProcessor.ListenerNotaryHandlers()
+	for i := range hh {
+		hh[i].SetHandler(p.reqs.handler(hh[i]))
+	}
+
+	return hh
+}
@@ -1,6 +1,7 @@
 package alphabet
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/timers"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	"go.uber.org/zap"
@@ -12,7 +13,7 @@ func (ap *Processor) HandleGasEmission(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := ap.pool.Submit(func() { ap.processEmit() })
+	err := processors.SubmitEvent(ap.pool, ap.metrics, processors.AlphabetProcessorName, "gas_emission", func() { ap.processEmit() })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		ap.log.Warn("alphabet processor worker pool drained",
@@ -34,6 +34,8 @@ func (ap *Processor) processEmit() {
 		return
 	}
 
+	ap.metrics.AddEmission()
+
 	if ap.storageEmission == 0 {
 		ap.log.Info("storage node emission is off")
 
@@ -77,6 +79,10 @@ func (ap *Processor) processEmit() {
 				zap.Int64("amount", int64(gasPerNode)),
 				zap.String("error", err.Error()),
 			)
+
+			continue
 		}
+
+		ap.metrics.AddEmittedGAS(int64(gasPerNode))
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client"
 	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
@@ -35,6 +36,7 @@ type (
 	Processor struct {
 		log               *logger.Logger
 		pool              *ants.Pool
+		metrics           metrics.Register
 		alphabetContracts Contracts
 		netmapClient      *nmClient.Client
 		morphClient       *client.Client
@@ -45,6 +47,7 @@ type (
 	// Params of the processor constructor.
 	Params struct {
 		Log               *logger.Logger
+		Metrics           metrics.Register
 		PoolSize          int
 		AlphabetContracts Contracts
 		NetmapClient      *nmClient.Client
@@ -72,9 +75,15 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/frostfs: can't create worker pool: %w", err)
 	}
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:               p.Log,
 		pool:              pool,
+		metrics:           metricsRegister,
 		alphabetContracts: p.AlphabetContracts,
 		netmapClient:      p.NetmapClient,
 		morphClient:       p.MorphClient,
@@ -107,3 +116,8 @@ func (ap *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (ap *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (ap *Processor) WorkerPool() *ants.Pool {
+	return ap.pool
+}
@@ -1,6 +1,7 @@
 package audit
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	"go.uber.org/zap"
 )
@@ -14,7 +15,7 @@ func (ap *Processor) handleNewAuditRound(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := ap.pool.Submit(func() { ap.processStartAudit(epoch) })
+	err := processors.SubmitEvent(ap.pool, ap.metrics, processors.AuditProcessorName, "new_audit_round", func() { ap.processStartAudit(epoch) })
 	if err != nil {
 		ap.log.Warn("previous round of audit prepare hasn't finished yet")
 	}
@@ -3,6 +3,7 @@ package audit
 import (
 	"context"
 	"crypto/sha256"
+	"time"
 
 	clientcore "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	netmapcore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
@@ -20,6 +21,11 @@ import (
 func (ap *Processor) processStartAudit(epoch uint64) {
 	log := ap.log.With(zap.Uint64("epoch", epoch))
 
+	start := time.Now()
+	defer func() {
+		ap.metrics.AddAuditRound(time.Since(start))
+	}()
+
 	ap.prevAuditCanceler()
 
 	skipped := ap.taskManager.Reset()
@@ -145,7 +151,7 @@ func (ap *Processor) findStorageGroups(cnr cid.ID, shuffled netmapcore.Nodes) []
 			continue
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), ap.searchTimeout)
+		ctx, cancel := context.WithTimeout(context.Background(), ap.searchTimeout.Load())
 
 		prm.Context = ctx
 		prm.NodeInfo = info
@@ -180,7 +186,7 @@ func (ap *Processor) filterExpiredSG(cid cid.ID, sgIDs []oid.ID,
 	getSGPrm.NetMap = nm
 
 	for _, sgID := range sgIDs {
-		ctx, cancel := context.WithTimeout(context.Background(), ap.searchTimeout)
+		ctx, cancel := context.WithTimeout(context.Background(), ap.searchTimeout.Load())
 
 		getSGPrm.OID = sgID
 		getSGPrm.Context = ctx
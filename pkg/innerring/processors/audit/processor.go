@@ -8,12 +8,14 @@ import (
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/storagegroup"
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	cntClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/container"
 	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/audit"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"github.com/panjf2000/ants/v2"
+	"go.uber.org/atomic"
 )
 
 type (
@@ -42,10 +44,11 @@ type (
 	Processor struct {
 		log           *logger.Logger
 		pool          *ants.Pool
+		metrics       metrics.Register
 		irList        Indexer
 		sgSrc         storagegroup.SGSource
 		epochSrc      EpochSource
-		searchTimeout time.Duration
+		searchTimeout *atomic.Duration
 
 		containerClient *cntClient.Client
 		netmapClient    *nmClient.Client
@@ -58,6 +61,7 @@ type (
 	// Params of the processor constructor.
 	Params struct {
 		Log              *logger.Logger
+		Metrics          metrics.Register
 		NetmapClient     *nmClient.Client
 		ContainerClient  *cntClient.Client
 		IRList           Indexer
@@ -105,14 +109,20 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/audit: can't create worker pool: %w", err)
 	}
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:               p.Log,
 		pool:              pool,
+		metrics:           metricsRegister,
 		containerClient:   p.ContainerClient,
 		irList:            p.IRList,
 		sgSrc:             p.SGSource,
 		epochSrc:          p.EpochSource,
-		searchTimeout:     p.RPCSearchTimeout,
+		searchTimeout:     atomic.NewDuration(p.RPCSearchTimeout),
 		netmapClient:      p.NetmapClient,
 		taskManager:       p.TaskManager,
 		reporter:          p.Reporter,
@@ -146,3 +156,13 @@ func (r *epochAuditReporter) WriteReport(rep *audit.Report) error {
 
 	return r.rep.WriteReport(rep)
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (ap *Processor) WorkerPool() *ants.Pool {
+	return ap.pool
+}
+
+// SetRPCSearchTimeout changes the timeout of the storage group search requests.
+func (ap *Processor) SetRPCSearchTimeout(d time.Duration) {
+	ap.searchTimeout.Store(d)
+}
@@ -3,6 +3,7 @@ package balance
 import (
 	"encoding/hex"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	balanceEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/balance"
 	"go.uber.org/zap"
@@ -16,7 +17,7 @@ func (bp *Processor) handleLock(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := bp.pool.Submit(func() { bp.processLock(&lock) })
+	err := processors.SubmitEvent(bp.pool, bp.metrics, processors.BalanceProcessorName, "lock", func() { bp.processLock(&lock) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		bp.log.Warn("balance worker pool drained",
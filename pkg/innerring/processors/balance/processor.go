@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	frostfscontract "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/frostfs"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	balanceEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/balance"
@@ -28,6 +29,7 @@ type (
 	Processor struct {
 		log           *logger.Logger
 		pool          *ants.Pool
+		metrics       metrics.Register
 		frostfsClient *frostfscontract.Client
 		balanceSC     util.Uint160
 		alphabetState AlphabetState
@@ -37,6 +39,7 @@ type (
 	// Params of the processor constructor.
 	Params struct {
 		Log           *logger.Logger
+		Metrics       metrics.Register
 		PoolSize      int
 		FrostFSClient *frostfscontract.Client
 		BalanceSC     util.Uint160
@@ -67,9 +70,15 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/balance: can't create worker pool: %w", err)
 	}
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:           p.Log,
 		pool:          pool,
+		metrics:       metricsRegister,
 		frostfsClient: p.FrostFSClient,
 		balanceSC:     p.BalanceSC,
 		alphabetState: p.AlphabetState,
@@ -119,3 +128,8 @@ func (bp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (bp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (bp *Processor) WorkerPool() *ants.Pool {
+	return bp.pool
+}
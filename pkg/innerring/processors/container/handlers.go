@@ -3,6 +3,7 @@ package container
 import (
 	"crypto/sha256"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	containerEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/container"
 	"github.com/mr-tron/base58"
@@ -19,7 +20,7 @@ func (cp *Processor) handlePut(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := cp.pool.Submit(func() { cp.processContainerPut(put) })
+	err := processors.SubmitEvent(cp.pool, cp.metrics, processors.ContainerProcessorName, "put", func() { cp.processContainerPut(put) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		cp.log.Warn("container processor worker pool drained",
@@ -35,7 +36,7 @@ func (cp *Processor) handleDelete(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := cp.pool.Submit(func() { cp.processContainerDelete(&del) })
+	err := processors.SubmitEvent(cp.pool, cp.metrics, processors.ContainerProcessorName, "delete", func() { cp.processContainerDelete(&del) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		cp.log.Warn("container processor worker pool drained",
@@ -52,7 +53,7 @@ func (cp *Processor) handleSetEACL(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := cp.pool.Submit(func() {
+	err := processors.SubmitEvent(cp.pool, cp.metrics, processors.ContainerProcessorName, "set_eacl", func() {
 		cp.processSetEACL(e)
 	})
 	if err != nil {
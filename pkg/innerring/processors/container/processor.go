@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client/frostfsid"
 	morphsubnet "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/subnet"
@@ -25,6 +26,7 @@ type (
 	Processor struct {
 		log            *logger.Logger
 		pool           *ants.Pool
+		metrics        metrics.Register
 		alphabetState  AlphabetState
 		cnrClient      *container.Client // notary must be enabled
 		idClient       *frostfsid.Client
@@ -36,6 +38,7 @@ type (
 	// Params of the processor constructor.
 	Params struct {
 		Log             *logger.Logger
+		Metrics         metrics.Register
 		PoolSize        int
 		AlphabetState   AlphabetState
 		ContainerClient *container.Client
@@ -95,9 +98,15 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/container: can't create worker pool: %w", err)
 	}
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:            p.Log,
 		pool:           pool,
+		metrics:        metricsRegister,
 		alphabetState:  p.AlphabetState,
 		cnrClient:      p.ContainerClient,
 		idClient:       p.FrostFSIDClient,
@@ -242,3 +251,8 @@ func (cp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (cp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (cp *Processor) WorkerPool() *ants.Pool {
+	return cp.pool
+}
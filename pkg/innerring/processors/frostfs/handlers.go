@@ -3,6 +3,7 @@ package frostfs
 import (
 	"encoding/hex"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	frostfsEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/frostfs"
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
@@ -17,7 +18,7 @@ func (np *Processor) handleDeposit(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := np.pool.Submit(func() { np.processDeposit(&deposit) })
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.FrostFSProcessorName, "deposit", func() { np.processDeposit(&deposit) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		np.log.Warn("frostfs processor worker pool drained",
@@ -33,7 +34,7 @@ func (np *Processor) handleWithdraw(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := np.pool.Submit(func() { np.processWithdraw(&withdraw) })
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.FrostFSProcessorName, "withdraw", func() { np.processWithdraw(&withdraw) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		np.log.Warn("frostfs processor worker pool drained",
@@ -49,7 +50,7 @@ func (np *Processor) handleCheque(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := np.pool.Submit(func() { np.processCheque(&cheque) })
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.FrostFSProcessorName, "cheque", func() { np.processCheque(&cheque) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		np.log.Warn("frostfs processor worker pool drained",
@@ -66,7 +67,7 @@ func (np *Processor) handleConfig(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := np.pool.Submit(func() { np.processConfig(&cfg) })
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.FrostFSProcessorName, "config", func() { np.processConfig(&cfg) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		np.log.Warn("frostfs processor worker pool drained",
@@ -82,7 +83,7 @@ func (np *Processor) handleBind(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := np.pool.Submit(func() { np.processBind(e) })
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.FrostFSProcessorName, "bind", func() { np.processBind(e) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		np.log.Warn("frostfs processor worker pool drained",
@@ -98,7 +99,7 @@ func (np *Processor) handleUnbind(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := np.pool.Submit(func() { np.processBind(e) })
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.FrostFSProcessorName, "unbind", func() { np.processBind(e) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		np.log.Warn("frostfs processor worker pool drained",
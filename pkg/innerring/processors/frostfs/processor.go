@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client/balance"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client/frostfsid"
@@ -39,6 +40,7 @@ type (
 	Processor struct {
 		log                 *logger.Logger
 		pool                *ants.Pool
+		metrics             metrics.Register
 		frostfsContract     util.Uint160
 		balanceClient       *balance.Client
 		netmapClient        *nmClient.Client
@@ -58,6 +60,7 @@ type (
 	// Params of the processor constructor.
 	Params struct {
 		Log                 *logger.Logger
+		Metrics             metrics.Register
 		PoolSize            int
 		FrostFSContract     util.Uint160
 		FrostFSIDClient     *frostfsid.Client
@@ -110,9 +113,15 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/frostfs: can't create LRU cache for gas emission: %w", err)
 	}
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:                 p.Log,
 		pool:                pool,
+		metrics:             metricsRegister,
 		frostfsContract:     p.FrostFSContract,
 		balanceClient:       p.BalanceClient,
 		netmapClient:        p.NetmapClient,
@@ -230,3 +239,8 @@ func (np *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (np *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (np *Processor) WorkerPool() *ants.Pool {
+	return np.pool
+}
@@ -1,6 +1,7 @@
 package governance
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event/rolemanagement"
 	"github.com/nspcc-dev/neo-go/pkg/core/native"
@@ -34,7 +35,7 @@ func (gp *Processor) HandleAlphabetSync(e event.Event) {
 
 	// send event to the worker pool
 
-	err := gp.pool.Submit(func() { gp.processAlphabetSync(hash) })
+	err := processors.SubmitEvent(gp.pool, gp.metrics, processors.GovernanceProcessorName, "alphabet_sync", func() { gp.processAlphabetSync(hash) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		gp.log.Warn("governance worker pool drained",
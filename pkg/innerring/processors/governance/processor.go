@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client"
 	frostfscontract "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/frostfs"
 	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
@@ -57,6 +58,7 @@ type (
 	Processor struct {
 		log           *logger.Logger
 		pool          *ants.Pool
+		metrics       metrics.Register
 		frostfsClient *frostfscontract.Client
 		netmapClient  *nmClient.Client
 
@@ -75,7 +77,8 @@ type (
 
 	// Params of the processor constructor.
 	Params struct {
-		Log *logger.Logger
+		Log     *logger.Logger
+		Metrics metrics.Register
 
 		AlphabetState AlphabetState
 		EpochState    EpochState
@@ -118,9 +121,15 @@ func New(p *Params) (*Processor, error) {
 	// result is cached by neo-go, so we can pre-calc it
 	designate := p.MainnetClient.GetDesignateHash()
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:            p.Log,
 		pool:           pool,
+		metrics:        metricsRegister,
 		frostfsClient:  p.FrostFSClient,
 		netmapClient:   p.NetmapClient,
 		alphabetState:  p.AlphabetState,
@@ -166,3 +175,8 @@ func (gp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (gp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (gp *Processor) WorkerPool() *ants.Pool {
+	return gp.pool
+}
@@ -3,6 +3,7 @@ package netmap
 import (
 	"encoding/hex"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	timerEvent "github.com/TrueCloudLab/frostfs-node/pkg/innerring/timers"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	netmapEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/netmap"
@@ -16,7 +17,7 @@ func (np *Processor) HandleNewEpochTick(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := np.pool.Submit(func() { np.processNewEpochTick() })
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.NetmapProcessorName, "new_epoch_tick", func() { np.processNewEpochTick() })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		np.log.Warn("netmap worker pool drained",
@@ -32,7 +33,7 @@ func (np *Processor) handleNewEpoch(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := np.pool.Submit(func() {
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.NetmapProcessorName, "new_epoch", func() {
 		np.processNewEpoch(epochEvent)
 	})
 	if err != nil {
@@ -51,7 +52,7 @@ func (np *Processor) handleAddPeer(ev event.Event) {
 
 	// send an event to the worker pool
 
-	err := np.pool.Submit(func() {
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.NetmapProcessorName, "add_peer", func() {
 		np.processAddPeer(newPeer)
 	})
 	if err != nil {
@@ -69,7 +70,7 @@ func (np *Processor) handleUpdateState(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := np.pool.Submit(func() {
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.NetmapProcessorName, "update_state", func() {
 		np.processUpdatePeer(updPeer)
 	})
 	if err != nil {
@@ -91,7 +92,7 @@ func (np *Processor) handleCleanupTick(ev event.Event) {
 	np.log.Info("tick", zap.String("type", "netmap cleaner"))
 
 	// send event to the worker pool
-	err := np.pool.Submit(func() {
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.NetmapProcessorName, "cleanup_tick", func() {
 		np.processNetmapCleanupTick(cleanup)
 	})
 	if err != nil {
@@ -110,7 +111,7 @@ func (np *Processor) handleRemoveNode(ev event.Event) {
 		zap.String("key", hex.EncodeToString(removeNode.Node())),
 	)
 
-	err := np.pool.Submit(func() {
+	err := processors.SubmitEvent(np.pool, np.metrics, processors.NetmapProcessorName, "remove_node", func() {
 		np.processRemoveSubnetNode(removeNode)
 	})
 	if err != nil {
@@ -0,0 +1,57 @@
+package policy
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+)
+
+// VerifyAndUpdate checks n against the admission Policy (Prm).
+//
+// If the Policy limits the number of nodes per country or per LOCODE,
+// n is evaluated among the current network map and its candidates
+// (see Policy.Evaluate). Only the chain state is used, so all the
+// Inner Ring nodes make the same decision.
+//
+// Node attributes remain untouched.
+func (v *Validator) VerifyAndUpdate(n *netmap.NodeInfo) error {
+	if v.policy.MaxNodesPerCountry == 0 && v.policy.MaxNodesPerLOCODE == 0 {
+		return v.check(v.policy.Check(*n))
+	}
+
+	nm, err := v.network.NetMap()
+	if err != nil {
+		return fmt.Errorf("could not get network map: %w", err)
+	}
+
+	candidates, err := v.network.GetCandidates()
+	if err != nil {
+		return fmt.Errorf("could not get network map candidates: %w", err)
+	}
+
+	// n replaces its previous state among the candidates
+	ind := len(candidates)
+	for i := range candidates {
+		if bytes.Equal(candidates[i].PublicKey(), n.PublicKey()) {
+			ind = i
+			break
+		}
+	}
+
+	if ind == len(candidates) {
+		candidates = append(candidates, *n)
+	} else {
+		candidates[ind] = *n
+	}
+
+	return v.check(v.policy.Evaluate(nm.Nodes(), candidates)[ind])
+}
+
+func (v *Validator) check(err error) error {
+	if err != nil {
+		return fmt.Errorf("node is rejected by admission policy: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package policy_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap/nodevalidation/policy"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+type network struct {
+	netmap     []netmap.NodeInfo
+	candidates []netmap.NodeInfo
+	err        error
+}
+
+func (x *network) NetMap() (*netmap.NetMap, error) {
+	var nm netmap.NetMap
+	nm.SetNodes(x.netmap)
+	return &nm, x.err
+}
+
+func (x *network) GetCandidates() ([]netmap.NodeInfo, error) {
+	// the list is modified by the Validator
+	res := make([]netmap.NodeInfo, len(x.candidates))
+	copy(res, x.candidates)
+	return res, nil
+}
+
+func newNode(key byte, lc, capacity string) netmap.NodeInfo {
+	var n netmap.NodeInfo
+
+	n.SetPublicKey([]byte{key})
+	if lc != "" {
+		n.SetLOCODE(lc)
+	}
+	n.SetAttribute("Capacity", capacity)
+	n.SetAttribute("Deployed", "Private")
+
+	return n
+}
+
+func TestParse(t *testing.T) {
+	_, err := policy.Parse([]byte(`denied_keys: [not-hex]`))
+	require.Error(t, err)
+
+	_, err = policy.Parse([]byte(`attributes: [{key: A, pattern: "("}]`))
+	require.Error(t, err)
+
+	_, err = policy.Parse([]byte(`attributes: [{pattern: ".*"}]`))
+	require.Error(t, err)
+
+	_, err = policy.Parse([]byte(`capacity: {min: 10, max: 1}`))
+	require.Error(t, err)
+
+	// JSON is accepted too
+	p, err := policy.Parse([]byte(`{"max_nodes_per_country": 2, "capacity": {"min": 10}}`))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, p.MaxNodesPerCountry)
+	require.EqualValues(t, 10, p.Capacity.Min)
+}
+
+func TestPolicy_Check(t *testing.T) {
+	p, err := policy.Parse([]byte(`
+allowed_keys: [01, 02, 03, 04]
+denied_keys: [02]
+attributes:
+  - key: Deployed
+    required: true
+    pattern: ^(Private|Public)$
+  - key: UN-LOCODE
+    pattern: ^(RU|DE) 
+capacity:
+  min: 10
+  max: 1000
+max_nodes_per_locode: 1
+max_nodes_per_country: 2
+`))
+	require.NoError(t, err)
+
+	t.Run("keys", func(t *testing.T) {
+		require.NoError(t, p.Check(newNode(1, "RU MOW", "100")))
+		require.Error(t, p.Check(newNode(2, "RU MOW", "100")))
+		require.Error(t, p.Check(newNode(5, "RU MOW", "100")))
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		n := newNode(1, "US NYC", "100")
+		require.Error(t, p.Check(n))
+
+		var noDeployed netmap.NodeInfo
+		noDeployed.SetPublicKey([]byte{1})
+		noDeployed.SetAttribute("Capacity", "100")
+		require.Error(t, p.Check(noDeployed))
+
+		n = newNode(1, "", "100")
+		n.SetAttribute("Deployed", "Unknown")
+		require.Error(t, p.Check(n))
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		require.Error(t, p.Check(newNode(1, "RU MOW", "1")))
+		require.Error(t, p.Check(newNode(1, "RU MOW", "1001")))
+		require.Error(t, p.Check(newNode(1, "RU MOW", "many")))
+	})
+}
+
+func TestPolicy_Evaluate(t *testing.T) {
+	p, err := policy.Parse([]byte(`
+denied_keys: [` + hex.EncodeToString([]byte{3}) + `]
+max_nodes_per_locode: 1
+max_nodes_per_country: 2
+`))
+	require.NoError(t, err)
+
+	t.Run("order of keys", func(t *testing.T) {
+		res := p.Evaluate(nil, []netmap.NodeInfo{
+			newNode(5, "RU MOW", "100"),
+			newNode(2, "RU MOW", "100"),
+			newNode(4, "RU LED", "100"),
+			newNode(1, "RU KGD", "100"),
+		})
+		require.Len(t, res, 4)
+		require.Error(t, res[0])   // LOCODE is occupied by node 2
+		require.NoError(t, res[1]) // admitted second in the country
+		require.Error(t, res[2])   // country is occupied by nodes 1 and 2
+		require.NoError(t, res[3])
+	})
+
+	t.Run("rejected nodes are not counted", func(t *testing.T) {
+		res := p.Evaluate(nil, []netmap.NodeInfo{
+			newNode(3, "RU MOW", "100"),
+			newNode(4, "RU MOW", "100"),
+		})
+		require.Error(t, res[0]) // denied
+		require.NoError(t, res[1])
+	})
+
+	t.Run("members first", func(t *testing.T) {
+		members := []netmap.NodeInfo{
+			newNode(5, "RU MOW", "100"),
+			newNode(6, "RU MOW", "100"),
+			newNode(7, "RU LED", "100"),
+		}
+
+		res := p.Evaluate(members, []netmap.NodeInfo{
+			newNode(1, "RU KGD", "100"),
+			newNode(6, "RU MOW", "100"),
+			newNode(2, "DE FRA", "100"),
+		})
+		require.Error(t, res[0])   // country is over the limit because of the members
+		require.NoError(t, res[1]) // member re-bootstraps over the limit
+		require.NoError(t, res[2])
+	})
+
+	t.Run("member with a new location", func(t *testing.T) {
+		members := []netmap.NodeInfo{
+			newNode(5, "RU MOW", "100"),
+		}
+
+		res := p.Evaluate(members, []netmap.NodeInfo{
+			newNode(1, "RU MOW", "100"),
+			newNode(5, "DE FRA", "100"),
+		})
+		require.NoError(t, res[0]) // the member has left the LOCODE
+		require.NoError(t, res[1])
+	})
+}
+
+func TestValidator_VerifyAndUpdate(t *testing.T) {
+	p, err := policy.Parse([]byte(`max_nodes_per_locode: 1`))
+	require.NoError(t, err)
+
+	src := &network{}
+	v := policy.New(policy.Prm{
+		Policy:  p,
+		Network: src,
+	})
+
+	n := newNode(2, "RU MOW", "100")
+	require.NoError(t, v.VerifyAndUpdate(&n))
+
+	// the node with the lower key is admitted first
+	src.candidates = []netmap.NodeInfo{newNode(1, "RU MOW", "100")}
+	require.Error(t, v.VerifyAndUpdate(&n))
+
+	src.candidates = []netmap.NodeInfo{newNode(3, "RU MOW", "100")}
+	require.NoError(t, v.VerifyAndUpdate(&n))
+
+	// the node re-bootstraps with a new location
+	src.candidates = []netmap.NodeInfo{newNode(1, "RU MOW", "100"), newNode(2, "RU MOW", "100")}
+	n = newNode(2, "RU LED", "100")
+	require.NoError(t, v.VerifyAndUpdate(&n))
+
+	// network map members keep their places
+	src.netmap = []netmap.NodeInfo{newNode(1, "RU MOW", "100"), newNode(2, "RU MOW", "100")}
+	n = newNode(2, "RU MOW", "100")
+	require.NoError(t, v.VerifyAndUpdate(&n))
+
+	n = newNode(3, "RU MOW", "100")
+	require.Error(t, v.VerifyAndUpdate(&n))
+
+	src.err = errors.New("any")
+	require.Error(t, v.VerifyAndUpdate(&n))
+}
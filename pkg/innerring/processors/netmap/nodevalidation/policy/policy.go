@@ -0,0 +1,298 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"gopkg.in/yaml.v3"
+)
+
+const attrCapacity = "Capacity"
+
+// Policy is a declarative admission policy of the storage nodes.
+//
+// Policy must be created using Parse or ReadFile.
+type Policy struct {
+	// AllowedKeys is a list of hex-encoded public keys of the nodes allowed
+	// to enter the network map. If empty, any node which is not denied is allowed.
+	AllowedKeys []string `yaml:"allowed_keys"`
+
+	// DeniedKeys is a list of hex-encoded public keys of the nodes
+	// which are not allowed to enter the network map.
+	DeniedKeys []string `yaml:"denied_keys"`
+
+	// Attributes is a list of constraints on the node attributes.
+	Attributes []AttributeRule `yaml:"attributes"`
+
+	// MaxNodesPerCountry limits the number of nodes with the same
+	// country code from the UN-LOCODE attribute. Zero means no limit.
+	MaxNodesPerCountry uint32 `yaml:"max_nodes_per_country"`
+
+	// MaxNodesPerLOCODE limits the number of nodes with the same
+	// UN-LOCODE attribute. Zero means no limit.
+	MaxNodesPerLOCODE uint32 `yaml:"max_nodes_per_locode"`
+
+	// Capacity limits the value of the Capacity attribute.
+	Capacity CapacityBounds `yaml:"capacity"`
+
+	allowed, denied map[string]struct{}
+	patterns        []*regexp.Regexp
+}
+
+// AttributeRule is a constraint on the node attribute.
+type AttributeRule struct {
+	// Key is the attribute key.
+	Key string `yaml:"key"`
+
+	// Required is set if the node must have the attribute.
+	Required bool `yaml:"required"`
+
+	// Pattern is a regular expression the attribute value must match
+	// if the attribute is present. Empty pattern matches any value.
+	Pattern string `yaml:"pattern"`
+}
+
+// CapacityBounds are the bounds of the node capacity in GB.
+// Zero bound is not checked.
+type CapacityBounds struct {
+	Min uint64 `yaml:"min"`
+	Max uint64 `yaml:"max"`
+}
+
+// ReadFile reads the Policy from the YAML (or JSON) file.
+func ReadFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read admission policy file: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse decodes the Policy from YAML (or JSON) and checks its correctness.
+func Parse(data []byte) (*Policy, error) {
+	p := new(Policy)
+
+	err := yaml.Unmarshal(data, p)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode admission policy: %w", err)
+	}
+
+	p.allowed, err = keySet(p.AllowedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed keys: %w", err)
+	}
+
+	p.denied, err = keySet(p.DeniedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denied keys: %w", err)
+	}
+
+	p.patterns = make([]*regexp.Regexp, len(p.Attributes))
+
+	for i, a := range p.Attributes {
+		if a.Key == "" {
+			return nil, fmt.Errorf("empty key of attribute rule #%d", i)
+		}
+
+		if a.Pattern == "" {
+			continue
+		}
+
+		p.patterns[i], err = regexp.Compile(a.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern of attribute %s: %w", a.Key, err)
+		}
+	}
+
+	if p.Capacity.Max != 0 && p.Capacity.Min > p.Capacity.Max {
+		return nil, fmt.Errorf("min capacity %d is greater than max capacity %d",
+			p.Capacity.Min, p.Capacity.Max)
+	}
+
+	return p, nil
+}
+
+func keySet(keys []string) (map[string]struct{}, error) {
+	res := make(map[string]struct{}, len(keys))
+
+	for _, k := range keys {
+		b, err := hex.DecodeString(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", k, err)
+		}
+
+		res[hex.EncodeToString(b)] = struct{}{}
+	}
+
+	return res, nil
+}
+
+// Check checks whether the node is admitted by the policy not taking the
+// per-country and per-LOCODE limits into account, see Evaluate.
+func (p *Policy) Check(n netmap.NodeInfo) error {
+	key := hex.EncodeToString(n.PublicKey())
+
+	if _, ok := p.denied[key]; ok {
+		return fmt.Errorf("node key %s is denied", key)
+	}
+
+	if len(p.allowed) != 0 {
+		if _, ok := p.allowed[key]; !ok {
+			return fmt.Errorf("node key %s is not allowed", key)
+		}
+	}
+
+	for i, a := range p.Attributes {
+		v := n.Attribute(a.Key)
+		if v == "" {
+			if a.Required {
+				return fmt.Errorf("missing required attribute %s", a.Key)
+			}
+			continue
+		}
+
+		if p.patterns[i] != nil && !p.patterns[i].MatchString(v) {
+			return fmt.Errorf("attribute %s=%s does not match %s", a.Key, v, a.Pattern)
+		}
+	}
+
+	return p.checkCapacity(n)
+}
+
+func (p *Policy) checkCapacity(n netmap.NodeInfo) error {
+	if p.Capacity.Min == 0 && p.Capacity.Max == 0 {
+		return nil
+	}
+
+	v := n.Attribute(attrCapacity)
+	if v == "" {
+		return fmt.Errorf("missing %s attribute", attrCapacity)
+	}
+
+	capacity, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s attribute: %w", attrCapacity, err)
+	}
+
+	if capacity < p.Capacity.Min {
+		return fmt.Errorf("capacity %d is less than %d", capacity, p.Capacity.Min)
+	}
+
+	if p.Capacity.Max != 0 && capacity > p.Capacity.Max {
+		return fmt.Errorf("capacity %d is greater than %d", capacity, p.Capacity.Max)
+	}
+
+	return nil
+}
+
+// locationCounter counts the admitted nodes per country and per LOCODE.
+type locationCounter struct {
+	countries, locodes map[string]uint32
+}
+
+func newLocationCounter() locationCounter {
+	return locationCounter{
+		countries: make(map[string]uint32),
+		locodes:   make(map[string]uint32),
+	}
+}
+
+func (c locationCounter) add(n netmap.NodeInfo) {
+	if lc := n.LOCODE(); lc != "" {
+		c.locodes[lc]++
+		c.countries[countryCode(lc)]++
+	}
+}
+
+func (p *Policy) checkLimits(n netmap.NodeInfo, c locationCounter) error {
+	lc := n.LOCODE()
+	if lc == "" {
+		return nil
+	}
+
+	if p.MaxNodesPerLOCODE != 0 && c.locodes[lc] >= p.MaxNodesPerLOCODE {
+		return fmt.Errorf("limit of %d nodes in %s is reached", p.MaxNodesPerLOCODE, lc)
+	}
+
+	country := countryCode(lc)
+	if p.MaxNodesPerCountry != 0 && c.countries[country] >= p.MaxNodesPerCountry {
+		return fmt.Errorf("limit of %d nodes in country %s is reached", p.MaxNodesPerCountry, country)
+	}
+
+	return nil
+}
+
+// Evaluate checks the candidates to enter the network map against the policy.
+// The result contains the reasons of the rejection for the candidates with
+// the same indexes, nil for the admitted ones.
+//
+// The per-country and per-LOCODE limits are checked in a deterministic order
+// and only the admitted nodes are counted. The members of the current network
+// map are counted first and are not rejected by the limits, so the nodes
+// already in the network map keep their places on re-bootstrap. Other
+// candidates are admitted in the order of their public keys while the limits
+// are not reached.
+func (p *Policy) Evaluate(members, candidates []netmap.NodeInfo) []error {
+	res := make([]error, len(candidates))
+	index := make(map[string]int, len(candidates))
+
+	for i := range candidates {
+		res[i] = p.Check(candidates[i])
+		index[string(candidates[i].PublicKey())] = i
+	}
+
+	counter := newLocationCounter()
+	isMember := make(map[string]struct{}, len(members))
+
+	for i := range members {
+		key := string(members[i].PublicKey())
+		if _, ok := isMember[key]; ok {
+			continue
+		}
+		isMember[key] = struct{}{}
+
+		// re-bootstrapping member is counted with its new state
+		if j, ok := index[key]; ok {
+			if res[j] == nil {
+				counter.add(candidates[j])
+			}
+		} else if p.Check(members[i]) == nil {
+			counter.add(members[i])
+		}
+	}
+
+	others := make([]int, 0, len(candidates))
+	for i := range candidates {
+		if _, ok := isMember[string(candidates[i].PublicKey())]; !ok && res[i] == nil {
+			others = append(others, i)
+		}
+	}
+
+	sort.Slice(others, func(i, j int) bool {
+		return bytes.Compare(candidates[others[i]].PublicKey(), candidates[others[j]].PublicKey()) < 0
+	})
+
+	for _, i := range others {
+		res[i] = p.checkLimits(candidates[i], counter)
+		if res[i] == nil {
+			counter.add(candidates[i])
+		}
+	}
+
+	return res
+}
+
+// countryCode returns the country code of the UN-LOCODE value
+// in the "CC LLL" format.
+func countryCode(lc string) string {
+	cc, _, _ := strings.Cut(lc, " ")
+	return cc
+}
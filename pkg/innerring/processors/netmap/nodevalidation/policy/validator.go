@@ -0,0 +1,66 @@
+package policy
+
+import (
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+)
+
+// NetworkSource is a source of the network map state stored in the chain.
+type NetworkSource interface {
+	// NetMap must return the current network map.
+	NetMap() (*netmap.NetMap, error)
+
+	// GetCandidates must return the list of the nodes
+	// which are candidates to enter the next network map.
+	GetCandidates() ([]netmap.NodeInfo, error)
+}
+
+// Prm groups the required parameters of the Validator's constructor.
+//
+// All values must comply with the requirements imposed on them.
+// Passing incorrect parameter values will result in constructor
+// failure (error or panic depending on the implementation).
+type Prm struct {
+	// Node admission policy.
+	//
+	// Must not be nil.
+	Policy *Policy
+
+	// Source of the current network map and its candidates
+	// used to check per-country and per-LOCODE limits.
+	//
+	// Must not be nil.
+	Network NetworkSource
+}
+
+// Validator is a utility that verifies the node
+// against the declarative admission Policy.
+//
+// For correct operation, the Validator must be created
+// using the constructor (New) based on the required parameters
+// and optional components. After successful creation,
+// the Validator is immediately ready to work through API.
+type Validator struct {
+	policy *Policy
+
+	network NetworkSource
+}
+
+// New creates a new instance of the Validator.
+//
+// Panics if at least one value of the parameters is invalid.
+//
+// The created Validator does not require additional
+// initialization and is completely ready for work.
+func New(prm Prm) *Validator {
+	switch {
+	case prm.Policy == nil:
+		panic("nil admission policy")
+	case prm.Network == nil:
+		panic("nil network source")
+	}
+
+	return &Validator{
+		policy:  prm.Policy,
+		network: prm.Network,
+	}
+}
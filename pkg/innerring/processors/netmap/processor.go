@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap/nodevalidation/state"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client/container"
 	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
@@ -57,6 +58,7 @@ type (
 	Processor struct {
 		log           *logger.Logger
 		pool          *ants.Pool
+		metrics       metrics.Register
 		epochTimer    EpochTimerReseter
 		epochState    EpochState
 		alphabetState AlphabetState
@@ -83,6 +85,7 @@ type (
 	// Params of the processor constructor.
 	Params struct {
 		Log              *logger.Logger
+		Metrics          metrics.Register
 		PoolSize         int
 		NetmapClient     *nmClient.Client
 		EpochTimer       EpochTimerReseter
@@ -149,9 +152,15 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/netmap: can't create worker pool: %w", err)
 	}
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:            p.Log,
 		pool:           pool,
+		metrics:        metricsRegister,
 		epochTimer:     p.EpochTimer,
 		epochState:     p.EpochState,
 		alphabetState:  p.AlphabetState,
@@ -301,3 +310,8 @@ func (np *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (np *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (np *Processor) WorkerPool() *ants.Pool {
+	return np.pool
+}
@@ -3,6 +3,7 @@ package reputation
 import (
 	"encoding/hex"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	reputationEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/reputation"
 	"go.uber.org/zap"
@@ -19,7 +20,7 @@ func (rp *Processor) handlePutReputation(ev event.Event) {
 
 	// send event to the worker pool
 
-	err := rp.pool.Submit(func() { rp.processPut(&put) })
+	err := processors.SubmitEvent(rp.pool, rp.metrics, processors.ReputationProcessorName, "put_reputation", func() { rp.processPut(&put) })
 	if err != nil {
 		// there system can be moved into controlled degradation stage
 		rp.log.Warn("reputation worker pool drained",
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	repClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/reputation"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	reputationEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/reputation"
@@ -27,8 +28,9 @@ type (
 
 	// Processor of events produced by reputation contract.
 	Processor struct {
-		log  *logger.Logger
-		pool *ants.Pool
+		log     *logger.Logger
+		pool    *ants.Pool
+		metrics metrics.Register
 
 		epochState    EpochState
 		alphabetState AlphabetState
@@ -43,6 +45,7 @@ type (
 	// Params of the processor constructor.
 	Params struct {
 		Log               *logger.Logger
+		Metrics           metrics.Register
 		PoolSize          int
 		EpochState        EpochState
 		AlphabetState     AlphabetState
@@ -78,9 +81,15 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/reputation: can't create worker pool: %w", err)
 	}
 
+	metricsRegister := p.Metrics
+	if metricsRegister == nil {
+		metricsRegister = metrics.DefaultRegister{}
+	}
+
 	return &Processor{
 		log:            p.Log,
 		pool:           pool,
+		metrics:        metricsRegister,
 		epochState:     p.EpochState,
 		alphabetState:  p.AlphabetState,
 		reputationWrp:  p.ReputationWrapper,
@@ -153,3 +162,8 @@ func (rp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (rp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (rp *Processor) WorkerPool() *ants.Pool {
+	return rp.pool
+}
@@ -1,6 +1,7 @@
 package settlement
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"go.uber.org/zap"
@@ -30,7 +31,7 @@ func (p *Processor) HandleAuditEvent(e event.Event) {
 		proc:  p.auditProc,
 	}
 
-	err := p.pool.Submit(handler.handle)
+	err := processors.SubmitEvent(p.pool, p.metrics, processors.SettlementProcessorName, "audit", handler.handle)
 	if err != nil {
 		log.Warn("could not add handler of AuditEvent to queue",
 			zap.String("error", err.Error()),
@@ -75,7 +76,7 @@ func (p *Processor) HandleIncomeCollectionEvent(e event.Event) {
 
 	p.incomeContexts[epoch] = incomeCtx
 
-	err = p.pool.Submit(func() {
+	err = processors.SubmitEvent(p.pool, p.metrics, processors.SettlementProcessorName, "income_collection", func() {
 		incomeCtx.Collect()
 	})
 	if err != nil {
@@ -113,7 +114,7 @@ func (p *Processor) HandleIncomeDistributionEvent(e event.Event) {
 		return
 	}
 
-	err := p.pool.Submit(func() {
+	err := processors.SubmitEvent(p.pool, p.metrics, processors.SettlementProcessorName, "income_distribution", func() {
 		incomeCtx.Distribute()
 	})
 	if err != nil {
@@ -1,6 +1,7 @@
 package settlement
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"go.uber.org/zap"
 )
@@ -12,6 +13,8 @@ type options struct {
 	poolSize int
 
 	log *logger.Logger
+
+	metrics metrics.Register
 }
 
 func defaultOptions() *options {
@@ -20,6 +23,7 @@ func defaultOptions() *options {
 	return &options{
 		poolSize: poolSize,
 		log:      &logger.Logger{Logger: zap.L()},
+		metrics:  metrics.DefaultRegister{},
 	}
 }
 
@@ -29,3 +33,10 @@ func WithLogger(l *logger.Logger) Option {
 		o.log = l
 	}
 }
+
+// WithMetrics returns option to override the component for metrics.
+func WithMetrics(m metrics.Register) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
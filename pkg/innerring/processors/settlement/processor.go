@@ -4,8 +4,9 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/settlement/basic"
-	nodeutil "github.com/TrueCloudLab/frostfs-node/pkg/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
@@ -23,7 +24,9 @@ type (
 
 		state AlphabetState
 
-		pool nodeutil.WorkerPool
+		pool *ants.Pool
+
+		metrics metrics.Register
 
 		auditProc AuditProcessor
 
@@ -71,8 +74,14 @@ func New(prm Prm, opts ...Option) *Processor {
 		log:            o.log,
 		state:          prm.State,
 		pool:           pool,
+		metrics:        o.metrics,
 		auditProc:      prm.AuditProcessor,
 		basicIncome:    prm.BasicIncome,
 		incomeContexts: make(map[uint64]*basic.IncomeSettlementContext),
 	}
 }
+
+// WorkerPool returns the pool of workers handling the events.
+func (p *Processor) WorkerPool() *ants.Pool {
+	return p.pool
+}
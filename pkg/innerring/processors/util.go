@@ -0,0 +1,43 @@
+package processors
+
+import (
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
+	"github.com/panjf2000/ants/v2"
+)
+
+// Names of the event processors used as the metric labels
+// and in the Control service.
+const (
+	NetmapProcessorName     = "netmap"
+	ContainerProcessorName  = "container"
+	BalanceProcessorName    = "balance"
+	FrostFSProcessorName    = "frostfs"
+	AlphabetProcessorName   = "alphabet"
+	ReputationProcessorName = "reputation"
+	GovernanceProcessorName = "governance"
+	AuditProcessorName      = "audit"
+	SettlementProcessorName = "settlement"
+)
+
+// SubmitEvent submits the event handler to the worker pool of the processor
+// and registers the event metrics.
+//
+// Returns an error if the worker pool is drained, the event is dropped then.
+func SubmitEvent(pool *ants.Pool, m metrics.Register, processor, event string, handler func()) error {
+	m.AddReceivedEvent(processor, event)
+
+	err := pool.Submit(func() {
+		start := time.Now()
+
+		handler()
+
+		m.AddHandledEvent(processor, event, time.Since(start))
+	})
+	if err != nil {
+		m.AddDroppedEvent(processor, event)
+	}
+
+	return err
+}
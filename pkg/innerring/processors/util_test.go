@@ -0,0 +1,67 @@
+package processors
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type testRegister struct {
+	metrics.DefaultRegister
+
+	mtx                        sync.Mutex
+	received, dropped, handled []string
+}
+
+func (r *testRegister) AddReceivedEvent(processor, event string) {
+	r.mtx.Lock()
+	r.received = append(r.received, processor+"/"+event)
+	r.mtx.Unlock()
+}
+
+func (r *testRegister) AddDroppedEvent(processor, event string) {
+	r.mtx.Lock()
+	r.dropped = append(r.dropped, processor+"/"+event)
+	r.mtx.Unlock()
+}
+
+func (r *testRegister) AddHandledEvent(processor, event string, _ time.Duration) {
+	r.mtx.Lock()
+	r.handled = append(r.handled, processor+"/"+event)
+	r.mtx.Unlock()
+}
+
+func TestSubmitEvent(t *testing.T) {
+	pool, err := ants.NewPool(1, ants.WithNonblocking(true))
+	require.NoError(t, err)
+	defer pool.Release()
+
+	var r testRegister
+
+	block := make(chan struct{})
+	done := make(chan struct{})
+
+	require.NoError(t, SubmitEvent(pool, &r, "netmap", "new_epoch", func() {
+		<-block
+		close(done)
+	}))
+
+	require.Error(t, SubmitEvent(pool, &r, "netmap", "add_peer", func() {}))
+
+	close(block)
+	<-done
+
+	require.Eventually(t, func() bool {
+		r.mtx.Lock()
+		defer r.mtx.Unlock()
+		return len(r.handled) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, []string{"netmap/new_epoch", "netmap/add_peer"}, r.received)
+	require.Equal(t, []string{"netmap/add_peer"}, r.dropped)
+	require.Equal(t, []string{"netmap/new_epoch"}, r.handled)
+}
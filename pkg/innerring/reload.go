@@ -0,0 +1,167 @@
+package innerring
+
+import (
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/morph/timer"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// reloadComponent is a part of the inner ring node
+// configuration which can be changed at runtime.
+type reloadComponent struct {
+	name   string
+	reload func(*viper.Viper) error
+}
+
+// Reload applies the configuration to the running inner ring node.
+//
+// Chain subscriptions, event listeners and notary requests in progress
+// are not affected. All the components are reloaded regardless of errors,
+// the first error is returned.
+func (s *Server) Reload(cfg *viper.Viper) error {
+	s.reloadMtx.Lock()
+	defer s.reloadMtx.Unlock()
+
+	components := []reloadComponent{
+		{name: "workers", reload: s.reloadWorkers},
+		{name: "timers", reload: s.reloadTimers},
+		{name: "audit", reload: s.reloadAudit},
+		{name: "morph endpoints", reload: s.reloadEndpoints},
+		{name: "node validation", reload: s.reloadNodeValidation},
+	}
+
+	var firstErr error
+
+	for _, c := range components {
+		err := c.reload(cfg)
+		if err != nil {
+			s.log.Error("can't reload inner ring component",
+				zap.String("component", c.name),
+				zap.Error(err))
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", c.name, err)
+			}
+		}
+	}
+
+	return firstErr
+}
+
+func (s *Server) reloadWorkers(cfg *viper.Viper) error {
+	for _, p := range s.processors {
+		switch p.name {
+		case netmapProcessorName,
+			containerProcessorName,
+			balanceProcessorName,
+			frostfsProcessorName,
+			alphabetProcessorName,
+			reputationProcessorName:
+		default:
+			// pool sizes of the other processors are fixed
+			continue
+		}
+
+		size := cfg.GetInt("workers." + p.name)
+		if size <= 0 {
+			return fmt.Errorf("invalid %s worker pool size %d", p.name, size)
+		}
+
+		p.pool.Tune(size)
+	}
+
+	return nil
+}
+
+func (s *Server) reloadTimers(cfg *viper.Viper) error {
+	deltas := []struct {
+		idx  int
+		name string
+	}{
+		{stopEstimationDelta, "stop_estimation"},
+		{collectBasicIncomeDelta, "collect_basic_income"},
+		{distributeBasicIncomeDelta, "distribute_basic_income"},
+	}
+
+	for _, d := range deltas {
+		mul := cfg.GetUint32("timers." + d.name + ".mul")
+		div := cfg.GetUint32("timers." + d.name + ".div")
+		if div == 0 {
+			return fmt.Errorf("zero %s timer divisor", d.name)
+		}
+
+		// applied from the next epoch
+		s.epochTimer.SetDelta(d.idx, mul, div)
+	}
+
+	emitDuration := cfg.GetUint32("timers.emit")
+	if emitDuration != s.emitDuration {
+		s.emissionTimer.SetBlockMeter(timer.StaticBlockMeter(emitDuration))
+
+		err := s.emissionTimer.Reset()
+		if err != nil {
+			return fmt.Errorf("can't reset emission timer: %w", err)
+		}
+
+		s.emitDuration = emitDuration
+	}
+
+	return nil
+}
+
+func (s *Server) reloadAudit(cfg *viper.Viper) error {
+	execPoolSize := cfg.GetInt("audit.task.exec_pool_size")
+	if execPoolSize <= 0 {
+		return fmt.Errorf("invalid audit task pool size %d", execPoolSize)
+	}
+
+	s.auditPool.Tune(execPoolSize)
+
+	s.pdpPoolSize.Store(cfg.GetInt64("audit.pdp.pairs_pool_size"))
+	s.porPoolSize.Store(cfg.GetInt64("audit.por.pool_size"))
+
+	s.clientCache.setTimeouts(
+		cfg.GetDuration("audit.timeout.get"),
+		cfg.GetDuration("audit.timeout.head"),
+		cfg.GetDuration("audit.timeout.rangehash"),
+	)
+
+	s.auditProcessor.SetRPCSearchTimeout(cfg.GetDuration("audit.timeout.search"))
+	s.auditTaskManager.SetMaxPDPSleepInterval(cfg.GetDuration("audit.pdp.max_sleep_interval"))
+
+	return nil
+}
+
+func (s *Server) reloadEndpoints(cfg *viper.Viper) error {
+	endpoints, err := parseEndpoints(cfg, morphPrefix)
+	if err != nil {
+		return err
+	}
+
+	err = s.morphClient.SetEndpoints(endpoints...)
+	if err != nil {
+		return err
+	}
+
+	if s.withoutMainNet {
+		return nil
+	}
+
+	endpoints, err = parseEndpoints(cfg, mainnetPrefix)
+	if err != nil {
+		return err
+	}
+
+	return s.mainnetClient.SetEndpoints(endpoints...)
+}
+
+func (s *Server) reloadNodeValidation(cfg *viper.Viper) error {
+	err := s.locodeValidator.reload(cfg.GetString("locode.db.path"))
+	if err != nil {
+		return err
+	}
+
+	return s.admissionValidator.reload(cfg.GetString("admission_policy.path"))
+}
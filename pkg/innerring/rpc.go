@@ -19,6 +19,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/storagegroup"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -31,7 +32,7 @@ type (
 		}
 		key *ecdsa.PrivateKey
 
-		sgTimeout, headTimeout, rangeTimeout time.Duration
+		sgTimeout, headTimeout, rangeTimeout atomic.Duration
 	}
 
 	clientCacheParams struct {
@@ -45,14 +46,21 @@ type (
 )
 
 func newClientCache(p *clientCacheParams) *ClientCache {
-	return &ClientCache{
-		log:          p.Log,
-		cache:        cache.NewSDKClientCache(cache.ClientCacheOpts{AllowExternal: p.AllowExternal, Key: p.Key}),
-		key:          p.Key,
-		sgTimeout:    p.SGTimeout,
-		headTimeout:  p.HeadTimeout,
-		rangeTimeout: p.RangeTimeout,
+	c := &ClientCache{
+		log:   p.Log,
+		cache: cache.NewSDKClientCache(cache.ClientCacheOpts{AllowExternal: p.AllowExternal, Key: p.Key}),
+		key:   p.Key,
 	}
+
+	c.setTimeouts(p.SGTimeout, p.HeadTimeout, p.RangeTimeout)
+
+	return c
+}
+
+func (c *ClientCache) setTimeouts(sg, head, rng time.Duration) {
+	c.sgTimeout.Store(sg)
+	c.headTimeout.Store(head)
+	c.rangeTimeout.Store(rng)
 }
 
 func (c *ClientCache) Get(info clientcore.NodeInfo) (clientcore.Client, error) {
@@ -100,7 +108,7 @@ func (c *ClientCache) getSG(ctx context.Context, addr oid.Address, nm *netmap.Ne
 			continue
 		}
 
-		cctx, cancel := context.WithTimeout(ctx, c.sgTimeout)
+		cctx, cancel := context.WithTimeout(ctx, c.sgTimeout.Load())
 		getObjPrm.SetContext(cctx)
 
 		// NOTE: we use the function which does not verify object integrity (checksums, signature),
@@ -149,7 +157,7 @@ func (c *ClientCache) GetHeader(prm auditor.GetHeaderPrm) (*object.Object, error
 		return nil, fmt.Errorf("can't setup remote connection with %s: %w", info.AddressGroup(), err)
 	}
 
-	cctx, cancel := context.WithTimeout(prm.Context, c.headTimeout)
+	cctx, cancel := context.WithTimeout(prm.Context, c.headTimeout.Load())
 
 	var obj *object.Object
 
@@ -187,7 +195,7 @@ func (c *ClientCache) GetRangeHash(prm auditor.GetRangeHashPrm) ([]byte, error)
 		return nil, fmt.Errorf("can't setup remote connection with %s: %w", info.AddressGroup(), err)
 	}
 
-	cctx, cancel := context.WithTimeout(prm.Context, c.rangeTimeout)
+	cctx, cancel := context.WithTimeout(prm.Context, c.rangeTimeout.Load())
 
 	h, err := frostfsapiclient.HashObjectRange(cctx, cli, objAddress, prm.Range)
 
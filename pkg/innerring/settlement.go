@@ -8,9 +8,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	irmetrics "github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/settlement/audit"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/settlement/basic"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/settlement/common"
@@ -48,6 +50,8 @@ type settlementDeps struct {
 
 	balanceClient *balanceClient.Client
 
+	metrics irmetrics.Register
+
 	settlementCtx string
 }
 
@@ -236,6 +240,9 @@ func (s settlementDeps) Transfer(sender, recipient user.ID, amount *big.Int, det
 	}
 
 	err := s.balanceClient.TransferX(params)
+
+	s.metrics.AddSettlementTransfer(strings.ReplaceAll(s.settlementCtx, " ", "_"), params.Amount, err == nil)
+
 	if err != nil {
 		log.Error(fmt.Sprintf("%s: could not send transfer", s.settlementCtx),
 			zap.String("error", err.Error()),
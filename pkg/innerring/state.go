@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"sort"
 
+	irmetrics "github.com/TrueCloudLab/frostfs-node/pkg/innerring/metrics"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/governance"
 	auditClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/audit"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/audit"
 	control "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/state"
+	auditAPI "github.com/TrueCloudLab/frostfs-sdk-go/audit"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -30,9 +33,7 @@ func (s *Server) EpochCounter() uint64 {
 // epoch counter.
 func (s *Server) SetEpochCounter(val uint64) {
 	s.epochCounter.Store(val)
-	if s.metrics != nil {
-		s.metrics.SetEpoch(val)
-	}
+	s.metrics.SetEpoch(val)
 }
 
 // EpochDuration is a getter for a global epoch duration.
@@ -154,7 +155,38 @@ func (s *Server) WriteReport(r *audit.Report) error {
 	prm := auditClient.PutPrm{}
 	prm.SetResult(res)
 
-	return s.auditClient.PutAuditResult(prm)
+	err := s.auditClient.PutAuditResult(prm)
+	if err != nil {
+		return err
+	}
+
+	s.metrics.AddAuditResult(auditResultLabel(res))
+
+	return nil
+}
+
+func auditResultLabel(res *auditAPI.Result) string {
+	if !res.Completed() {
+		return irmetrics.AuditResultIncomplete
+	}
+
+	var failed bool
+
+	res.IterateFailedStorageGroups(func(oid.ID) bool {
+		failed = true
+		return true
+	})
+
+	res.IterateFailedStorageNodes(func([]byte) bool {
+		failed = true
+		return true
+	})
+
+	if failed {
+		return irmetrics.AuditResultFailed
+	}
+
+	return irmetrics.AuditResultPassed
 }
 
 // ResetEpochTimer resets the block timer that produces events to update epoch
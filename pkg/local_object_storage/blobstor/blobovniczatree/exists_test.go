@@ -1,6 +1,7 @@
 package blobovniczatree
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -32,7 +33,7 @@ func TestExistsInvalidStorageID(t *testing.T) {
 	d, err := obj.Marshal()
 	require.NoError(t, err)
 
-	putRes, err := b.Put(common.PutPrm{Address: addr, RawData: d, DontCompress: true})
+	putRes, err := b.Put(context.Background(), common.PutPrm{Address: addr, RawData: d, DontCompress: true})
 	require.NoError(t, err)
 
 	t.Run("valid but wrong storage id", func(t *testing.T) {
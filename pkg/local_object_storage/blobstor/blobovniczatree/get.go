@@ -1,14 +1,18 @@
 package blobovniczatree
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -16,7 +20,13 @@ import (
 //
 // If blobocvnicza ID is specified, only this blobovnicza is processed.
 // Otherwise, all Blobovniczas are processed descending weight.
-func (b *Blobovniczas) Get(prm common.GetPrm) (res common.GetRes, err error) {
+func (b *Blobovniczas) Get(ctx context.Context, prm common.GetPrm) (res common.GetRes, err error) {
+	_, span := tracing.StartSpanFromContext(ctx, "Blobovniczas.Get",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	var bPrm blobovnicza.GetPrm
 	bPrm.SetAddress(prm.Address)
 
@@ -1,25 +1,35 @@
 package blobovniczatree
 
 import (
+	"context"
 	"errors"
 	"path/filepath"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // Put saves object in the maximum weight blobobnicza.
 //
 // returns error if could not save object in any blobovnicza.
-func (b *Blobovniczas) Put(prm common.PutPrm) (common.PutRes, error) {
+func (b *Blobovniczas) Put(ctx context.Context, prm common.PutPrm) (_ common.PutRes, err error) {
+	_, span := tracing.StartSpanFromContext(ctx, "Blobovniczas.Put",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	if b.readOnly {
 		return common.PutRes{}, common.ErrReadOnly
 	}
 
 	if !prm.DontCompress {
-		prm.RawData = b.compression.Compress(prm.RawData)
+		prm.RawData = b.compression.CompressObject(prm.Object, prm.RawData)
 	}
 
 	var putPrm blobovnicza.PutPrm
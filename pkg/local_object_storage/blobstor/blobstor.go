@@ -43,6 +43,7 @@ type cfg struct {
 	compression compression.Config
 	log         *logger.Logger
 	storage     []SubStorage
+	metrics     MetricsWriter
 }
 
 func initConfig(c *cfg) {
@@ -62,6 +63,25 @@ func New(opts ...Option) *BlobStor {
 		bs.storage[i].Storage.SetCompressor(&bs.compression)
 	}
 
+	if bs.metrics != nil {
+		// do not modify the slice passed in options
+		measured := make([]SubStorage, len(bs.storage))
+		for i := range bs.storage {
+			measured[i] = SubStorage{
+				Storage: measuredStorage{
+					Storage: bs.storage[i].Storage,
+					metrics: storageMetrics{
+						typ: bs.storage[i].Storage.Type(),
+						mw:  bs.metrics,
+					},
+				},
+				Policy: bs.storage[i].Policy,
+			}
+		}
+
+		bs.storage = measured
+	}
+
 	return bs
 }
 
@@ -87,7 +107,8 @@ func WithLogger(l *logger.Logger) Option {
 // WithCompressObjects returns option to toggle
 // compression of the stored objects.
 //
-// If true, Zstandard algorithm is used for data compression.
+// If true, the algorithm set by WithCompressionAlgorithm
+// (Zstandard by default) is used for data compression.
 //
 // If compressor (decompressor) creation failed,
 // the uncompressed option will be used, and the error
@@ -106,6 +127,40 @@ func WithUncompressableContentTypes(values []string) Option {
 	}
 }
 
+// WithCompressionAlgorithm returns option to specify the default
+// compression algorithm. Zstandard is used if not set.
+func WithCompressionAlgorithm(alg compression.Algorithm) Option {
+	return func(c *cfg) {
+		c.compression.Algorithm = alg
+	}
+}
+
+// WithCompressionLevel returns option to specify the compression level
+// of Zstandard algorithm. Default level is used if zero.
+func WithCompressionLevel(level int) Option {
+	return func(c *cfg) {
+		c.compression.Level = level
+	}
+}
+
+// WithCompressionContentTypeAlgorithms returns option to specify
+// compression algorithms for specific content types as seen by
+// object.AttributeContentType attribute.
+func WithCompressionContentTypeAlgorithms(values []compression.ContentTypeAlgorithm) Option {
+	return func(c *cfg) {
+		c.compression.ContentTypeAlgorithms = values
+	}
+}
+
+// WithCompressibilityEstimation returns option to skip compression
+// of the objects which data sample compression ratio exceeds threshold.
+func WithCompressibilityEstimation(enabled bool, threshold float64) Option {
+	return func(c *cfg) {
+		c.compression.EstimateCompressibility = enabled
+		c.compression.EstimateCompressibilityThreshold = threshold
+	}
+}
+
 // SetReportErrorFunc allows to provide a function to be called on disk errors.
 // This function MUST be called before Open.
 func (b *BlobStor) SetReportErrorFunc(f func(string, error)) {
@@ -1,6 +1,7 @@
 package blobstor
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -58,11 +59,11 @@ func TestCompression(t *testing.T) {
 	}
 
 	testGet := func(t *testing.T, b *BlobStor, i int) {
-		res1, err := b.Get(common.GetPrm{Address: object.AddressOf(smallObj[i])})
+		res1, err := b.Get(context.Background(), common.GetPrm{Address: object.AddressOf(smallObj[i])})
 		require.NoError(t, err)
 		require.Equal(t, smallObj[i], res1.Object)
 
-		res2, err := b.Get(common.GetPrm{Address: object.AddressOf(bigObj[i])})
+		res2, err := b.Get(context.Background(), common.GetPrm{Address: object.AddressOf(bigObj[i])})
 		require.NoError(t, err)
 		require.Equal(t, bigObj[i], res2.Object)
 	}
@@ -70,12 +71,12 @@ func TestCompression(t *testing.T) {
 	testPut := func(t *testing.T, b *BlobStor, i int) {
 		var prm common.PutPrm
 		prm.Object = smallObj[i]
-		_, err = b.Put(prm)
+		_, err = b.Put(context.Background(), prm)
 		require.NoError(t, err)
 
 		prm = common.PutPrm{}
 		prm.Object = bigObj[i]
-		_, err = b.Put(prm)
+		_, err = b.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
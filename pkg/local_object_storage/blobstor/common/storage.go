@@ -1,6 +1,10 @@
 package common
 
-import "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+)
 
 // Storage represents key-value object storage.
 // It is used as a building block for a blobstor of a shard.
@@ -16,10 +20,10 @@ type Storage interface {
 	// This function MUST be called before Open.
 	SetReportErrorFunc(f func(string, error))
 
-	Get(GetPrm) (GetRes, error)
-	GetRange(GetRangePrm) (GetRangeRes, error)
+	Get(context.Context, GetPrm) (GetRes, error)
+	GetRange(context.Context, GetRangePrm) (GetRangeRes, error)
 	Exists(ExistsPrm) (ExistsRes, error)
-	Put(PutPrm) (PutRes, error)
+	Put(context.Context, PutPrm) (PutRes, error)
 	Delete(DeletePrm) (DeleteRes, error)
 	Iterate(IteratePrm) (IterateRes, error)
 }
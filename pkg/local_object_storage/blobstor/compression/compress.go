@@ -2,17 +2,66 @@ package compression
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/klauspost/compress/zstd"
 )
 
+// Algorithm is a compression algorithm.
+type Algorithm string
+
+const (
+	// AlgorithmNone stores the data uncompressed.
+	AlgorithmNone Algorithm = "none"
+	// AlgorithmZSTD is Zstandard compression, the default one.
+	AlgorithmZSTD Algorithm = "zstd"
+	// AlgorithmLZ4 is LZ4 compression.
+	AlgorithmLZ4 Algorithm = "lz4"
+)
+
+// DefaultEstimateCompressibilityThreshold is the default value
+// of Config.EstimateCompressibilityThreshold.
+const DefaultEstimateCompressibilityThreshold = 0.9
+
+// ContentTypeAlgorithm sets the compression algorithm
+// for the objects with the specific content type.
+type ContentTypeAlgorithm struct {
+	// ContentType is a value of the Content-Type object attribute.
+	// It can contain a star `*` as a first (last) character,
+	// which matches any prefix (suffix).
+	ContentType string
+	// Algorithm is the algorithm used for the matching objects.
+	Algorithm Algorithm
+}
+
 // Config represents common compression-related configuration.
+//
+// Compressed data always contains the frame magic of the algorithm, so it
+// can be decompressed regardless of the current settings.
 type Config struct {
 	Enabled                    bool
 	UncompressableContentTypes []string
 
+	// Algorithm is the compression algorithm used by default.
+	// AlgorithmZSTD is used if empty.
+	Algorithm Algorithm
+	// Level is the compression level of AlgorithmZSTD.
+	// Default level (3) is used if zero.
+	Level int
+	// ContentTypeAlgorithms overrides Algorithm for the objects
+	// with the specific content types. The first match is used.
+	ContentTypeAlgorithms []ContentTypeAlgorithm
+
+	// EstimateCompressibility enables skipping the compression of the data
+	// which sample doesn't compress well.
+	EstimateCompressibility bool
+	// EstimateCompressibilityThreshold is the maximum ratio of compressed
+	// sample size to the original one for the data to be compressed.
+	// DefaultEstimateCompressibilityThreshold is used if zero.
+	EstimateCompressibilityThreshold float64
+
 	encoder *zstd.Encoder
 	decoder *zstd.Decoder
 }
@@ -21,12 +70,44 @@ type Config struct {
 // https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
 var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
 
+const (
+	// sampleChunkSize is the size of a single chunk of the sample used
+	// to estimate data compressibility.
+	sampleChunkSize = 4 << 10
+	// sampleChunkCount is the number of chunks in the sample used
+	// to estimate data compressibility.
+	sampleChunkCount = 4
+)
+
 // Init initializes compression routines.
 func (c *Config) Init() error {
 	var err error
 
-	if c.Enabled {
-		c.encoder, err = zstd.NewWriter(nil)
+	if c.Algorithm == "" {
+		c.Algorithm = AlgorithmZSTD
+	}
+	if c.EstimateCompressibilityThreshold == 0 {
+		c.EstimateCompressibilityThreshold = DefaultEstimateCompressibilityThreshold
+	}
+
+	useZSTD := c.Algorithm == AlgorithmZSTD
+	if err := checkAlgorithm(c.Algorithm); err != nil {
+		return err
+	}
+	for i := range c.ContentTypeAlgorithms {
+		if err := checkAlgorithm(c.ContentTypeAlgorithms[i].Algorithm); err != nil {
+			return err
+		}
+		useZSTD = useZSTD || c.ContentTypeAlgorithms[i].Algorithm == AlgorithmZSTD
+	}
+
+	if c.Enabled && useZSTD {
+		opts := []zstd.EOption{}
+		if c.Level != 0 {
+			opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(c.Level)))
+		}
+
+		c.encoder, err = zstd.NewWriter(nil, opts...)
 		if err != nil {
 			return err
 		}
@@ -40,54 +121,131 @@ func (c *Config) Init() error {
 	return nil
 }
 
+func checkAlgorithm(a Algorithm) error {
+	switch a {
+	case AlgorithmNone, AlgorithmZSTD, AlgorithmLZ4:
+		return nil
+	default:
+		return fmt.Errorf("unknown compression algorithm: %s", a)
+	}
+}
+
 // NeedsCompression returns true if the object should be compressed.
 // For an object to be compressed 2 conditions must hold:
 // 1. Compression is enabled in settings.
 // 2. Object MIME Content-Type is allowed for compression.
 func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
-	if !c.Enabled || len(c.UncompressableContentTypes) == 0 {
-		return c.Enabled
+	return c.Enabled && c.algorithm(obj) != AlgorithmNone
+}
+
+// algorithm returns the compression algorithm for the object.
+func (c *Config) algorithm(obj *objectSDK.Object) Algorithm {
+	if obj == nil {
+		return c.Algorithm
 	}
 
 	for _, attr := range obj.Attributes() {
 		if attr.Key() == objectSDK.AttributeContentType {
 			for _, value := range c.UncompressableContentTypes {
-				match := false
-				switch {
-				case len(value) > 0 && value[len(value)-1] == '*':
-					match = strings.HasPrefix(attr.Value(), value[:len(value)-1])
-				case len(value) > 0 && value[0] == '*':
-					match = strings.HasSuffix(attr.Value(), value[1:])
-				default:
-					match = attr.Value() == value
+				if matchContentType(attr.Value(), value) {
+					return AlgorithmNone
 				}
-				if match {
-					return false
+			}
+			for i := range c.ContentTypeAlgorithms {
+				if matchContentType(attr.Value(), c.ContentTypeAlgorithms[i].ContentType) {
+					return c.ContentTypeAlgorithms[i].Algorithm
 				}
 			}
 		}
 	}
 
-	return c.Enabled
+	return c.Algorithm
+}
+
+func matchContentType(contentType, pattern string) bool {
+	switch {
+	case len(pattern) > 0 && pattern[len(pattern)-1] == '*':
+		return strings.HasPrefix(contentType, pattern[:len(pattern)-1])
+	case len(pattern) > 0 && pattern[0] == '*':
+		return strings.HasSuffix(contentType, pattern[1:])
+	default:
+		return contentType == pattern
+	}
 }
 
 // Decompress decompresses data if it starts with the magic
-// and returns data untouched otherwise.
+// of any supported algorithm and returns data untouched otherwise.
 func (c *Config) Decompress(data []byte) ([]byte, error) {
-	if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
+	switch {
+	case len(data) < 4:
+		return data, nil
+	case bytes.Equal(data[:4], zstdFrameMagic):
+		return c.decoder.DecodeAll(data, nil)
+	case bytes.Equal(data[:4], lz4FrameMagic):
+		return lz4Decompress(data)
+	default:
 		return data, nil
 	}
-	return c.decoder.DecodeAll(data, nil)
 }
 
-// Compress compresses data if compression is enabled
-// and returns data untouched otherwise.
+// Compress compresses data with the default algorithm if compression
+// is enabled and returns data untouched otherwise.
 func (c *Config) Compress(data []byte) []byte {
+	return c.CompressObject(nil, data)
+}
+
+// CompressObject compresses data of the object with the algorithm selected
+// for the object content type. Returns data untouched if compression
+// is disabled or the object doesn't need compression. obj can be nil,
+// the default algorithm is used in this case.
+func (c *Config) CompressObject(obj *objectSDK.Object, data []byte) []byte {
 	if c == nil || !c.Enabled {
 		return data
 	}
-	maxSize := c.encoder.MaxEncodedSize(len(data))
-	return c.encoder.EncodeAll(data, make([]byte, 0, maxSize))
+
+	alg := c.algorithm(obj)
+	if alg == AlgorithmNone {
+		return data
+	}
+
+	if c.EstimateCompressibility && len(data) > sampleChunkSize*sampleChunkCount {
+		sample := sampleData(data)
+		if !c.compressedWell(sample, c.compress(alg, sample)) {
+			return data
+		}
+	}
+
+	res := c.compress(alg, data)
+	if c.EstimateCompressibility && !c.compressedWell(data, res) {
+		return data
+	}
+	return res
+}
+
+func (c *Config) compress(alg Algorithm, data []byte) []byte {
+	switch alg {
+	case AlgorithmLZ4:
+		return lz4Compress(data)
+	default:
+		maxSize := c.encoder.MaxEncodedSize(len(data))
+		return c.encoder.EncodeAll(data, make([]byte, 0, maxSize))
+	}
+}
+
+// compressedWell checks whether the compression ratio satisfies
+// the threshold.
+func (c *Config) compressedWell(data, compressed []byte) bool {
+	return float64(len(compressed)) <= float64(len(data))*c.EstimateCompressibilityThreshold
+}
+
+// sampleData returns sampleChunkCount evenly spaced chunks of data.
+func sampleData(data []byte) []byte {
+	sample := make([]byte, 0, sampleChunkSize*sampleChunkCount)
+	step := (len(data) - sampleChunkSize) / (sampleChunkCount - 1)
+	for i := 0; i < sampleChunkCount; i++ {
+		sample = append(sample, data[i*step:i*step+sampleChunkSize]...)
+	}
+	return sample
 }
 
 // Close closes encoder and decoder, returns any error occurred.
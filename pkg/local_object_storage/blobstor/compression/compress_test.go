@@ -0,0 +1,153 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("compressible data "), 1000)
+
+	for _, alg := range []Algorithm{AlgorithmZSTD, AlgorithmLZ4, AlgorithmNone} {
+		t.Run(string(alg), func(t *testing.T) {
+			c := Config{Enabled: true, Algorithm: alg}
+			require.NoError(t, c.Init())
+			defer c.Close()
+
+			compressed := c.Compress(data)
+			if alg == AlgorithmNone {
+				require.Equal(t, data, compressed)
+			} else {
+				require.Less(t, len(compressed), len(data))
+			}
+
+			res, err := c.Decompress(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, res)
+		})
+	}
+}
+
+func TestCompressionLevel(t *testing.T) {
+	data := notSoRandomSlice(64*1024, 123)
+
+	c := Config{Enabled: true, Level: 19}
+	require.NoError(t, c.Init())
+	defer c.Close()
+
+	res, err := c.Decompress(c.Compress(data))
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+}
+
+func TestCompressionUnknownAlgorithm(t *testing.T) {
+	c := Config{Enabled: true, Algorithm: "unknown"}
+	require.Error(t, c.Init())
+
+	c = Config{Enabled: true, ContentTypeAlgorithms: []ContentTypeAlgorithm{
+		{ContentType: "text/plain", Algorithm: "unknown"},
+	}}
+	require.Error(t, c.Init())
+}
+
+func TestDecompressAfterSettingsChange(t *testing.T) {
+	data := bytes.Repeat([]byte("compressible data "), 1000)
+
+	var stored [][]byte
+	for _, alg := range []Algorithm{AlgorithmZSTD, AlgorithmLZ4} {
+		c := Config{Enabled: true, Algorithm: alg}
+		require.NoError(t, c.Init())
+		stored = append(stored, c.Compress(data))
+		require.NoError(t, c.Close())
+	}
+
+	c := Config{Enabled: false}
+	require.NoError(t, c.Init())
+	defer c.Close()
+
+	for i := range stored {
+		res, err := c.Decompress(stored[i])
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	}
+}
+
+func TestContentTypeAlgorithms(t *testing.T) {
+	data := bytes.Repeat([]byte("compressible data "), 1000)
+
+	c := Config{
+		Enabled:                    true,
+		Algorithm:                  AlgorithmZSTD,
+		UncompressableContentTypes: []string{"video/*"},
+		ContentTypeAlgorithms: []ContentTypeAlgorithm{
+			{ContentType: "text/*", Algorithm: AlgorithmLZ4},
+			{ContentType: "*/json", Algorithm: AlgorithmNone},
+			{ContentType: "video/mp4", Algorithm: AlgorithmLZ4},
+		},
+	}
+	require.NoError(t, c.Init())
+	defer c.Close()
+
+	testCases := []struct {
+		contentType string
+		magic       []byte
+	}{
+		{"", zstdFrameMagic},
+		{"text/plain", lz4FrameMagic},
+		{"application/json", nil},
+		{"video/mp4", nil},
+		{"image/png", zstdFrameMagic},
+	}
+
+	for _, tc := range testCases {
+		obj := objectSDK.New()
+		if tc.contentType != "" {
+			var a objectSDK.Attribute
+			a.SetKey(objectSDK.AttributeContentType)
+			a.SetValue(tc.contentType)
+			obj.SetAttributes(a)
+		}
+
+		require.Equal(t, tc.magic != nil, c.NeedsCompression(obj), tc.contentType)
+
+		compressed := c.CompressObject(obj, data)
+		if tc.magic == nil {
+			require.Equal(t, data, compressed, tc.contentType)
+		} else {
+			require.Equal(t, tc.magic, compressed[:4], tc.contentType)
+		}
+
+		res, err := c.Decompress(compressed)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	}
+}
+
+func TestEstimateCompressibility(t *testing.T) {
+	random := make([]byte, 64*1024)
+	_, _ = rand.Read(random)
+
+	compressible := notSoRandomSlice(64*1024, 123)
+
+	for _, alg := range []Algorithm{AlgorithmZSTD, AlgorithmLZ4} {
+		t.Run(string(alg), func(t *testing.T) {
+			c := Config{Enabled: true, Algorithm: alg, EstimateCompressibility: true}
+			require.NoError(t, c.Init())
+			defer c.Close()
+
+			require.Equal(t, random, c.Compress(random))
+			require.Equal(t, random[:100], c.Compress(random[:100]))
+
+			compressed := c.Compress(compressible)
+			require.Less(t, len(compressed), len(compressible))
+
+			res, err := c.Decompress(compressed)
+			require.NoError(t, err)
+			require.Equal(t, compressible, res)
+		})
+	}
+}
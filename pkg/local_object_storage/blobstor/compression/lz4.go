@@ -0,0 +1,50 @@
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// lz4FrameMagic contains first 4 bytes of any LZ4 frame
+// https://github.com/lz4/lz4/blob/dev/doc/lz4_Frame_format.md .
+var lz4FrameMagic = []byte{0x04, 0x22, 0x4d, 0x18}
+
+// lz4Compress compresses data into a single LZ4 frame with content size
+// and content checksum. Returns data untouched if it can't be compressed.
+func lz4Compress(data []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, lz4.CompressBlockBound(len(data))+len(lz4FrameMagic)+32))
+
+	w := lz4.NewWriter(buf)
+
+	err := w.Apply(
+		lz4.BlockSizeOption(lz4.Block4Mb),
+		lz4.ChecksumOption(true),
+		lz4.SizeOption(uint64(len(data))),
+	)
+	if err == nil {
+		_, err = w.Write(data)
+	}
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		return data
+	}
+
+	return buf.Bytes()
+}
+
+// lz4Decompress decompresses LZ4 frame.
+func lz4Decompress(data []byte) ([]byte, error) {
+	r := lz4.NewReader(bytes.NewReader(data))
+
+	res, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress lz4 frame: %w", err)
+	}
+
+	return res, nil
+}
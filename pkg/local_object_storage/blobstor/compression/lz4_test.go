@@ -0,0 +1,106 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func lz4TestCases() map[string][]byte {
+	random := make([]byte, 100*1024)
+	_, _ = rand.Read(random)
+
+	incompressible := make([]byte, 9<<20)
+	_, _ = rand.Read(incompressible)
+
+	return map[string][]byte{
+		"empty":          {},
+		"short":          []byte("abc"),
+		"zeroed":         make([]byte, 100*1024),
+		"repeated":       bytes.Repeat([]byte("0123456789"), 10*1024),
+		"random":         random,
+		"not random":     notSoRandomSlice(100*1024, 1000),
+		"multi-block":    notSoRandomSlice(4<<20+12345, 70000),
+		"large":          notSoRandomSlice(32<<20, 1<<20),
+		"incompressible": incompressible,
+	}
+}
+
+func TestLZ4(t *testing.T) {
+	for name, data := range lz4TestCases() {
+		t.Run(name, func(t *testing.T) {
+			compressed := lz4Compress(data)
+			require.Equal(t, lz4FrameMagic, compressed[:4])
+
+			res, err := lz4Decompress(compressed)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(data, res))
+
+			t.Run("reference decoder", func(t *testing.T) {
+				res, err := io.ReadAll(lz4.NewReader(bytes.NewReader(compressed)))
+				require.NoError(t, err)
+				require.True(t, bytes.Equal(data, res))
+			})
+		})
+	}
+}
+
+func TestLZ4Decompress(t *testing.T) {
+	// Produced by `echo -n "hello hello hello hello" | lz4 -c`.
+	frame, err := hex.DecodeString("04224d186440a70f0000006868656c6c6f2006005068656c6c6f00000000f26b940b")
+	require.NoError(t, err)
+
+	res, err := lz4Decompress(frame)
+	require.NoError(t, err)
+	require.Equal(t, "hello hello hello hello", string(res))
+
+	t.Run("reference encoder", func(t *testing.T) {
+		options := map[string][]lz4.Option{
+			"default":        nil,
+			"small blocks":   {lz4.BlockSizeOption(lz4.Block64Kb)},
+			"block checksum": {lz4.BlockChecksumOption(true), lz4.ChecksumOption(false)},
+			"concurrent":     {lz4.ConcurrencyOption(4), lz4.BlockSizeOption(lz4.Block256Kb)},
+			"high level":     {lz4.CompressionLevelOption(lz4.Level9)},
+		}
+
+		data := lz4TestCases()
+
+		for name, opts := range options {
+			for _, key := range []string{"empty", "random", "multi-block", "incompressible"} {
+				t.Run(name+"/"+key, func(t *testing.T) {
+					var buf bytes.Buffer
+
+					w := lz4.NewWriter(&buf)
+					require.NoError(t, w.Apply(opts...))
+
+					_, err := w.Write(data[key])
+					require.NoError(t, err)
+					require.NoError(t, w.Close())
+
+					res, err := lz4Decompress(buf.Bytes())
+					require.NoError(t, err)
+					require.True(t, bytes.Equal(data[key], res))
+				})
+			}
+		}
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		data := lz4Compress(bytes.Repeat([]byte("0123456789"), 1024))
+		for _, i := range []int{4, 6, len(data) - 1} {
+			corrupted := append([]byte{}, data...)
+			corrupted[i]++
+
+			_, err := lz4Decompress(corrupted)
+			require.Error(t, err)
+		}
+
+		_, err := lz4Decompress(data[:len(data)/2])
+		require.Error(t, err)
+	})
+}
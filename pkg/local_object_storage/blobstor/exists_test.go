@@ -1,6 +1,7 @@
 package blobstor
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -33,7 +34,7 @@ func TestExists(t *testing.T) {
 	for i := range objects {
 		var prm common.PutPrm
 		prm.Object = objects[i]
-		_, err = b.Put(prm)
+		_, err = b.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
@@ -1,6 +1,7 @@
 package fstree
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -13,11 +14,14 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // FSTree represents an object storage as a filesystem tree.
@@ -147,7 +151,9 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 			if err != nil {
 				if prm.IgnoreErrors {
 					if prm.ErrorHandler != nil {
-						return prm.ErrorHandler(*addr, err)
+						if err := prm.ErrorHandler(*addr, err); err != nil {
+							return err
+						}
 					}
 					continue
 				}
@@ -225,7 +231,13 @@ func (t *FSTree) getPath(addr oid.Address) (string, error) {
 }
 
 // Put puts an object in the storage.
-func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
+func (t *FSTree) Put(ctx context.Context, prm common.PutPrm) (_ common.PutRes, err error) {
+	_, span := tracing.StartSpanFromContext(ctx, "FSTree.Put",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	if t.readOnly {
 		return common.PutRes{}, common.ErrReadOnly
 	}
@@ -236,11 +248,11 @@ func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
 		return common.PutRes{}, err
 	}
 	if !prm.DontCompress {
-		prm.RawData = t.Compress(prm.RawData)
+		prm.RawData = t.CompressObject(prm.Object, prm.RawData)
 	}
 
 	tmpPath := p + "#"
-	err := t.writeFile(tmpPath, prm.RawData)
+	err = t.writeFile(tmpPath, prm.RawData)
 	if err != nil {
 		var pe *fs.PathError
 		if errors.As(err, &pe) && pe.Err == syscall.ENOSPC {
@@ -296,7 +308,13 @@ func (t *FSTree) PutStream(addr oid.Address, handler func(*os.File) error) error
 }
 
 // Get returns an object from the storage by address.
-func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
+func (t *FSTree) Get(ctx context.Context, prm common.GetPrm) (_ common.GetRes, err error) {
+	_, span := tracing.StartSpanFromContext(ctx, "FSTree.Get",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	p := t.treePath(prm.Address)
 
 	if _, err := os.Stat(p); os.IsNotExist(err) {
@@ -322,8 +340,14 @@ func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
 }
 
 // GetRange implements common.Storage.
-func (t *FSTree) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
-	res, err := t.Get(common.GetPrm{Address: prm.Address})
+func (t *FSTree) GetRange(ctx context.Context, prm common.GetRangePrm) (_ common.GetRangeRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "FSTree.GetRange",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
+	res, err := t.Get(ctx, common.GetPrm{Address: prm.Address})
 	if err != nil {
 		return common.GetRangeRes{}, err
 	}
@@ -364,6 +388,55 @@ func (t *FSTree) NumberOfObjects() (uint64, error) {
 	return counter, nil
 }
 
+// Usage walks the file tree rooted at FSTree's root and returns number
+// of stored objects and their total size in bytes. Files that do not
+// correspond to any object are skipped.
+func (t *FSTree) Usage() (uint64, uint64, error) {
+	var count, size uint64
+
+	err := filepath.WalkDir(t.RootPath,
+		func(_ string, d fs.DirEntry, _ error) error {
+			if d == nil || d.IsDir() {
+				return nil
+			}
+			if _, err := addressFromString(d.Name()); err != nil {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+
+			count++
+			size += uint64(info.Size())
+
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not walk through %s directory: %w", t.RootPath, err)
+	}
+
+	return count, size, nil
+}
+
+// ObjectSize returns the size of the file storing the object with
+// the specified address.
+//
+// Returns an error of type apistatus.ObjectNotFound if object is missing.
+func (t *FSTree) ObjectSize(addr oid.Address) (uint64, error) {
+	info, err := os.Stat(t.treePath(addr))
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+		return 0, err
+	}
+
+	return uint64(info.Size()), nil
+}
+
 // Type is fstree storage type used in logs and configuration.
 const Type = "fstree"
 
@@ -1,23 +1,34 @@
 package blobstor
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // GetRange reads object payload data from b.
 // If the descriptor is present, only one sub-storage is tried,
 // Otherwise, each sub-storage is tried in order.
-func (b *BlobStor) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+func (b *BlobStor) GetRange(ctx context.Context, prm common.GetRangePrm) (_ common.GetRangeRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "BlobStor.GetRange",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+			attribute.Bool("with_storage_id", prm.StorageID != nil),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	b.modeMtx.RLock()
 	defer b.modeMtx.RUnlock()
 
 	if prm.StorageID == nil {
 		for i := range b.storage {
-			res, err := b.storage[i].Storage.GetRange(prm)
+			res, err := b.storage[i].Storage.GetRange(ctx, prm)
 			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
 				return res, err
 			}
@@ -26,7 +37,7 @@ func (b *BlobStor) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error)
 		return common.GetRangeRes{}, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
 	if len(prm.StorageID) == 0 {
-		return b.storage[len(b.storage)-1].Storage.GetRange(prm)
+		return b.storage[len(b.storage)-1].Storage.GetRange(ctx, prm)
 	}
-	return b.storage[0].Storage.GetRange(prm)
+	return b.storage[0].Storage.GetRange(ctx, prm)
 }
@@ -1,6 +1,7 @@
 package blobstortest
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -67,7 +68,7 @@ func prepare(t *testing.T, count int, s common.Storage, min, max uint64) []objec
 		prm.Object = objects[i].obj
 		prm.RawData = objects[i].raw
 
-		putRes, err := s.Put(prm)
+		putRes, err := s.Put(context.Background(), prm)
 		require.NoError(t, err)
 
 		objects[i].storageID = putRes.StorageID
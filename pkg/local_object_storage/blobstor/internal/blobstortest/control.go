@@ -1,6 +1,7 @@
 package blobstortest
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -26,7 +27,7 @@ func TestControl(t *testing.T, cons Constructor, min, max uint64) {
 		prm.StorageID = objects[i].storageID
 		prm.Raw = true
 
-		_, err := s.Get(prm)
+		_, err := s.Get(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
@@ -35,7 +36,7 @@ func TestControl(t *testing.T, cons Constructor, min, max uint64) {
 		prm.Object = NewObject(min + uint64(rand.Intn(int(max-min+1))))
 		prm.Address = objectCore.AddressOf(prm.Object)
 
-		_, err := s.Put(prm)
+		_, err := s.Put(context.Background(), prm)
 		require.ErrorIs(t, err, common.ErrReadOnly)
 	})
 	t.Run("delete fails", func(t *testing.T) {
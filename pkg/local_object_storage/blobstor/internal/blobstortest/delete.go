@@ -1,6 +1,7 @@
 package blobstortest
 
 import (
+	"context"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
@@ -41,12 +42,12 @@ func TestDelete(t *testing.T, cons Constructor, min, max uint64) {
 		})
 		t.Run("get fail", func(t *testing.T) {
 			prm := common.GetPrm{Address: oidtest.Address()}
-			_, err := s.Get(prm)
+			_, err := s.Get(context.Background(), prm)
 			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 		})
 		t.Run("getrange fail", func(t *testing.T) {
 			prm := common.GetRangePrm{Address: oidtest.Address()}
-			_, err := s.GetRange(prm)
+			_, err := s.GetRange(context.Background(), prm)
 			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 		})
 	})
@@ -75,7 +76,7 @@ func TestDelete(t *testing.T, cons Constructor, min, max uint64) {
 		prm.Address = objects[3].addr
 		prm.Raw = true
 
-		res, err := s.Get(prm)
+		res, err := s.Get(context.Background(), prm)
 		require.NoError(t, err)
 		require.Equal(t, objects[3].raw, res.RawData)
 	})
@@ -1,6 +1,7 @@
 package blobstortest
 
 import (
+	"context"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
@@ -19,7 +20,7 @@ func TestGet(t *testing.T, cons Constructor, min, max uint64) {
 
 	t.Run("missing object", func(t *testing.T) {
 		gPrm := common.GetPrm{Address: oidtest.Address()}
-		_, err := s.Get(gPrm)
+		_, err := s.Get(context.Background(), gPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 
@@ -29,13 +30,13 @@ func TestGet(t *testing.T, cons Constructor, min, max uint64) {
 
 		// With storage ID.
 		gPrm.StorageID = objects[i].storageID
-		res, err := s.Get(gPrm)
+		res, err := s.Get(context.Background(), gPrm)
 		require.NoError(t, err)
 		require.Equal(t, objects[i].obj, res.Object)
 
 		// Without storage ID.
 		gPrm.StorageID = nil
-		res, err = s.Get(gPrm)
+		res, err = s.Get(context.Background(), gPrm)
 		require.NoError(t, err)
 		require.Equal(t, objects[i].obj, res.Object)
 
@@ -43,7 +44,7 @@ func TestGet(t *testing.T, cons Constructor, min, max uint64) {
 		gPrm.StorageID = objects[i].storageID
 		gPrm.Raw = true
 
-		res, err = s.Get(gPrm)
+		res, err = s.Get(context.Background(), gPrm)
 		require.NoError(t, err)
 		require.Equal(t, objects[i].raw, res.RawData)
 	}
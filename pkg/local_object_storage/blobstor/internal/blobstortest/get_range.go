@@ -1,6 +1,7 @@
 package blobstortest
 
 import (
+	"context"
 	"math"
 	"testing"
 
@@ -20,7 +21,7 @@ func TestGetRange(t *testing.T, cons Constructor, min, max uint64) {
 
 	t.Run("missing object", func(t *testing.T) {
 		gPrm := common.GetRangePrm{Address: oidtest.Address()}
-		_, err := s.GetRange(gPrm)
+		_, err := s.GetRange(context.Background(), gPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 
@@ -38,14 +39,14 @@ func TestGetRange(t *testing.T, cons Constructor, min, max uint64) {
 
 	t.Run("without storage ID", func(t *testing.T) {
 		// Without storage ID.
-		res, err := s.GetRange(gPrm)
+		res, err := s.GetRange(context.Background(), gPrm)
 		require.NoError(t, err)
 		require.Equal(t, payload[start:stop], res.Data)
 	})
 
 	t.Run("with storage ID", func(t *testing.T) {
 		gPrm.StorageID = objects[0].storageID
-		res, err := s.GetRange(gPrm)
+		res, err := s.GetRange(context.Background(), gPrm)
 		require.NoError(t, err)
 		require.Equal(t, payload[start:stop], res.Data)
 	})
@@ -54,7 +55,7 @@ func TestGetRange(t *testing.T, cons Constructor, min, max uint64) {
 		gPrm.Range.SetOffset(uint64(len(payload) + 10))
 		gPrm.Range.SetLength(10)
 
-		_, err := s.GetRange(gPrm)
+		_, err := s.GetRange(context.Background(), gPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
 	})
 
@@ -62,7 +63,7 @@ func TestGetRange(t *testing.T, cons Constructor, min, max uint64) {
 		gPrm.Range.SetOffset(10)
 		gPrm.Range.SetLength(uint64(len(payload)))
 
-		_, err := s.GetRange(gPrm)
+		_, err := s.GetRange(context.Background(), gPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
 	})
 
@@ -70,7 +71,7 @@ func TestGetRange(t *testing.T, cons Constructor, min, max uint64) {
 		gPrm.Range.SetOffset(0)
 		gPrm.Range.SetLength(1 << 63)
 
-		_, err := s.GetRange(gPrm)
+		_, err := s.GetRange(context.Background(), gPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
 	})
 
@@ -78,7 +79,7 @@ func TestGetRange(t *testing.T, cons Constructor, min, max uint64) {
 		gPrm.Range.SetOffset(10)
 		gPrm.Range.SetLength(math.MaxUint64 - 2)
 
-		_, err := s.GetRange(gPrm)
+		_, err := s.GetRange(context.Background(), gPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
 	})
 }
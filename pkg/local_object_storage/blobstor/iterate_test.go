@@ -1,6 +1,7 @@
 package blobstor
 
 import (
+	"context"
 	"encoding/binary"
 	"os"
 	"testing"
@@ -63,7 +64,7 @@ func TestIterateObjects(t *testing.T) {
 	}
 
 	for _, v := range mObjs {
-		_, err := blobStor.Put(common.PutPrm{Address: v.addr, RawData: v.data})
+		_, err := blobStor.Put(context.Background(), common.PutPrm{Address: v.addr, RawData: v.data})
 		require.NoError(t, err)
 	}
 
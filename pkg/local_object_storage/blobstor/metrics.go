@@ -0,0 +1,74 @@
+package blobstor
+
+import (
+	"context"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
+)
+
+// MetricsWriter is an interface that must store BlobStor metrics.
+type MetricsWriter interface {
+	// AddMethodDuration must store the duration of the sub-storage method call.
+	// Storage is the type of the sub-storage (see common.Storage.Type).
+	// Success is false if the call failed with non-logical error.
+	AddMethodDuration(storage, method string, success bool, d time.Duration)
+}
+
+// WithMetrics returns option to specify BlobStor metrics writer.
+// Calls of every sub-storage are measured separately.
+func WithMetrics(m MetricsWriter) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
+
+// storageMetrics passes the method durations of the
+// sub-storage of the given type to the MetricsWriter.
+type storageMetrics struct {
+	typ string
+	mw  MetricsWriter
+}
+
+func (m storageMetrics) AddMethodDuration(method string, success bool, d time.Duration) {
+	m.mw.AddMethodDuration(m.typ, method, success, d)
+}
+
+// measuredStorage is a common.Storage wrapper which reports
+// duration of the data operations to the MetricsWriter.
+type measuredStorage struct {
+	common.Storage
+
+	metrics util.MethodDurationWriter
+}
+
+func (s measuredStorage) Get(ctx context.Context, prm common.GetPrm) (_ common.GetRes, err error) {
+	defer util.Elapsed(s.metrics, "Get", &err)()
+	return s.Storage.Get(ctx, prm)
+}
+
+func (s measuredStorage) GetRange(ctx context.Context, prm common.GetRangePrm) (_ common.GetRangeRes, err error) {
+	defer util.Elapsed(s.metrics, "GetRange", &err)()
+	return s.Storage.GetRange(ctx, prm)
+}
+
+func (s measuredStorage) Exists(prm common.ExistsPrm) (_ common.ExistsRes, err error) {
+	defer util.Elapsed(s.metrics, "Exists", &err)()
+	return s.Storage.Exists(prm)
+}
+
+func (s measuredStorage) Put(ctx context.Context, prm common.PutPrm) (_ common.PutRes, err error) {
+	defer util.Elapsed(s.metrics, "Put", &err)()
+	return s.Storage.Put(ctx, prm)
+}
+
+func (s measuredStorage) Delete(prm common.DeletePrm) (_ common.DeleteRes, err error) {
+	defer util.Elapsed(s.metrics, "Delete", &err)()
+	return s.Storage.Delete(prm)
+}
+
+func (s measuredStorage) Iterate(prm common.IteratePrm) (_ common.IterateRes, err error) {
+	defer util.Elapsed(s.metrics, "Iterate", &err)()
+	return s.Storage.Iterate(prm)
+}
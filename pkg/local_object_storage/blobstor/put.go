@@ -1,12 +1,16 @@
 package blobstor
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ErrNoPlaceFound is returned when object can't be saved to any sub-storage component
@@ -21,7 +25,13 @@ var ErrNoPlaceFound = logicerr.New("couldn't find a place to store an object")
 //
 // Returns any error encountered that
 // did not allow to completely save the object.
-func (b *BlobStor) Put(prm common.PutPrm) (common.PutRes, error) {
+func (b *BlobStor) Put(ctx context.Context, prm common.PutPrm) (_ common.PutRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "BlobStor.Put",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	b.modeMtx.RLock()
 	defer b.modeMtx.RUnlock()
 
@@ -39,7 +49,7 @@ func (b *BlobStor) Put(prm common.PutPrm) (common.PutRes, error) {
 
 	for i := range b.storage {
 		if b.storage[i].Policy == nil || b.storage[i].Policy(prm.Object, prm.RawData) {
-			res, err := b.storage[i].Storage.Put(prm)
+			res, err := b.storage[i].Storage.Put(ctx, prm)
 			if err == nil {
 				logOp(b.log, putOp, prm.Address, b.storage[i].Storage.Type(), res.StorageID)
 			}
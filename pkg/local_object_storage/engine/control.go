@@ -95,6 +95,10 @@ func (e *StorageEngine) Init() error {
 	e.wg.Add(1)
 	go e.setModeLoop()
 
+	e.initialized = true
+
+	e.loadEvacuation()
+
 	return nil
 }
 
@@ -290,29 +294,12 @@ loop:
 	}
 
 	for _, newID := range shardsToAdd {
-		sh, err := e.createShard(rcfg.shards[newID])
+		id, err := e.AddShard(rcfg.shards[newID]...)
 		if err != nil {
 			return fmt.Errorf("could not add new shard with '%s' metabase path: %w", newID, err)
 		}
 
-		idStr := sh.ID().String()
-
-		err = sh.Open()
-		if err == nil {
-			err = sh.Init()
-		}
-		if err != nil {
-			_ = sh.Close()
-			return fmt.Errorf("could not init %s shard: %w", idStr, err)
-		}
-
-		err = e.addShard(sh)
-		if err != nil {
-			_ = sh.Close()
-			return fmt.Errorf("could not add %s shard: %w", idStr, err)
-		}
-
-		e.log.Info("added new shard", zap.String("id", idStr))
+		e.log.Info("added new shard", zap.Stringer("id", id))
 	}
 
 	return nil
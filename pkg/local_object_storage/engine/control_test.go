@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -28,7 +29,7 @@ func TestExecBlocks(t *testing.T) {
 
 	addr := object.AddressOf(obj)
 
-	require.NoError(t, Put(e, obj))
+	require.NoError(t, Put(context.Background(), e, obj))
 
 	// block executions
 	errBlock := errors.New("block exec err")
@@ -36,20 +37,20 @@ func TestExecBlocks(t *testing.T) {
 	require.NoError(t, e.BlockExecution(errBlock))
 
 	// try to exec some op
-	_, err := Head(e, addr)
+	_, err := Head(context.Background(), e, addr)
 	require.ErrorIs(t, err, errBlock)
 
 	// resume executions
 	require.NoError(t, e.ResumeExecution())
 
-	_, err = Head(e, addr) // can be any data-related op
+	_, err = Head(context.Background(), e, addr) // can be any data-related op
 	require.NoError(t, err)
 
 	// close
 	require.NoError(t, e.Close())
 
 	// try exec after close
-	_, err = Head(e, addr)
+	_, err = Head(context.Background(), e, addr)
 	require.Error(t, err)
 
 	// try to resume
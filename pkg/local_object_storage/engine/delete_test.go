@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -57,9 +58,9 @@ func TestDeleteBigObject(t *testing.T) {
 	defer e.Close()
 
 	for i := range children {
-		require.NoError(t, Put(e, children[i]))
+		require.NoError(t, Put(context.Background(), e, children[i]))
 	}
-	require.NoError(t, Put(e, link))
+	require.NoError(t, Put(context.Background(), e, link))
 
 	var splitErr *objectSDK.SplitInfoError
 
@@ -91,7 +92,7 @@ func checkGetError(t *testing.T, e *StorageEngine, addr oid.Address, expected in
 	var getPrm GetPrm
 	getPrm.WithAddress(addr)
 
-	_, err := e.Get(getPrm)
+	_, err := e.Get(context.Background(), getPrm)
 	if expected != nil {
 		require.ErrorAs(t, err, expected)
 	} else {
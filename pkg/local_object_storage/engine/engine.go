@@ -32,6 +32,14 @@ type StorageEngine struct {
 
 		err error
 	}
+
+	// initialized is set when the engine is initialized,
+	// shards can be added at runtime after that.
+	initialized bool
+
+	rebalance rebalancer
+
+	evacuation evacuator
 }
 
 type shardWrapper struct {
@@ -202,6 +210,12 @@ type cfg struct {
 	metrics MetricRegister
 
 	shardPoolSize uint32
+
+	rebalanceRateLimit uint32
+
+	rebalanceOnShardAdd bool
+
+	evacuationStatePath string
 }
 
 func defaultCfg() *cfg {
@@ -209,6 +223,8 @@ func defaultCfg() *cfg {
 		log: &logger.Logger{Logger: zap.L()},
 
 		shardPoolSize: 20,
+
+		rebalanceRateLimit: 100,
 	}
 }
 
@@ -257,3 +273,28 @@ func WithErrorThreshold(sz uint32) Option {
 		c.errorsThreshold = sz
 	}
 }
+
+// WithRebalanceRateLimit returns an option to specify the maximum number of objects
+// checked by the shard rebalancing per second. Zero value disables the limit.
+func WithRebalanceRateLimit(v uint32) Option {
+	return func(c *cfg) {
+		c.rebalanceRateLimit = v
+	}
+}
+
+// WithRebalanceOnShardAdd returns an option to start the shard rebalancing
+// when a new shard is added to the initialized engine.
+func WithRebalanceOnShardAdd(v bool) Option {
+	return func(c *cfg) {
+		c.rebalanceOnShardAdd = v
+	}
+}
+
+// WithEvacuationStatePath returns an option to specify the file where the background
+// shard evacuation state is saved, so that it can be resumed after the restart.
+// Empty path disables saving.
+func WithEvacuationStatePath(path string) Option {
+	return func(c *cfg) {
+		c.evacuationStatePath = path
+	}
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -61,7 +62,7 @@ func benchmarkExists(b *testing.B, shardNum int) {
 	addr := oidtest.Address()
 	for i := 0; i < 100; i++ {
 		obj := generateObjectWithCID(b, cidtest.ID())
-		err := Put(e, obj)
+		err := Put(context.Background(), e, obj)
 		if err != nil {
 			b.Fatal(err)
 		}
@@ -1,12 +1,16 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/TrueCloudLab/hrw"
@@ -17,12 +21,14 @@ import (
 type EvacuateShardPrm struct {
 	shardID      []*shard.ID
 	handler      func(oid.Address, *objectSDK.Object) error
+	treeHandler  func(context.Context, cidSDK.ID, string, pilorama.Forest) error
 	ignoreErrors bool
 }
 
 // EvacuateShardRes represents result of the EvacuateShard operation.
 type EvacuateShardRes struct {
-	count int
+	count     int
+	treeCount int
 }
 
 // WithShardIDList sets shard ID.
@@ -40,12 +46,24 @@ func (p *EvacuateShardPrm) WithFaultHandler(f func(oid.Address, *objectSDK.Objec
 	p.handler = f
 }
 
+// WithTreeHandler sets handler to call for trees which cannot be saved on other shards.
+// The handler receives the evacuated shard to read the tree operation log from.
+func (p *EvacuateShardPrm) WithTreeHandler(f func(context.Context, cidSDK.ID, string, pilorama.Forest) error) {
+	p.treeHandler = f
+}
+
 // Count returns amount of evacuated objects.
 // Objects for which handler returned no error are also assumed evacuated.
 func (p EvacuateShardRes) Count() int {
 	return p.count
 }
 
+// TreeCount returns amount of evacuated trees.
+// Trees for which tree handler returned no error are also assumed evacuated.
+func (p EvacuateShardRes) TreeCount() int {
+	return p.treeCount
+}
+
 const defaultEvacuateBatchSize = 100
 
 type pooledShard struct {
@@ -57,33 +75,40 @@ var errMustHaveTwoShards = errors.New("must have at least 1 spare shard")
 
 // Evacuate moves data from one shard to the others.
 // The shard being moved must be in read-only mode.
-func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error) {
-	sidList := make([]string, len(prm.shardID))
-	for i := range prm.shardID {
-		sidList[i] = prm.shardID[i].String()
+func (e *StorageEngine) Evacuate(ctx context.Context, prm EvacuateShardPrm) (EvacuateShardRes, error) {
+	return e.evacuate(ctx, shardIDStrings(prm.shardID), prm, nil)
+}
+
+func shardIDStrings(ids []*shard.ID) []string {
+	sidList := make([]string, len(ids))
+	for i := range ids {
+		sidList[i] = ids[i].String()
 	}
+	return sidList
+}
 
+// getEvacuationShards checks that the shards can be evacuated and returns
+// all engine shards with their weights and the shards being evacuated.
+func (e *StorageEngine) getEvacuationShards(sidList []string, withHandler bool) ([]pooledShard, []float64, map[string]*shard.Shard, error) {
 	e.mtx.RLock()
 	for i := range sidList {
 		sh, ok := e.shards[sidList[i]]
 		if !ok {
 			e.mtx.RUnlock()
-			return EvacuateShardRes{}, errShardNotFound
+			return nil, nil, nil, errShardNotFound
 		}
 
 		if !sh.GetMode().ReadOnly() {
 			e.mtx.RUnlock()
-			return EvacuateShardRes{}, shard.ErrMustBeReadOnly
+			return nil, nil, nil, shard.ErrMustBeReadOnly
 		}
 	}
 
-	if len(e.shards)-len(sidList) < 1 && prm.handler == nil {
+	if len(e.shards)-len(sidList) < 1 && !withHandler {
 		e.mtx.RUnlock()
-		return EvacuateShardRes{}, errMustHaveTwoShards
+		return nil, nil, nil, errMustHaveTwoShards
 	}
 
-	e.log.Info("started shards evacuation", zap.Strings("shard_ids", sidList))
-
 	// We must have all shards, to have correct information about their
 	// indexes in a sorted slice and set appropriate marks in the metabase.
 	// Evacuated shard is skipped during put.
@@ -110,6 +135,20 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 		}
 	}
 
+	return shards, weights, shardMap, nil
+}
+
+// evacuate moves objects and trees from the shards from sidList to the other shards.
+// Shard list from prm is ignored. If job is not nil, evacuation starts from the
+// saved cursors and the progress is reported to it after each batch.
+func (e *StorageEngine) evacuate(ctx context.Context, sidList []string, prm EvacuateShardPrm, job *evacuationJob) (EvacuateShardRes, error) {
+	shards, weights, shardMap, err := e.getEvacuationShards(sidList, prm.handler != nil)
+	if err != nil {
+		return EvacuateShardRes{}, err
+	}
+
+	e.log.Info("started shards evacuation", zap.Strings("shard_ids", sidList))
+
 	var listPrm shard.ListWithCursorPrm
 	listPrm.WithCount(defaultEvacuateBatchSize)
 
@@ -119,8 +158,25 @@ mainLoop:
 	for n := range sidList {
 		sh := shardMap[sidList[n]]
 
-		var c *meta.Cursor
+		c, done := job.shardCursor(sidList[n])
+		if done {
+			continue
+		}
+
 		for {
+			select {
+			case <-ctx.Done():
+				return res, ctx.Err()
+			default:
+			}
+
+			// listing moves the cursor, the previous position is kept
+			// to save the progress of the partially processed batch
+			var prev []byte
+			if c != nil {
+				prev, _ = c.MarshalBinary()
+			}
+
 			listPrm.WithCursor(c)
 
 			// TODO (@fyrchik): #1731 this approach doesn't work in degraded modes
@@ -128,6 +184,13 @@ mainLoop:
 			listRes, err := sh.ListWithCursor(listPrm)
 			if err != nil {
 				if errors.Is(err, meta.ErrEndOfListing) || errors.Is(err, shard.ErrDegradedMode) {
+					treeCount, err := e.evacuateTrees(ctx, sh, shardMap, prm, job)
+					res.treeCount += treeCount
+					if err != nil {
+						return res, err
+					}
+
+					job.finishShard(sidList[n])
 					continue mainLoop
 				}
 				return res, err
@@ -136,18 +199,35 @@ mainLoop:
 			// TODO (@fyrchik): #1731 parallelize the loop
 			lst := listRes.AddressList()
 
+			var evacuated, failed uint64
+
+			// interrupted saves the progress of the batch if the evacuation
+			// is cancelled before the i-th object is processed.
+			interrupted := func(i int) {
+				if ctx.Err() != nil {
+					e.saveEvacuationProgress(sh, job, prev, i, evacuated, failed)
+				}
+			}
+
 		loop:
 			for i := range lst {
+				if err := ctx.Err(); err != nil {
+					interrupted(i)
+					return res, err
+				}
+
 				addr := lst[i].Address
 
 				var getPrm shard.GetPrm
 				getPrm.SetAddress(addr)
 
-				getRes, err := sh.Get(getPrm)
+				getRes, err := sh.Get(ctx, getPrm)
 				if err != nil {
-					if prm.ignoreErrors {
+					if prm.ignoreErrors && ctx.Err() == nil {
+						failed++
 						continue
 					}
+					interrupted(i)
 					return res, err
 				}
 
@@ -156,7 +236,7 @@ mainLoop:
 					if _, ok := shardMap[shards[j].ID().String()]; ok {
 						continue
 					}
-					putDone, exists := e.putToShard(shards[j].hashedShard, j, shards[j].pool, addr, getRes.Object())
+					putDone, exists := e.putToShard(ctx, shards[j].hashedShard, j, shards[j].pool, addr, getRes.Object())
 					if putDone || exists {
 						if putDone {
 							e.log.Debug("object is moved to another shard",
@@ -165,6 +245,7 @@ mainLoop:
 								zap.Stringer("addr", addr))
 
 							res.count++
+							evacuated++
 						}
 						continue loop
 					}
@@ -173,17 +254,21 @@ mainLoop:
 				if prm.handler == nil {
 					// Do not check ignoreErrors flag here because
 					// ignoring errors on put make this command kinda useless.
+					interrupted(i)
 					return res, fmt.Errorf("%w: %s", errPutShard, lst[i])
 				}
 
 				err = prm.handler(addr, getRes.Object())
 				if err != nil {
+					interrupted(i)
 					return res, err
 				}
 				res.count++
+				evacuated++
 			}
 
 			c = listRes.Cursor()
+			job.update(sidList[n], c, evacuated, failed)
 		}
 	}
 
@@ -191,3 +276,181 @@ mainLoop:
 		zap.Strings("shard_ids", sidList))
 	return res, nil
 }
+
+// saveEvacuationProgress saves the progress of the interrupted batch: the
+// listing cursor is moved right after the first n processed objects listed
+// from the cursor encoded in prev, so that they are neither processed nor
+// counted again on resume. The counters are dropped if the cursor can't be
+// restored.
+func (e *StorageEngine) saveEvacuationProgress(sh *shard.Shard, job *evacuationJob, prev []byte, n int, evacuated, failed uint64) {
+	if job == nil || n == 0 {
+		return
+	}
+
+	var c *meta.Cursor
+	if prev != nil {
+		c = new(meta.Cursor)
+		if err := c.UnmarshalBinary(prev); err != nil {
+			return
+		}
+	}
+
+	var listPrm shard.ListWithCursorPrm
+	listPrm.WithCount(uint32(n))
+	listPrm.WithCursor(c)
+
+	// source shards are read-only, so the same objects are listed again
+	listRes, err := sh.ListWithCursor(listPrm)
+	if err != nil {
+		e.log.Warn("could not save evacuation progress",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+		return
+	}
+
+	job.update(sh.ID().String(), listRes.Cursor(), evacuated, failed)
+}
+
+// evacuateTrees copies all trees of the shard to the other shards.
+// Trees which cannot be saved locally are passed to the tree handler.
+func (e *StorageEngine) evacuateTrees(ctx context.Context, sh *shard.Shard, shardMap map[string]*shard.Shard,
+	prm EvacuateShardPrm, job *evacuationJob) (int, error) {
+	trees, err := sh.TreeListTrees()
+	if err != nil {
+		if errors.Is(err, shard.ErrPiloramaDisabled) || errors.Is(err, pilorama.ErrDegradedMode) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not list trees: %w", err)
+	}
+
+	var count int
+	for i := range trees {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+
+		if !e.evacuateTree(ctx, sh, trees[i], shardMap) {
+			if prm.treeHandler == nil {
+				return count, fmt.Errorf("%w: tree %s/%s", errPutShard, trees[i].CID, trees[i].TreeID)
+			}
+
+			err = prm.treeHandler(ctx, trees[i].CID, trees[i].TreeID, sh)
+			if err != nil {
+				return count, fmt.Errorf("could not evacuate tree %s/%s: %w", trees[i].CID, trees[i].TreeID, err)
+			}
+		}
+
+		e.log.Debug("tree is evacuated",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Stringer("cid", trees[i].CID),
+			zap.String("tree_id", trees[i].TreeID))
+
+		count++
+		job.addTree()
+	}
+
+	return count, nil
+}
+
+// evacuateTree copies the tree to another writable shard. The shard which
+// already has the tree is preferred. Returns false if there is no suitable shard.
+func (e *StorageEngine) evacuateTree(ctx context.Context, src *shard.Shard, tree pilorama.ContainerIDTreeID,
+	shardMap map[string]*shard.Shard) bool {
+	var withTree, withoutTree []hashedShard
+	for _, sh := range e.sortShardsByWeight(tree.CID) {
+		if _, ok := shardMap[sh.ID().String()]; ok || sh.GetMode() != mode.ReadWrite {
+			continue
+		}
+
+		exists, err := sh.TreeExists(tree.CID, tree.TreeID)
+		if err != nil {
+			continue
+		}
+
+		if exists {
+			withTree = append(withTree, sh)
+		} else {
+			withoutTree = append(withoutTree, sh)
+		}
+	}
+
+	for _, dst := range append(withTree, withoutTree...) {
+		err := copyTree(ctx, src, dst.Shard, tree)
+		if err == nil {
+			return true
+		}
+
+		e.log.Warn("could not copy tree to another shard",
+			zap.Stringer("from", src.ID()),
+			zap.Stringer("to", dst.ID()),
+			zap.Stringer("cid", tree.CID),
+			zap.String("tree_id", tree.TreeID),
+			zap.Error(err))
+	}
+
+	return false
+}
+
+// copyTree copies the tree snapshot and applies the whole operation log
+// of the tree from src to dst.
+func copyTree(ctx context.Context, src, dst pilorama.Forest, tree pilorama.ContainerIDTreeID) error {
+	d := pilorama.CIDDescriptor{CID: tree.CID, Position: 0, Size: 1}
+
+	height, err := copyTreeSnapshot(src, dst, d, tree.TreeID)
+	if err != nil {
+		return fmt.Errorf("could not copy tree snapshot: %w", err)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lm, err := src.TreeGetOpLog(tree.CID, tree.TreeID, height)
+		if err != nil {
+			return err
+		}
+		if lm.Time == 0 {
+			return nil
+		}
+
+		err = dst.TreeApply(d, tree.TreeID, &lm, true)
+		if err != nil {
+			return err
+		}
+
+		height = lm.Time + 1
+	}
+}
+
+const treeSnapshotBatchSize = 1000
+
+// copyTreeSnapshot copies the tree snapshot from src to dst. Returns the snapshot height.
+func copyTreeSnapshot(src, dst pilorama.Forest, d pilorama.CIDDescriptor, treeID string) (uint64, error) {
+	var cursor pilorama.SnapshotCursor
+
+	height, page, err := src.TreeGetSnapshot(d.CID, treeID, 0, &cursor, treeSnapshotBatchSize)
+	if err != nil || height == 0 {
+		return 0, err
+	}
+
+	for len(page) != 0 {
+		if err := dst.TreeApplySnapshot(d, treeID, height, page, true); err != nil {
+			return 0, err
+		}
+		if len(page) < treeSnapshotBatchSize {
+			break
+		}
+
+		var h uint64
+		h, page, err = src.TreeGetSnapshot(d.CID, treeID, height, &cursor, treeSnapshotBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		if h != height {
+			return 0, errors.New("tree snapshot has been changed")
+		}
+	}
+
+	return height, dst.TreeApplySnapshot(d, treeID, height, nil, false)
+}
@@ -0,0 +1,534 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// EvacuationState represents the state of the background shard evacuation.
+type EvacuationState uint8
+
+const (
+	// EvacuationStateRunning means that the evacuation is in progress.
+	EvacuationStateRunning EvacuationState = iota
+	// EvacuationStateCompleted means that all objects were evacuated.
+	EvacuationStateCompleted
+	// EvacuationStateFailed means that the evacuation was stopped because of an error.
+	EvacuationStateFailed
+	// EvacuationStateCancelled means that the evacuation was cancelled by the user.
+	EvacuationStateCancelled
+	// EvacuationStateInterrupted means that the evacuation was interrupted
+	// by the engine shutdown and can be continued with ResumeEvacuation.
+	EvacuationStateInterrupted
+)
+
+// String implements fmt.Stringer.
+func (s EvacuationState) String() string {
+	switch s {
+	case EvacuationStateRunning:
+		return "running"
+	case EvacuationStateCompleted:
+		return "completed"
+	case EvacuationStateFailed:
+		return "failed"
+	case EvacuationStateCancelled:
+		return "cancelled"
+	case EvacuationStateInterrupted:
+		return "interrupted"
+	default:
+		return "unknown"
+	}
+}
+
+// EvacuationStatus contains the progress of the background shard evacuation.
+type EvacuationStatus struct {
+	// ID is the evacuation job identifier.
+	ID string
+	// State is the current evacuation state.
+	State EvacuationState
+	// ShardIDs contains identifiers of the evacuated shards.
+	ShardIDs []string
+	// StartedAt is the time when the evacuation was started.
+	StartedAt time.Time
+	// FinishedAt is the time when the evacuation was finished.
+	// Zero if the evacuation is in progress or interrupted.
+	FinishedAt time.Time
+	// Total is the number of objects in the evacuated shards
+	// at the moment the evacuation was started.
+	Total uint64
+	// Evacuated is the number of evacuated objects.
+	Evacuated uint64
+	// Failed is the number of objects skipped because of errors.
+	Failed uint64
+	// TreesEvacuated is the number of evacuated trees.
+	TreesEvacuated uint64
+	// ETA is the estimated time left. Zero if unknown.
+	ETA time.Duration
+	// Error is the reason of the evacuation failure.
+	Error error
+}
+
+var (
+	errEvacuationInProgress = logicerr.New("shard evacuation is already in progress")
+	errEvacuationNotFound   = logicerr.New("shard evacuation not found")
+	errEvacuationNotRunning = logicerr.New("shard evacuation is not running")
+)
+
+// evacuationJob is a background shard evacuation.
+//
+// Methods used by the evacuation loop are no-op on nil receiver,
+// so the synchronous Evacuate can share the loop.
+type evacuationJob struct {
+	mtx sync.Mutex
+
+	status       EvacuationStatus
+	ignoreErrors bool
+
+	cursors map[string][]byte   // shard ID -> encoded listing cursor
+	done    map[string]struct{} // fully evacuated shards
+
+	// runStartedAt and runProcessed are used to estimate the time left.
+	runStartedAt time.Time
+	runProcessed uint64
+
+	cancel      context.CancelFunc
+	interrupted bool
+
+	statePath string
+	log       *logger.Logger
+}
+
+// evacuationJobState is the evacuation job state persisted between node restarts.
+type evacuationJobState struct {
+	ID           string            `json:"id"`
+	ShardIDs     []string          `json:"shard_ids"`
+	IgnoreErrors bool              `json:"ignore_errors"`
+	StartedAt    time.Time         `json:"started_at"`
+	Total        uint64            `json:"total"`
+	Evacuated    uint64            `json:"evacuated"`
+	Failed       uint64            `json:"failed"`
+	Trees        uint64            `json:"trees_evacuated"`
+	Done         []string          `json:"done,omitempty"`
+	Cursors      map[string][]byte `json:"cursors,omitempty"`
+}
+
+func (j *evacuationJob) shardCursor(sid string) (*meta.Cursor, bool) {
+	if j == nil {
+		return nil, false
+	}
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if _, ok := j.done[sid]; ok {
+		return nil, true
+	}
+
+	data, ok := j.cursors[sid]
+	if !ok {
+		return nil, false
+	}
+
+	c := new(meta.Cursor)
+	if err := c.UnmarshalBinary(data); err != nil {
+		// Start from the beginning, already evacuated objects
+		// will be listed again but this is harmless.
+		return nil, false
+	}
+	return c, false
+}
+
+func (j *evacuationJob) update(sid string, c *meta.Cursor, evacuated, failed uint64) {
+	if j == nil {
+		return
+	}
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	j.status.Evacuated += evacuated
+	j.status.Failed += failed
+
+	if c != nil {
+		data, err := c.MarshalBinary()
+		if err == nil {
+			j.cursors[sid] = data
+		}
+	}
+
+	j.persist()
+}
+
+func (j *evacuationJob) addTree() {
+	if j == nil {
+		return
+	}
+
+	j.mtx.Lock()
+	j.status.TreesEvacuated++
+	j.mtx.Unlock()
+}
+
+func (j *evacuationJob) finishShard(sid string) {
+	if j == nil {
+		return
+	}
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	j.done[sid] = struct{}{}
+	delete(j.cursors, sid)
+
+	j.persist()
+}
+
+func (j *evacuationJob) getStatus() EvacuationStatus {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	st := j.status
+	st.ShardIDs = append([]string(nil), st.ShardIDs...)
+
+	if st.State == EvacuationStateRunning {
+		processed := st.Evacuated + st.Failed
+		if processed > j.runProcessed && st.Total > processed {
+			speed := float64(processed-j.runProcessed) / float64(time.Since(j.runStartedAt))
+			st.ETA = time.Duration(float64(st.Total-processed) / speed)
+		}
+	}
+
+	return st
+}
+
+func (j *evacuationJob) setInterrupted() {
+	j.mtx.Lock()
+	j.interrupted = true
+	j.mtx.Unlock()
+}
+
+// finish sets the final job state according to the evacuation result.
+func (j *evacuationJob) finish(err error) EvacuationState {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	switch {
+	case err == nil:
+		j.status.State = EvacuationStateCompleted
+	case j.interrupted:
+		j.status.State = EvacuationStateInterrupted
+	case errors.Is(err, context.Canceled):
+		j.status.State = EvacuationStateCancelled
+	default:
+		j.status.State = EvacuationStateFailed
+		j.status.Error = err
+	}
+
+	if j.status.State == EvacuationStateInterrupted {
+		j.persist()
+	} else {
+		j.status.FinishedAt = time.Now()
+		j.removeState()
+	}
+
+	return j.status.State
+}
+
+// persist saves the job state to the file. Must be called with mtx held.
+func (j *evacuationJob) persist() {
+	if j.statePath == "" {
+		return
+	}
+
+	st := evacuationJobState{
+		ID:           j.status.ID,
+		ShardIDs:     j.status.ShardIDs,
+		IgnoreErrors: j.ignoreErrors,
+		StartedAt:    j.status.StartedAt,
+		Total:        j.status.Total,
+		Evacuated:    j.status.Evacuated,
+		Failed:       j.status.Failed,
+		Trees:        j.status.TreesEvacuated,
+		Cursors:      j.cursors,
+	}
+	for sid := range j.done {
+		st.Done = append(st.Done, sid)
+	}
+
+	data, err := json.Marshal(st)
+	if err != nil {
+		j.log.Error("could not encode shard evacuation state", zap.Error(err))
+		return
+	}
+
+	// Write to a temporary file first, so that the state
+	// is not corrupted if the node fails in the middle.
+	tmpPath := j.statePath + ".tmp"
+	err = os.WriteFile(tmpPath, data, 0600)
+	if err == nil {
+		err = os.Rename(tmpPath, j.statePath)
+	}
+	if err != nil {
+		j.log.Error("could not save shard evacuation state",
+			zap.String("path", j.statePath),
+			zap.Error(err))
+	}
+}
+
+func (j *evacuationJob) removeState() {
+	if j.statePath == "" {
+		return
+	}
+
+	err := os.Remove(j.statePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		j.log.Error("could not remove shard evacuation state",
+			zap.String("path", j.statePath),
+			zap.Error(err))
+	}
+}
+
+// evacuator holds the current (or the last) background shard evacuation.
+type evacuator struct {
+	mtx sync.Mutex
+	job *evacuationJob
+}
+
+// StartEvacuation starts moving data from the shards to the others in background
+// and returns the evacuation job identifier. The shards being moved must be in read-only mode.
+// Only one evacuation can run at a time.
+func (e *StorageEngine) StartEvacuation(prm EvacuateShardPrm) (string, error) {
+	sidList := shardIDStrings(prm.shardID)
+
+	_, _, shardMap, err := e.getEvacuationShards(sidList, prm.handler != nil)
+	if err != nil {
+		return "", err
+	}
+
+	e.evacuation.mtx.Lock()
+	defer e.evacuation.mtx.Unlock()
+
+	if j := e.evacuation.job; j != nil && j.getStatus().State == EvacuationStateRunning {
+		return "", errEvacuationInProgress
+	}
+
+	var total uint64
+	for _, sh := range shardMap {
+		cnt, err := sh.ObjectCounters()
+		if err == nil {
+			total += cnt.Phy()
+		}
+	}
+
+	job := e.newEvacuationJob(uuid.New().String(), sidList, prm.ignoreErrors)
+	job.status.Total = total
+
+	e.evacuation.job = job
+	e.runEvacuation(job, prm)
+
+	return job.status.ID, nil
+}
+
+// ResumeEvacuation continues the evacuation interrupted by the previous engine shutdown.
+// Only handlers are used from prm, shard list and flags are restored from the saved state.
+// Evacuated shards are moved to read-only mode if needed. Does nothing if there is
+// no interrupted evacuation.
+func (e *StorageEngine) ResumeEvacuation(prm EvacuateShardPrm) error {
+	e.evacuation.mtx.Lock()
+	defer e.evacuation.mtx.Unlock()
+
+	job := e.evacuation.job
+	if job == nil || job.getStatus().State != EvacuationStateInterrupted {
+		return nil
+	}
+
+	// Shard mode is not persisted, so after the restart
+	// shards can be in read-write mode again.
+	for _, sid := range job.status.ShardIDs {
+		e.mtx.RLock()
+		sh, ok := e.shards[sid]
+		e.mtx.RUnlock()
+
+		if ok && sh.GetMode() == mode.ReadWrite {
+			if err := sh.SetMode(mode.ReadOnly); err != nil {
+				err = fmt.Errorf("could not set shard %s to read-only mode: %w", sid, err)
+				job.finish(err)
+				return err
+			}
+		}
+	}
+
+	_, _, _, err := e.getEvacuationShards(job.status.ShardIDs, prm.handler != nil)
+	if err != nil {
+		job.finish(err)
+		return err
+	}
+
+	e.log.Info("resuming shards evacuation", zap.String("id", job.status.ID))
+
+	e.runEvacuation(job, prm)
+	return nil
+}
+
+// EvacuationStatus returns the status of the evacuation with the given identifier.
+// Empty id means the current (or the last) evacuation.
+func (e *StorageEngine) EvacuationStatus(id string) (EvacuationStatus, error) {
+	job, err := e.getEvacuationJob(id)
+	if err != nil {
+		return EvacuationStatus{}, err
+	}
+	return job.getStatus(), nil
+}
+
+// CancelEvacuation stops the evacuation with the given identifier.
+// Empty id means the current evacuation. Already evacuated objects are not moved back.
+func (e *StorageEngine) CancelEvacuation(id string) error {
+	job, err := e.getEvacuationJob(id)
+	if err != nil {
+		return err
+	}
+
+	job.mtx.Lock()
+	defer job.mtx.Unlock()
+
+	if job.status.State != EvacuationStateRunning {
+		return errEvacuationNotRunning
+	}
+
+	job.cancel()
+	return nil
+}
+
+func (e *StorageEngine) getEvacuationJob(id string) (*evacuationJob, error) {
+	e.evacuation.mtx.Lock()
+	job := e.evacuation.job
+	e.evacuation.mtx.Unlock()
+
+	if job == nil || id != "" && job.status.ID != id {
+		return nil, errEvacuationNotFound
+	}
+	return job, nil
+}
+
+func (e *StorageEngine) newEvacuationJob(id string, sidList []string, ignoreErrors bool) *evacuationJob {
+	return &evacuationJob{
+		status: EvacuationStatus{
+			ID:        id,
+			State:     EvacuationStateRunning,
+			ShardIDs:  sidList,
+			StartedAt: time.Now(),
+		},
+		ignoreErrors: ignoreErrors,
+		cursors:      make(map[string][]byte),
+		done:         make(map[string]struct{}),
+		statePath:    e.evacuationStatePath,
+		log:          e.log,
+	}
+}
+
+func (e *StorageEngine) runEvacuation(job *evacuationJob, prm EvacuateShardPrm) {
+	prm.ignoreErrors = job.ignoreErrors
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	job.mtx.Lock()
+	job.status.State = EvacuationStateRunning
+	job.status.Error = nil
+	job.runStartedAt = time.Now()
+	job.runProcessed = job.status.Evacuated + job.status.Failed
+	job.cancel = cancel
+	job.interrupted = false
+	job.persist()
+	job.mtx.Unlock()
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer cancel()
+
+		stop := make(chan struct{})
+		go func() {
+			select {
+			case <-e.closeCh:
+				job.setInterrupted()
+				cancel()
+			case <-stop:
+			}
+		}()
+
+		_, err := e.evacuate(ctx, job.status.ShardIDs, prm, job)
+		close(stop)
+
+		select {
+		case <-e.closeCh:
+			// Shards could be closed before the context is cancelled.
+			job.setInterrupted()
+		default:
+		}
+
+		st := job.finish(err)
+		e.log.Info("shards evacuation finished",
+			zap.String("id", job.status.ID),
+			zap.Stringer("state", st),
+			zap.Error(err))
+	}()
+}
+
+// loadEvacuation restores the evacuation interrupted by the previous shutdown.
+func (e *StorageEngine) loadEvacuation() {
+	if e.evacuationStatePath == "" {
+		return
+	}
+
+	data, err := os.ReadFile(e.evacuationStatePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			e.log.Error("could not read shard evacuation state",
+				zap.String("path", e.evacuationStatePath),
+				zap.Error(err))
+		}
+		return
+	}
+
+	var st evacuationJobState
+	if err := json.Unmarshal(data, &st); err != nil {
+		e.log.Error("could not decode shard evacuation state",
+			zap.String("path", e.evacuationStatePath),
+			zap.Error(err))
+		return
+	}
+
+	job := e.newEvacuationJob(st.ID, st.ShardIDs, st.IgnoreErrors)
+	job.status.State = EvacuationStateInterrupted
+	job.status.StartedAt = st.StartedAt
+	job.status.Total = st.Total
+	job.status.Evacuated = st.Evacuated
+	job.status.Failed = st.Failed
+	job.status.TreesEvacuated = st.Trees
+	for _, sid := range st.Done {
+		job.done[sid] = struct{}{}
+	}
+	for sid, c := range st.Cursors {
+		job.cursors[sid] = c
+	}
+
+	e.evacuation.mtx.Lock()
+	e.evacuation.job = job
+	e.evacuation.mtx.Unlock()
+
+	e.log.Info("found interrupted shards evacuation",
+		zap.String("id", st.ID),
+		zap.Strings("shard_ids", st.ShardIDs))
+}
@@ -1,20 +1,25 @@
 package engine
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -46,6 +51,10 @@ func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEng
 				meta.WithPath(filepath.Join(dir, fmt.Sprintf("%d.metabase", i))),
 				meta.WithPermissions(0700),
 				meta.WithEpochState(epochState{}),
+			),
+			shard.WithPiloramaOptions(
+				pilorama.WithPath(filepath.Join(dir, fmt.Sprintf("%d.pilorama", i))),
+				pilorama.WithPerm(0700),
 			))
 		require.NoError(t, err)
 	}
@@ -60,7 +69,7 @@ func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEng
 
 		var putPrm shard.PutPrm
 		putPrm.SetObject(obj)
-		_, err := e.shards[sh.String()].Put(putPrm)
+		_, err := e.shards[sh.String()].Put(context.Background(), putPrm)
 		require.NoError(t, err)
 	}
 
@@ -70,7 +79,7 @@ func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEng
 		var putPrm PutPrm
 		putPrm.WithObject(objects[len(objects)-1])
 
-		_, err := e.Put(putPrm)
+		_, err := e.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		res, err := e.shards[ids[len(ids)-1].String()].List()
@@ -94,7 +103,7 @@ func TestEvacuateShard(t *testing.T) {
 			var prm GetPrm
 			prm.WithAddress(objectCore.AddressOf(objects[i]))
 
-			_, err := e.Get(prm)
+			_, err := e.Get(context.Background(), prm)
 			require.NoError(t, err)
 		}
 	}
@@ -105,14 +114,14 @@ func TestEvacuateShard(t *testing.T) {
 	prm.WithShardIDList(ids[2:3])
 
 	t.Run("must be read-only", func(t *testing.T) {
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
 		require.Equal(t, 0, res.Count())
 	})
 
 	require.NoError(t, e.shards[evacuateShardID].SetMode(mode.ReadOnly))
 
-	res, err := e.Evacuate(prm)
+	res, err := e.Evacuate(context.Background(), prm)
 	require.NoError(t, err)
 	require.Equal(t, objPerShard, res.count)
 
@@ -123,7 +132,7 @@ func TestEvacuateShard(t *testing.T) {
 	checkHasObjects(t)
 
 	// Calling it again is OK, but all objects are already moved, so no new PUTs should be done.
-	res, err = e.Evacuate(prm)
+	res, err = e.Evacuate(context.Background(), prm)
 	require.NoError(t, err)
 	require.Equal(t, 0, res.count)
 
@@ -168,13 +177,13 @@ func TestEvacuateNetwork(t *testing.T) {
 		var prm EvacuateShardPrm
 		prm.shardID = ids[0:1]
 
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errMustHaveTwoShards)
 		require.Equal(t, 0, res.Count())
 
 		prm.handler = acceptOneOf(objects, 2)
 
-		res, err = e.Evacuate(prm)
+		res, err = e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errReplication)
 		require.Equal(t, 2, res.Count())
 	})
@@ -188,14 +197,14 @@ func TestEvacuateNetwork(t *testing.T) {
 		prm.shardID = ids[1:2]
 		prm.handler = acceptOneOf(objects, 2)
 
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errReplication)
 		require.Equal(t, 2, res.Count())
 
 		t.Run("no errors", func(t *testing.T) {
 			prm.handler = acceptOneOf(objects, 3)
 
-			res, err := e.Evacuate(prm)
+			res, err := e.Evacuate(context.Background(), prm)
 			require.NoError(t, err)
 			require.Equal(t, 3, res.Count())
 		})
@@ -220,16 +229,276 @@ func TestEvacuateNetwork(t *testing.T) {
 		prm.shardID = evacuateIDs
 		prm.handler = acceptOneOf(objects, totalCount-1)
 
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errReplication)
 		require.Equal(t, totalCount-1, res.Count())
 
 		t.Run("no errors", func(t *testing.T) {
 			prm.handler = acceptOneOf(objects, totalCount)
 
-			res, err := e.Evacuate(prm)
+			res, err := e.Evacuate(context.Background(), prm)
 			require.NoError(t, err)
 			require.Equal(t, totalCount, res.Count())
 		})
 	})
 }
+
+func waitEvacuation(t *testing.T, e *StorageEngine, id string) EvacuationStatus {
+	require.Eventually(t, func() bool {
+		st, err := e.EvacuationStatus(id)
+		return err == nil && st.State != EvacuationStateRunning
+	}, 5*time.Second, 10*time.Millisecond)
+
+	st, err := e.EvacuationStatus(id)
+	require.NoError(t, err)
+	return st
+}
+
+func TestEvacuateShardAsync(t *testing.T) {
+	const objPerShard = 3
+
+	e, ids, _ := newEngineEvacuate(t, 3, objPerShard)
+	t.Cleanup(func() { _ = e.Close() })
+
+	_, err := e.EvacuationStatus("")
+	require.ErrorIs(t, err, errEvacuationNotFound)
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids[2:3])
+
+	_, err = e.StartEvacuation(prm)
+	require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
+
+	require.NoError(t, e.shards[ids[2].String()].SetMode(mode.ReadOnly))
+
+	id, err := e.StartEvacuation(prm)
+	require.NoError(t, err)
+
+	st := waitEvacuation(t, e, id)
+	require.Equal(t, EvacuationStateCompleted, st.State)
+	require.NoError(t, st.Error)
+	require.Equal(t, []string{ids[2].String()}, st.ShardIDs)
+	require.Equal(t, uint64(objPerShard), st.Total)
+	require.Equal(t, uint64(objPerShard), st.Evacuated)
+	require.Equal(t, uint64(0), st.Failed)
+	require.False(t, st.FinishedAt.IsZero())
+
+	_, err = e.EvacuationStatus("unknown")
+	require.ErrorIs(t, err, errEvacuationNotFound)
+	require.ErrorIs(t, e.CancelEvacuation(id), errEvacuationNotRunning)
+}
+
+func TestEvacuateShardAsyncCancel(t *testing.T) {
+	e, ids, _ := newEngineEvacuate(t, 1, 3)
+	t.Cleanup(func() { _ = e.Close() })
+
+	require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+	called := make(chan struct{}, 3)
+	release := make(chan struct{})
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids)
+	prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error {
+		called <- struct{}{}
+		<-release
+		return nil
+	})
+
+	id, err := e.StartEvacuation(prm)
+	require.NoError(t, err)
+
+	<-called
+	_, err = e.StartEvacuation(prm)
+	require.ErrorIs(t, err, errEvacuationInProgress)
+
+	require.NoError(t, e.CancelEvacuation(id))
+	close(release)
+
+	st := waitEvacuation(t, e, id)
+	require.Equal(t, EvacuationStateCancelled, st.State)
+	require.Equal(t, uint64(1), st.Evacuated)
+}
+
+func TestEvacuateShardInterruptedBatch(t *testing.T) {
+	const objPerShard = 3
+
+	e, ids, _ := newEngineEvacuate(t, 1, objPerShard)
+	t.Cleanup(func() { _ = e.Close() })
+
+	sid := ids[0].String()
+	require.NoError(t, e.shards[sid].SetMode(mode.ReadOnly))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handled := make(map[oid.Address]int)
+
+	var prm EvacuateShardPrm
+	prm.WithFaultHandler(func(addr oid.Address, _ *objectSDK.Object) error {
+		handled[addr]++
+		if len(handled) == 2 {
+			// interrupt the evacuation in the middle of the batch
+			cancel()
+		}
+		return nil
+	})
+
+	job := e.newEvacuationJob("job", []string{sid}, false)
+
+	_, err := e.evacuate(ctx, []string{sid}, prm, job)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, uint64(2), job.getStatus().Evacuated)
+
+	_, err = e.evacuate(context.Background(), []string{sid}, prm, job)
+	require.NoError(t, err)
+	require.Equal(t, uint64(objPerShard), job.getStatus().Evacuated)
+
+	require.Len(t, handled, objPerShard)
+	for addr, n := range handled {
+		require.Equal(t, 1, n, "object %s is evacuated more than once", addr)
+	}
+}
+
+func TestEvacuateShardAsyncResume(t *testing.T) {
+	const objPerShard = 5
+
+	e, ids, _ := newEngineEvacuate(t, 2, objPerShard)
+	t.Cleanup(func() { _ = e.Close() })
+
+	e.evacuationStatePath = filepath.Join(t.TempDir(), "evacuation")
+
+	sid := ids[1].String()
+	sh := e.shards[sid]
+
+	// Emulate the evacuation interrupted after the first object.
+	var listPrm shard.ListWithCursorPrm
+	listPrm.WithCount(1)
+
+	listRes, err := sh.ListWithCursor(listPrm)
+	require.NoError(t, err)
+	skipped := listRes.AddressList()[0].Address
+
+	c, err := listRes.Cursor().MarshalBinary()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(evacuationJobState{
+		ID:        "job",
+		ShardIDs:  []string{sid},
+		StartedAt: time.Now(),
+		Total:     objPerShard,
+		Evacuated: 1,
+		Cursors:   map[string][]byte{sid: c},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(e.evacuationStatePath, data, 0600))
+
+	e.loadEvacuation()
+
+	st, err := e.EvacuationStatus("job")
+	require.NoError(t, err)
+	require.Equal(t, EvacuationStateInterrupted, st.State)
+
+	require.NoError(t, e.ResumeEvacuation(EvacuateShardPrm{}))
+	require.True(t, sh.GetMode().ReadOnly())
+
+	st = waitEvacuation(t, e, "job")
+	require.Equal(t, EvacuationStateCompleted, st.State)
+	require.Equal(t, uint64(objPerShard), st.Evacuated)
+
+	res, err := sh.List()
+	require.NoError(t, err)
+	require.Len(t, res.AddressList(), objPerShard)
+
+	var existsPrm shard.ExistsPrm
+	existsPrm.SetAddress(skipped)
+
+	exRes, err := e.shards[ids[0].String()].Exists(existsPrm)
+	require.NoError(t, err)
+	require.False(t, exRes.Exists())
+
+	_, err = os.Stat(e.evacuationStatePath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestEvacuateTrees(t *testing.T) {
+	addTrees := func(t *testing.T, sh *shard.Shard) []pilorama.ContainerIDTreeID {
+		var trees []pilorama.ContainerIDTreeID
+		for i := 0; i < 3; i++ {
+			tree := pilorama.ContainerIDTreeID{CID: cidtest.ID(), TreeID: "version"}
+			d := pilorama.CIDDescriptor{CID: tree.CID, Position: 0, Size: 1}
+
+			for j := 0; j < 3; j++ {
+				_, err := sh.TreeAddByPath(d, tree.TreeID, pilorama.AttributeFilename,
+					[]string{"path", strconv.Itoa(j)}, []pilorama.KeyValue{{Key: "Key", Value: []byte("value")}})
+				require.NoError(t, err)
+			}
+			trees = append(trees, tree)
+		}
+		return trees
+	}
+
+	t.Run("to another shard", func(t *testing.T) {
+		e, ids, _ := newEngineEvacuate(t, 2, 3)
+
+		src := e.shards[ids[0].String()].Shard
+		dst := e.shards[ids[1].String()].Shard
+		trees := addTrees(t, src)
+
+		require.NoError(t, src.SetMode(mode.ReadOnly))
+
+		var prm EvacuateShardPrm
+		prm.WithShardIDList(ids[0:1])
+
+		res, err := e.Evacuate(context.Background(), prm)
+		require.NoError(t, err)
+		require.Equal(t, len(trees), res.TreeCount())
+
+		for _, tree := range trees {
+			var height uint64
+			for {
+				expected, err := src.TreeGetOpLog(tree.CID, tree.TreeID, height)
+				require.NoError(t, err)
+
+				actual, err := dst.TreeGetOpLog(tree.CID, tree.TreeID, height)
+				require.NoError(t, err)
+				require.Equal(t, expected, actual)
+
+				if expected.Time == 0 {
+					break
+				}
+				height = expected.Time + 1
+			}
+		}
+	})
+	t.Run("to remote node", func(t *testing.T) {
+		e, ids, _ := newEngineEvacuate(t, 1, 3)
+
+		src := e.shards[ids[0].String()].Shard
+		trees := addTrees(t, src)
+
+		require.NoError(t, src.SetMode(mode.ReadOnly))
+
+		var prm EvacuateShardPrm
+		prm.WithShardIDList(ids)
+		prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error { return nil })
+
+		_, err := e.Evacuate(context.Background(), prm)
+		require.ErrorIs(t, err, errPutShard)
+
+		var handled []pilorama.ContainerIDTreeID
+		prm.WithTreeHandler(func(_ context.Context, cnr cidSDK.ID, treeID string, f pilorama.Forest) error {
+			lm, err := f.TreeGetOpLog(cnr, treeID, 0)
+			require.NoError(t, err)
+			require.NotZero(t, lm.Time)
+
+			handled = append(handled, pilorama.ContainerIDTreeID{CID: cnr, TreeID: treeID})
+			return nil
+		})
+
+		res, err := e.Evacuate(context.Background(), prm)
+		require.NoError(t, err)
+		require.Equal(t, len(trees), res.TreeCount())
+		require.ElementsMatch(t, trees, handled)
+	})
+}
@@ -1,14 +1,18 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // HeadPrm groups the parameters of Head operation.
@@ -52,16 +56,23 @@ func (r HeadRes) Header() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object was inhumed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Head(prm HeadPrm) (res HeadRes, err error) {
+func (e *StorageEngine) Head(ctx context.Context, prm HeadPrm) (res HeadRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Head",
+		trace.WithAttributes(
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("raw", prm.raw),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.head(prm)
+		res, err = e.head(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
+func (e *StorageEngine) head(ctx context.Context, prm HeadPrm) (HeadRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddHeadDuration)()
 	}
@@ -81,7 +92,7 @@ func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
 	shPrm.SetRaw(prm.raw)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
-		res, err := sh.Head(shPrm)
+		res, err := sh.Head(ctx, shPrm)
 		if err != nil {
 			switch {
 			case shard.IsErrNotFound(err):
@@ -139,11 +150,11 @@ func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
 }
 
 // Head reads object header from local storage by provided address.
-func Head(storage *StorageEngine, addr oid.Address) (*objectSDK.Object, error) {
+func Head(ctx context.Context, storage *StorageEngine, addr oid.Address) (*objectSDK.Object, error) {
 	var headPrm HeadPrm
 	headPrm.WithAddress(addr)
 
-	res, err := storage.Head(headPrm)
+	res, err := storage.Head(ctx, headPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -153,12 +164,12 @@ func Head(storage *StorageEngine, addr oid.Address) (*objectSDK.Object, error) {
 
 // HeadRaw reads object header from local storage by provided address and raw
 // flag.
-func HeadRaw(storage *StorageEngine, addr oid.Address, raw bool) (*objectSDK.Object, error) {
+func HeadRaw(ctx context.Context, storage *StorageEngine, addr oid.Address, raw bool) (*objectSDK.Object, error) {
 	var headPrm HeadPrm
 	headPrm.WithAddress(addr)
 	headPrm.WithRaw(raw)
 
-	res, err := storage.Head(headPrm)
+	res, err := storage.Head(ctx, headPrm)
 	if err != nil {
 		return nil, err
 	}
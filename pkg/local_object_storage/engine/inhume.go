@@ -74,6 +74,9 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 		defer elapsed(e.metrics.AddInhumeDuration)()
 	}
 
+	e.rebalance.statusMtx.RLock()
+	defer e.rebalance.statusMtx.RUnlock()
+
 	var shPrm shard.InhumePrm
 	if prm.forceRemoval {
 		shPrm.ForceRemoval()
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -40,7 +41,7 @@ func TestStorageEngine_Inhume(t *testing.T) {
 		e := testNewEngineWithShardNum(t, 1)
 		defer e.Close()
 
-		err := Put(e, parent)
+		err := Put(context.Background(), e, parent)
 		require.NoError(t, err)
 
 		var inhumePrm InhumePrm
@@ -63,12 +64,12 @@ func TestStorageEngine_Inhume(t *testing.T) {
 
 		var putChild shard.PutPrm
 		putChild.SetObject(child)
-		_, err := s1.Put(putChild)
+		_, err := s1.Put(context.Background(), putChild)
 		require.NoError(t, err)
 
 		var putLink shard.PutPrm
 		putLink.SetObject(link)
-		_, err = s2.Put(putLink)
+		_, err = s2.Put(context.Background(), putLink)
 		require.NoError(t, err)
 
 		var inhumePrm InhumePrm
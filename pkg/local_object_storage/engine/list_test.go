@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"os"
 	"sort"
@@ -34,7 +35,7 @@ func TestListWithCursor(t *testing.T) {
 		var prm PutPrm
 		prm.WithObject(obj)
 
-		_, err := e.Put(prm)
+		_, err := e.Put(context.Background(), prm)
 		require.NoError(t, err)
 		expected = append(expected, object.AddressWithType{Type: objectSDK.TypeRegular, Address: object.AddressOf(obj)})
 	}
@@ -21,6 +21,9 @@ var errLockFailed = errors.New("lock operation failed")
 // Locked list should be unique. Panics if it is empty.
 func (e *StorageEngine) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
 	return e.execIfNotBlocked(func() error {
+		e.rebalance.statusMtx.RLock()
+		defer e.rebalance.statusMtx.RUnlock()
+
 		return e.lock(idCnr, locker, locked)
 	})
 }
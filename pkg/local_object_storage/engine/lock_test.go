@@ -93,7 +93,7 @@ func TestLockUserScenario(t *testing.T) {
 	id, _ := obj.ID()
 	objAddr.SetObject(id)
 
-	err = Put(e, obj)
+	err = Put(context.Background(), e, obj)
 	require.NoError(t, err)
 
 	// 2.
@@ -101,7 +101,7 @@ func TestLockUserScenario(t *testing.T) {
 	locker.WriteMembers([]oid.ID{id})
 	object.WriteLock(lockerObj, locker)
 
-	err = Put(e, lockerObj)
+	err = Put(context.Background(), e, lockerObj)
 	require.NoError(t, err)
 
 	err = e.Lock(cnr, lockerID, []oid.ID{id})
@@ -119,7 +119,7 @@ func TestLockUserScenario(t *testing.T) {
 	tombObj.SetID(tombForLockID)
 	tombObj.SetAttributes(a)
 
-	err = Put(e, tombObj)
+	err = Put(context.Background(), e, tombObj)
 	require.NoError(t, err)
 
 	inhumePrm.WithTarget(tombForLockAddr, lockerAddr)
@@ -168,7 +168,7 @@ func TestLockExpiration(t *testing.T) {
 	// 1.
 	obj := generateObjectWithCID(t, cnr)
 
-	err = Put(e, obj)
+	err = Put(context.Background(), e, obj)
 	require.NoError(t, err)
 
 	// 2.
@@ -180,7 +180,7 @@ func TestLockExpiration(t *testing.T) {
 	lock.SetType(object.TypeLock)
 	lock.SetAttributes(a)
 
-	err = Put(e, lock)
+	err = Put(context.Background(), e, lock)
 	require.NoError(t, err)
 
 	id, _ := obj.ID()
@@ -239,14 +239,14 @@ func TestLockForceRemoval(t *testing.T) {
 	// 1.
 	obj := generateObjectWithCID(t, cnr)
 
-	err = Put(e, obj)
+	err = Put(context.Background(), e, obj)
 	require.NoError(t, err)
 
 	// 2.
 	lock := generateObjectWithCID(t, cnr)
 	lock.SetType(object.TypeLock)
 
-	err = Put(e, lock)
+	err = Put(context.Background(), e, lock)
 	require.NoError(t, err)
 
 	id, _ := obj.ID()
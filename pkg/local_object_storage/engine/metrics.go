@@ -24,6 +24,14 @@ type MetricRegister interface {
 
 	AddToContainerSize(cnrID string, size int64)
 	AddToPayloadCounter(shardID string, size int64)
+
+	AddShardMethodDuration(shardID, component, method string, success bool, d time.Duration)
+
+	SetWriteCacheObjectCounter(shardID, storage string, v uint64)
+	SetWriteCacheSize(shardID, storage string, v uint64)
+	AddWriteCacheFlush(shardID string, success bool, size uint64)
+
+	AddScrubbedObject(shardID string, corrupted bool)
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
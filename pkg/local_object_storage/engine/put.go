@@ -1,15 +1,19 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -38,16 +42,22 @@ func (p *PutPrm) WithObject(obj *objectSDK.Object) {
 // Returns an error if executions are blocked (see BlockExecution).
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
-func (e *StorageEngine) Put(prm PutPrm) (res PutRes, err error) {
+func (e *StorageEngine) Put(ctx context.Context, prm PutPrm) (res PutRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Put",
+		trace.WithAttributes(
+			attribute.String("address", object.AddressOf(prm.obj).EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.put(prm)
+		res, err = e.put(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
+func (e *StorageEngine) put(ctx context.Context, prm PutPrm) (PutRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddPutDuration)()
 	}
@@ -72,7 +82,7 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 			return false
 		}
 
-		putDone, exists := e.putToShard(sh, ind, pool, addr, prm.obj)
+		putDone, exists := e.putToShard(ctx, sh, ind, pool, addr, prm.obj)
 		finished = putDone || exists
 		return finished
 	})
@@ -87,7 +97,7 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 // putToShard puts object to sh.
 // First return value is true iff put has been successfully done.
 // Second return value is true iff object already exists.
-func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
+func (e *StorageEngine) putToShard(ctx context.Context, sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
 	var putSuccess, alreadyExists bool
 
 	exitCh := make(chan struct{})
@@ -130,7 +140,7 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 		var putPrm shard.PutPrm
 		putPrm.SetObject(obj)
 
-		_, err = sh.Put(putPrm)
+		_, err = sh.Put(ctx, putPrm)
 		if err != nil {
 			if errors.Is(err, shard.ErrReadOnlyMode) || errors.Is(err, blobstor.ErrNoPlaceFound) ||
 				errors.Is(err, common.ErrReadOnly) || errors.Is(err, common.ErrNoSpace) {
@@ -155,11 +165,11 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 }
 
 // Put writes provided object to local storage.
-func Put(storage *StorageEngine, obj *objectSDK.Object) error {
+func Put(ctx context.Context, storage *StorageEngine, obj *objectSDK.Object) error {
 	var putPrm PutPrm
 	putPrm.WithObject(obj)
 
-	_, err := storage.Put(putPrm)
+	_, err := storage.Put(ctx, putPrm)
 
 	return err
 }
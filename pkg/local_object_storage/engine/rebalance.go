@@ -0,0 +1,434 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// RebalanceState represents the state of the shard rebalancing.
+type RebalanceState uint8
+
+const (
+	// RebalanceStateIdle means that rebalancing is not running.
+	RebalanceStateIdle RebalanceState = iota
+	// RebalanceStateRunning means that rebalancing is in progress.
+	RebalanceStateRunning
+	// RebalanceStatePaused means that rebalancing is paused.
+	RebalanceStatePaused
+)
+
+// String implements fmt.Stringer.
+func (s RebalanceState) String() string {
+	switch s {
+	case RebalanceStateIdle:
+		return "idle"
+	case RebalanceStateRunning:
+		return "running"
+	case RebalanceStatePaused:
+		return "paused"
+	default:
+		return "unknown"
+	}
+}
+
+// RebalanceStatus contains the progress of the current
+// (or the last finished) shard rebalancing.
+type RebalanceStatus struct {
+	// State is the current rebalancing state.
+	State RebalanceState
+	// StartedAt is the time when the rebalancing was started.
+	StartedAt time.Time
+	// FinishedAt is the time when the rebalancing was finished.
+	// Zero if the rebalancing is in progress.
+	FinishedAt time.Time
+	// ShardsTotal is the number of shards to process.
+	ShardsTotal int
+	// ShardsDone is the number of processed shards.
+	ShardsDone int
+	// Processed is the number of checked objects.
+	Processed uint64
+	// Moved is the number of objects moved to another shard.
+	Moved uint64
+	// Failed is the number of objects which could not be moved.
+	Failed uint64
+	// Error is the error the last rebalancing was finished with.
+	Error error
+}
+
+var (
+	errRebalanceInProgress = logicerr.New("shard rebalancing is already in progress")
+	errRebalanceNotRunning = logicerr.New("shard rebalancing is not running")
+)
+
+const defaultRebalanceBatchSize = 100
+
+type rebalancer struct {
+	mtx    sync.Mutex
+	status RebalanceStatus
+	// restart is set when rebalancing must be started
+	// again after the current one is finished.
+	restart bool
+	// resumeCh is closed on resume, nil if not paused.
+	resumeCh chan struct{}
+	// statusMtx is held for writing while the moved object is removed from
+	// the source shard and for reading by the operations storing the object
+	// status (removal marks, locks) in the shard metabase.
+	statusMtx sync.RWMutex
+}
+
+// StartRebalance starts moving objects to the shards preferred by HRW
+// in background. Objects are checked at the rate configured by
+// WithRebalanceRateLimit.
+//
+// Returns an error if rebalancing is already in progress.
+func (e *StorageEngine) StartRebalance() error {
+	e.rebalance.mtx.Lock()
+	defer e.rebalance.mtx.Unlock()
+
+	if e.rebalance.status.State != RebalanceStateIdle {
+		return errRebalanceInProgress
+	}
+
+	e.startRebalance()
+	return nil
+}
+
+// startRebalance must be called with e.rebalance.mtx held.
+func (e *StorageEngine) startRebalance() {
+	e.rebalance.status = RebalanceStatus{
+		State:     RebalanceStateRunning,
+		StartedAt: time.Now(),
+	}
+
+	e.wg.Add(1)
+	go e.rebalanceLoop()
+}
+
+// PauseRebalance suspends the shard rebalancing. It can be continued
+// with ResumeRebalance.
+//
+// Returns an error if rebalancing is not running.
+func (e *StorageEngine) PauseRebalance() error {
+	e.rebalance.mtx.Lock()
+	defer e.rebalance.mtx.Unlock()
+
+	switch e.rebalance.status.State {
+	case RebalanceStateIdle:
+		return errRebalanceNotRunning
+	case RebalanceStateRunning:
+		e.rebalance.status.State = RebalanceStatePaused
+		e.rebalance.resumeCh = make(chan struct{})
+		e.log.Info("shard rebalancing is paused")
+	}
+	return nil
+}
+
+// ResumeRebalance continues the shard rebalancing paused with PauseRebalance.
+//
+// Returns an error if rebalancing is not running.
+func (e *StorageEngine) ResumeRebalance() error {
+	e.rebalance.mtx.Lock()
+	defer e.rebalance.mtx.Unlock()
+
+	switch e.rebalance.status.State {
+	case RebalanceStateIdle:
+		return errRebalanceNotRunning
+	case RebalanceStatePaused:
+		e.rebalance.status.State = RebalanceStateRunning
+		close(e.rebalance.resumeCh)
+		e.rebalance.resumeCh = nil
+		e.log.Info("shard rebalancing is resumed")
+	}
+	return nil
+}
+
+// RebalanceStatus returns the progress of the current (or the last) shard rebalancing.
+func (e *StorageEngine) RebalanceStatus() RebalanceStatus {
+	e.rebalance.mtx.Lock()
+	defer e.rebalance.mtx.Unlock()
+
+	return e.rebalance.status
+}
+
+// requestRebalance starts rebalancing or schedules a new one after the
+// current rebalancing is finished.
+func (e *StorageEngine) requestRebalance() {
+	e.rebalance.mtx.Lock()
+	defer e.rebalance.mtx.Unlock()
+
+	if e.rebalance.status.State == RebalanceStateIdle {
+		e.startRebalance()
+	} else {
+		e.rebalance.restart = true
+	}
+}
+
+func (e *StorageEngine) rebalanceLoop() {
+	defer e.wg.Done()
+
+	for {
+		e.log.Info("started shard rebalancing")
+
+		err := e.rebalanceShards()
+
+		e.rebalance.mtx.Lock()
+		st := &e.rebalance.status
+		st.Error = err
+		if err == nil && e.rebalance.restart {
+			e.rebalance.restart = false
+			e.rebalance.status = RebalanceStatus{
+				State:     RebalanceStateRunning,
+				StartedAt: time.Now(),
+			}
+			e.rebalance.mtx.Unlock()
+			continue
+		}
+
+		st.State = RebalanceStateIdle
+		st.FinishedAt = time.Now()
+		e.rebalance.restart = false
+		e.rebalance.resumeCh = nil
+
+		fields := []zap.Field{
+			zap.Uint64("processed", st.Processed),
+			zap.Uint64("moved", st.Moved),
+			zap.Uint64("failed", st.Failed),
+		}
+		e.rebalance.mtx.Unlock()
+
+		if err != nil {
+			e.log.Error("shard rebalancing failed", append(fields, zap.Error(err))...)
+		} else {
+			e.log.Info("finished shard rebalancing", fields...)
+		}
+		return
+	}
+}
+
+func (e *StorageEngine) rebalanceShards() error {
+	shards := e.unsortedShards()
+
+	e.rebalance.mtx.Lock()
+	e.rebalance.status.ShardsTotal = len(shards)
+	e.rebalance.mtx.Unlock()
+
+	var limit <-chan time.Time
+	if e.rebalanceRateLimit != 0 {
+		t := time.NewTicker(time.Second / time.Duration(e.rebalanceRateLimit))
+		defer t.Stop()
+
+		limit = t.C
+	}
+
+	for i := range shards {
+		err := e.rebalanceShard(shards[i], limit)
+		if err != nil {
+			return fmt.Errorf("shard %s: %w", shards[i].ID(), err)
+		}
+
+		e.rebalance.mtx.Lock()
+		e.rebalance.status.ShardsDone++
+		e.rebalance.mtx.Unlock()
+	}
+	return nil
+}
+
+func (e *StorageEngine) rebalanceShard(sh hashedShard, limit <-chan time.Time) error {
+	if sh.GetMode() != mode.ReadWrite {
+		// Objects can't be removed from the shard.
+		e.log.Debug("skip shard rebalancing",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Stringer("mode", sh.GetMode()))
+		return nil
+	}
+
+	var listPrm shard.ListWithCursorPrm
+	listPrm.WithCount(defaultRebalanceBatchSize)
+
+	var c *meta.Cursor
+	for {
+		listPrm.WithCursor(c)
+
+		listRes, err := sh.ListWithCursor(listPrm)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) || errors.Is(err, shard.ErrDegradedMode) {
+				return nil
+			}
+			return err
+		}
+
+		lst := listRes.AddressList()
+		for i := range lst {
+			if err := e.waitRebalance(limit); err != nil {
+				return err
+			}
+
+			if lst[i].Type != objectSDK.TypeRegular {
+				// Tombstones and lockers affect the metabase of the shard they are stored in.
+				continue
+			}
+
+			var moved bool
+			err = e.execIfNotBlocked(func() error {
+				moved, err = e.rebalanceObject(sh, lst[i].Address)
+				return err
+			})
+			if errors.Is(err, errClosed) {
+				return err
+			}
+
+			e.rebalance.mtx.Lock()
+			e.rebalance.status.Processed++
+			if moved {
+				e.rebalance.status.Moved++
+			} else if err != nil {
+				e.rebalance.status.Failed++
+			}
+			e.rebalance.mtx.Unlock()
+
+			if err != nil {
+				e.log.Warn("could not move object to the preferred shard",
+					zap.Stringer("shard_id", sh.ID()),
+					zap.Stringer("addr", lst[i].Address),
+					zap.Error(err))
+			}
+		}
+
+		c = listRes.Cursor()
+	}
+}
+
+// waitRebalance blocks while rebalancing is paused and
+// throttles the rebalancing according to the rate limit.
+func (e *StorageEngine) waitRebalance(limit <-chan time.Time) error {
+	e.rebalance.mtx.Lock()
+	resumeCh := e.rebalance.resumeCh
+	e.rebalance.mtx.Unlock()
+
+	if resumeCh != nil {
+		select {
+		case <-e.closeCh:
+			return errClosed
+		case <-resumeCh:
+		}
+	}
+
+	if limit != nil {
+		select {
+		case <-e.closeCh:
+			return errClosed
+		case <-limit:
+		}
+	}
+	return nil
+}
+
+// rebalanceObject moves the object from src to the first writable shard
+// in HRW order if it precedes src. Returns true iff the object was moved.
+func (e *StorageEngine) rebalanceObject(src hashedShard, addr oid.Address) (bool, error) {
+	var dst hashedShard
+
+	srcID := src.ID().String()
+	for _, sh := range e.sortShardsByWeight(addr) {
+		if sh.ID().String() == srcID {
+			return false, nil
+		}
+		if sh.GetMode() == mode.ReadWrite {
+			dst = sh
+			break
+		}
+	}
+	if dst.Shard == nil {
+		return false, nil
+	}
+
+	locked, err := src.IsLocked(addr)
+	if err != nil {
+		return false, fmt.Errorf("could not check object lock: %w", err)
+	} else if locked {
+		// Lock information is stored in the metabase of the shard.
+		return false, nil
+	}
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(addr)
+
+	getRes, err := src.Get(context.Background(), getPrm)
+	if err != nil {
+		if shard.IsErrRemoved(err) || shard.IsErrObjectExpired(err) || shard.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not get object: %w", err)
+	}
+
+	e.mtx.RLock()
+	pool, ok := e.shardPools[dst.ID().String()]
+	e.mtx.RUnlock()
+	if !ok {
+		// Shard was concurrently removed, skip.
+		return false, nil
+	}
+
+	putDone, exists := e.putToShard(context.Background(), dst, 0, pool, addr, getRes.Object())
+	if !putDone && !exists {
+		return false, fmt.Errorf("%w: %s", errPutShard, dst.ID())
+	}
+
+	// The object could have been removed or locked while it was being copied,
+	// the status is stored in the source shard only. Status changes are
+	// blocked until the source copy is deleted, so that none of them is lost.
+	e.rebalance.statusMtx.Lock()
+	defer e.rebalance.statusMtx.Unlock()
+
+	locked, err = src.IsLocked(addr)
+	if err != nil {
+		return false, fmt.Errorf("could not check object lock: %w", err)
+	} else if locked {
+		var delPrm shard.DeletePrm
+		delPrm.SetAddresses(addr)
+
+		_, err = dst.Delete(delPrm)
+		return false, err
+	}
+
+	var existsPrm shard.ExistsPrm
+	existsPrm.SetAddress(addr)
+
+	_, err = src.Exists(existsPrm)
+	if err != nil {
+		if shard.IsErrRemoved(err) || shard.IsErrObjectExpired(err) {
+			var inhumePrm shard.InhumePrm
+			inhumePrm.MarkAsGarbage(addr)
+
+			_, err = dst.Inhume(inhumePrm)
+			return false, err
+		}
+		return false, fmt.Errorf("could not check object status: %w", err)
+	}
+
+	var delPrm shard.DeletePrm
+	delPrm.SetAddresses(addr)
+
+	_, err = src.Delete(delPrm)
+	if err != nil {
+		return false, fmt.Errorf("could not delete object from the source shard: %w", err)
+	}
+
+	e.log.Debug("object is moved to the preferred shard",
+		zap.String("from", srcID),
+		zap.Stringer("to", dst.ID()),
+		zap.Stringer("addr", addr))
+
+	return true, nil
+}
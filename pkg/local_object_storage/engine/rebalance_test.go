@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func rebalanceShardOpts(t *testing.T, dir string, i int) []shard.Option {
+	return []shard.Option{
+		shard.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{{
+				Storage: fstree.New(
+					fstree.WithPath(filepath.Join(dir, strconv.Itoa(i))),
+					fstree.WithDepth(1)),
+			}})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, fmt.Sprintf("%d.metabase", i))),
+			meta.WithPermissions(0700),
+			meta.WithEpochState(epochState{}),
+		),
+	}
+}
+
+func newEngineRebalance(t *testing.T, objCount int, opts ...Option) (*StorageEngine, *shard.ID, []*objectSDK.Object) {
+	dir := t.TempDir()
+
+	e := New(append([]Option{
+		WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		WithShardPoolSize(1),
+		WithRebalanceRateLimit(0),
+	}, opts...)...)
+	t.Cleanup(func() { _ = e.Close() })
+
+	id, err := e.AddShard(rebalanceShardOpts(t, dir, 0)...)
+	require.NoError(t, err)
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+
+	objects := make([]*objectSDK.Object, objCount)
+	for i := range objects {
+		objects[i] = generateObjectWithCID(t, cidtest.ID())
+		require.NoError(t, Put(context.Background(), e, objects[i]))
+	}
+
+	// Add a shard to the working engine, like Reload does.
+	_, err = e.AddShard(rebalanceShardOpts(t, dir, 1)...)
+	require.NoError(t, err)
+
+	return e, id, objects
+}
+
+func waitRebalance(t *testing.T, e *StorageEngine) RebalanceStatus {
+	require.Eventually(t, func() bool {
+		return e.RebalanceStatus().State == RebalanceStateIdle
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return e.RebalanceStatus()
+}
+
+func TestRebalance(t *testing.T) {
+	const objCount = 20
+
+	e, id, objects := newEngineRebalance(t, objCount)
+
+	expectedMoved := 0
+	for i := range objects {
+		addr := objectCore.AddressOf(objects[i])
+		if e.sortShardsByWeight(addr)[0].ID().String() != id.String() {
+			expectedMoved++
+		}
+	}
+	require.NotZero(t, expectedMoved, "test is useless when all objects stay in place")
+
+	require.NoError(t, e.StartRebalance())
+
+	st := waitRebalance(t, e)
+	require.NoError(t, st.Error)
+	// Moved objects are checked again if the target shard is processed later.
+	require.GreaterOrEqual(t, st.Processed, uint64(objCount))
+	require.Equal(t, uint64(expectedMoved), st.Moved)
+	require.Equal(t, uint64(0), st.Failed)
+	require.Equal(t, 2, st.ShardsTotal)
+	require.Equal(t, 2, st.ShardsDone)
+	require.False(t, st.FinishedAt.IsZero())
+
+	for i := range objects {
+		addr := objectCore.AddressOf(objects[i])
+
+		var existsPrm shard.ExistsPrm
+		existsPrm.SetAddress(addr)
+
+		for j, sh := range e.sortShardsByWeight(addr) {
+			res, err := sh.Exists(existsPrm)
+			require.NoError(t, err)
+			require.Equal(t, j == 0, res.Exists())
+		}
+
+		var getPrm GetPrm
+		getPrm.WithAddress(addr)
+
+		_, err := e.Get(context.Background(), getPrm)
+		require.NoError(t, err)
+	}
+
+	t.Run("nothing to move", func(t *testing.T) {
+		require.NoError(t, e.StartRebalance())
+
+		st := waitRebalance(t, e)
+		require.NoError(t, st.Error)
+		require.Equal(t, uint64(objCount), st.Processed)
+		require.Equal(t, uint64(0), st.Moved)
+	})
+}
+
+func TestRebalanceOnShardAdd(t *testing.T) {
+	const objCount = 20
+
+	e, _, _ := newEngineRebalance(t, objCount, WithRebalanceOnShardAdd(true))
+
+	st := waitRebalance(t, e)
+	require.NoError(t, st.Error)
+	require.GreaterOrEqual(t, st.Processed, uint64(objCount))
+	require.NotZero(t, st.Moved)
+}
+
+func TestRebalancePause(t *testing.T) {
+	e, _, objects := newEngineRebalance(t, 10, WithRebalanceRateLimit(100))
+
+	require.ErrorIs(t, e.PauseRebalance(), errRebalanceNotRunning)
+	require.ErrorIs(t, e.ResumeRebalance(), errRebalanceNotRunning)
+
+	require.NoError(t, e.StartRebalance())
+	require.ErrorIs(t, e.StartRebalance(), errRebalanceInProgress)
+
+	require.NoError(t, e.PauseRebalance())
+	require.NoError(t, e.PauseRebalance())
+	require.Equal(t, RebalanceStatePaused, e.RebalanceStatus().State)
+
+	processed := e.RebalanceStatus().Processed
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, RebalanceStatePaused, e.RebalanceStatus().State)
+	require.LessOrEqual(t, e.RebalanceStatus().Processed, processed+1)
+
+	require.NoError(t, e.ResumeRebalance())
+	require.NoError(t, e.ResumeRebalance())
+
+	st := waitRebalance(t, e)
+	require.NoError(t, st.Error)
+	require.GreaterOrEqual(t, st.Processed, uint64(len(objects)))
+}
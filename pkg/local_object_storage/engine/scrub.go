@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+)
+
+// ShardScrubStatus groups the scrubbing status of a single shard.
+type ShardScrubStatus struct {
+	ID *shard.ID
+	shard.ScrubStatus
+}
+
+// StartScrub starts the background scrubbing of the specified shards.
+// If no shards are specified, all shards are scrubbed.
+func (e *StorageEngine) StartScrub(ids []*shard.ID) error {
+	shards, err := e.scrubShards(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, sh := range shards {
+		if err := sh.StartScrub(); err != nil {
+			return fmt.Errorf("shard %s: %w", sh.ID(), err)
+		}
+	}
+	return nil
+}
+
+// ScrubStatus returns the scrubbing status of the specified shards.
+// If no shards are specified, the status of all shards is returned.
+func (e *StorageEngine) ScrubStatus(ids []*shard.ID) ([]ShardScrubStatus, error) {
+	shards, err := e.scrubShards(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ShardScrubStatus, 0, len(shards))
+	for _, sh := range shards {
+		res = append(res, ShardScrubStatus{
+			ID:          sh.ID(),
+			ScrubStatus: sh.ScrubStatus(),
+		})
+	}
+	return res, nil
+}
+
+func (e *StorageEngine) scrubShards(ids []*shard.ID) ([]*shard.Shard, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	if len(ids) == 0 {
+		shards := make([]*shard.Shard, 0, len(e.shards))
+		for _, sh := range e.shards {
+			shards = append(shards, sh.Shard)
+		}
+		return shards, nil
+	}
+
+	shards := make([]*shard.Shard, 0, len(ids))
+	for _, id := range ids {
+		sh, ok := e.shards[id.String()]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errShardNotFound, id)
+		}
+		shards = append(shards, sh.Shard)
+	}
+	return shards, nil
+}
@@ -1,6 +1,9 @@
 package engine
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -11,11 +14,16 @@ import (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	limit  int
+	cursor *oid.ID
+	attrs  []string
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	attrList [][]*string
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -28,11 +36,38 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// WithLimit is a Select option to limit the number of the selected objects.
+// Zero limit means no limit.
+func (p *SelectPrm) WithLimit(n int) {
+	p.limit = n
+}
+
+// WithCursor is a Select option to select only the objects
+// with identifiers greater than the cursor.
+func (p *SelectPrm) WithCursor(id oid.ID) {
+	p.cursor = &id
+}
+
+// WithAttributes is a Select option to return values
+// of the specified attributes of the selected objects.
+func (p *SelectPrm) WithAttributes(keys ...string) {
+	p.attrs = keys
+}
+
 // AddressList returns list of addresses of the selected objects.
+//
+// Select results are sorted by object identifiers.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// AttributeList returns values of the requested attributes of the selected objects.
+// The i-th list corresponds to the i-th address and contains values in
+// the order of the requested keys, values of missing attributes are nil.
+func (r SelectRes) AttributeList() [][]*string {
+	return r.attrList
+}
+
 // Select selects the objects from local storage that match select parameters.
 //
 // Returns any error encountered that did not allow to completely select the objects.
@@ -52,7 +87,7 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 		defer elapsed(e.metrics.AddSearchDuration)()
 	}
 
-	addrList := make([]oid.Address, 0)
+	var res SelectRes
 	uniqueMap := make(map[string]struct{})
 
 	var outError error
@@ -60,27 +95,68 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 	var shPrm shard.SelectPrm
 	shPrm.SetContainerID(prm.cnr)
 	shPrm.SetFilters(prm.filters)
+	shPrm.SetLimit(prm.limit)
+	shPrm.SetAttributes(prm.attrs...)
+	if prm.cursor != nil {
+		shPrm.SetCursor(*prm.cursor)
+	}
 
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
-		res, err := sh.Select(shPrm)
+		shRes, err := sh.Select(shPrm)
 		if err != nil {
 			e.reportShardError(sh, "could not select objects from shard", err)
 			return false
 		}
 
-		for _, addr := range res.AddressList() { // save only unique values
+		attrList := shRes.AttributeList()
+		for i, addr := range shRes.AddressList() { // save only unique values
 			if _, ok := uniqueMap[addr.EncodeToString()]; !ok {
 				uniqueMap[addr.EncodeToString()] = struct{}{}
-				addrList = append(addrList, addr)
+				res.addrList = append(res.addrList, addr)
+				if attrList != nil {
+					res.attrList = append(res.attrList, attrList[i])
+				}
 			}
 		}
 
 		return false
 	})
 
-	return SelectRes{
-		addrList: addrList,
-	}, outError
+	// every shard returns sorted results, but they must be merged
+	// to be limited correctly
+	sort.Sort(selectResSorter{&res})
+	if prm.limit > 0 && len(res.addrList) > prm.limit {
+		res.addrList = res.addrList[:prm.limit]
+		if res.attrList != nil {
+			res.attrList = res.attrList[:prm.limit]
+		}
+	}
+	if res.addrList == nil {
+		res.addrList = make([]oid.Address, 0)
+	}
+
+	return res, outError
+}
+
+// selectResSorter sorts Select results by object identifiers.
+type selectResSorter struct {
+	*SelectRes
+}
+
+func (s selectResSorter) Len() int {
+	return len(s.addrList)
+}
+
+func (s selectResSorter) Less(i, j int) bool {
+	a, b := s.addrList[i].Object(), s.addrList[j].Object()
+	return bytes.Compare(a[:], b[:]) < 0
+}
+
+func (s selectResSorter) Swap(i, j int) {
+	s.addrList[i], s.addrList[j] = s.addrList[j], s.addrList[i]
+	if s.attrList != nil {
+		s.attrList[i], s.attrList[j] = s.attrList[j], s.attrList[i]
+	}
 }
 
 // List returns `limit` available physically storage object addresses in engine.
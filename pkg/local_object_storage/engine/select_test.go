@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectLimitCursor(t *testing.T) {
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+	e := testNewEngineWithShards(s1, s2)
+
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	const total = 20
+
+	cnr := cidtest.ID()
+	for i := 0; i < total; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		id, _ := obj.ID()
+		addAttribute(obj, "id", id.EncodeToString())
+
+		var prm PutPrm
+		prm.WithObject(obj)
+
+		_, err := e.Put(context.Background(), prm)
+		require.NoError(t, err)
+	}
+
+	var prm SelectPrm
+	prm.WithContainerID(cnr)
+	prm.WithAttributes("id")
+
+	res, err := e.Select(prm)
+	require.NoError(t, err)
+
+	expected := res.AddressList()
+	require.Len(t, expected, total)
+	for i := range expected {
+		require.Equal(t, []string{expected[i].Object().EncodeToString()}, attributeValues(res.AttributeList()[i]))
+	}
+
+	var got []oid.Address
+
+	prm.WithLimit(3)
+	for {
+		res, err := e.Select(prm)
+		require.NoError(t, err)
+
+		page := res.AddressList()
+		require.LessOrEqual(t, len(page), 3)
+		if len(page) == 0 {
+			break
+		}
+
+		for i := range page {
+			require.Equal(t, []string{page[i].Object().EncodeToString()}, attributeValues(res.AttributeList()[i]))
+		}
+
+		got = append(got, page...)
+		prm.WithCursor(page[len(page)-1].Object())
+	}
+
+	require.Equal(t, expected, got)
+}
+
+// attributeValues dereferences the attribute values, missing ones are empty.
+func attributeValues(vals []*string) []string {
+	res := make([]string, len(vals))
+	for i := range vals {
+		if vals[i] != nil {
+			res[i] = *vals[i]
+		}
+	}
+	return res
+}
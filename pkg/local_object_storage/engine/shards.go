@@ -2,6 +2,7 @@ package engine
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
@@ -57,8 +58,32 @@ func (m *metricsWithID) AddToPayloadSize(size int64) {
 	m.mw.AddToPayloadCounter(m.id, size)
 }
 
+func (m *metricsWithID) AddMethodDuration(component, method string, success bool, d time.Duration) {
+	m.mw.AddShardMethodDuration(m.id, component, method, success, d)
+}
+
+func (m *metricsWithID) SetWriteCacheObjectCounter(storage string, v uint64) {
+	m.mw.SetWriteCacheObjectCounter(m.id, storage, v)
+}
+
+func (m *metricsWithID) SetWriteCacheSize(storage string, v uint64) {
+	m.mw.SetWriteCacheSize(m.id, storage, v)
+}
+
+func (m *metricsWithID) AddWriteCacheFlush(success bool, size uint64) {
+	m.mw.AddWriteCacheFlush(m.id, success, size)
+}
+
+func (m *metricsWithID) AddScrubbedObject(corrupted bool) {
+	m.mw.AddScrubbedObject(m.id, corrupted)
+}
+
 // AddShard adds a new shard to the storage engine.
 //
+// If the engine is already initialized, the shard is opened and initialized
+// before it is added and the shard rebalancing is started if it is enabled
+// with WithRebalanceOnShardAdd.
+//
 // Returns any error encountered that did not allow adding a shard.
 // Otherwise returns the ID of the added shard.
 func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
@@ -67,8 +92,26 @@ func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
 		return nil, fmt.Errorf("could not create a shard: %w", err)
 	}
 
+	e.mtx.RLock()
+	initialized := e.initialized
+	e.mtx.RUnlock()
+
+	if initialized {
+		err = sh.Open()
+		if err == nil {
+			err = sh.Init()
+		}
+		if err != nil {
+			_ = sh.Close()
+			return nil, fmt.Errorf("could not init %s shard: %w", sh.ID().String(), err)
+		}
+	}
+
 	err = e.addShard(sh)
 	if err != nil {
+		if initialized {
+			_ = sh.Close()
+		}
 		return nil, fmt.Errorf("could not add %s shard: %w", sh.ID().String(), err)
 	}
 
@@ -76,6 +119,10 @@ func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
 		e.cfg.metrics.SetReadonly(sh.ID().String(), sh.GetMode() != mode.ReadWrite)
 	}
 
+	if initialized && e.rebalanceOnShardAdd {
+		e.requestRebalance()
+	}
+
 	return sh.ID(), nil
 }
 
@@ -50,6 +50,25 @@ func (e *StorageEngine) TreeAddByPath(d pilorama.CIDDescriptor, treeID string, a
 	return lm, nil
 }
 
+// TreeBatch implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeBatch(d pilorama.CIDDescriptor, treeID string, ops []pilorama.BatchOperation) ([][]pilorama.Move, error) {
+	index, lst, err := e.getTreeShard(d.CID, treeID)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return nil, err
+	}
+
+	lm, err := lst[index].TreeBatch(d, treeID, ops)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+			e.reportShardError(lst[index], "can't perform `TreeBatch`", err,
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID))
+		}
+		return nil, err
+	}
+	return lm, nil
+}
+
 // TreeApply implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeApply(d pilorama.CIDDescriptor, treeID string, m *pilorama.Move, backgroundSync bool) error {
 	index, lst, err := e.getTreeShard(d.CID, treeID)
@@ -136,6 +155,28 @@ func (e *StorageEngine) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pil
 	return nil, err
 }
 
+// TreeGetSortedChildren implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeGetSortedChildren(cid cidSDK.ID, treeID string, nodeID pilorama.Node, attr string, after *pilorama.SortCursor, count int) ([]pilorama.Node, error) {
+	var err error
+	var nodes []pilorama.Node
+	for _, sh := range e.sortShardsByWeight(cid) {
+		nodes, err = sh.TreeGetSortedChildren(cid, treeID, nodeID, attr, after, count)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeGetSortedChildren`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return nodes, nil
+	}
+	return nil, err
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	var err error
@@ -213,6 +254,67 @@ func (e *StorageEngine) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	return err == nil, err
 }
 
+// TreeCompact implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeCompact(cid cidSDK.ID, treeID string, height uint64) error {
+	index, lst, err := e.getTreeShard(cid, treeID)
+	if err != nil {
+		return err
+	}
+
+	err = lst[index].TreeCompact(cid, treeID, height)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+			e.reportShardError(lst[index], "can't perform `TreeCompact`", err,
+				zap.Stringer("cid", cid),
+				zap.String("tree", treeID))
+		}
+		return err
+	}
+	return nil
+}
+
+// TreeGetSnapshot implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeGetSnapshot(cid cidSDK.ID, treeID string, height uint64, cursor *pilorama.SnapshotCursor, count int) (uint64, []pilorama.SnapshotNode, error) {
+	var err error
+	var h uint64
+	var nodes []pilorama.SnapshotNode
+	for _, sh := range e.sortShardsByWeight(cid) {
+		h, nodes, err = sh.TreeGetSnapshot(cid, treeID, height, cursor, count)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeGetSnapshot`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return h, nodes, nil
+	}
+	return 0, nil, err
+}
+
+// TreeApplySnapshot implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeApplySnapshot(d pilorama.CIDDescriptor, treeID string, height uint64, nodes []pilorama.SnapshotNode, more bool) error {
+	index, lst, err := e.getTreeShard(d.CID, treeID)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return err
+	}
+
+	err = lst[index].TreeApplySnapshot(d, treeID, height, nodes, more)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+			e.reportShardError(lst[index], "can't perform `TreeApplySnapshot`", err,
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID))
+		}
+		return err
+	}
+	return nil
+}
+
 func (e *StorageEngine) getTreeShard(cid cidSDK.ID, treeID string) (int, []hashedShard, error) {
 	lst := e.sortShardsByWeight(cid)
 	for i, sh := range lst {
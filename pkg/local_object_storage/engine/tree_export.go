@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"io"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+)
+
+// ExportTree writes the tree from the shard it is stored in to w.
+// See pilorama.ExportTree for details.
+func (e *StorageEngine) ExportTree(w io.Writer, cid cidSDK.ID, treeID string, snapshot bool) (pilorama.ExportInfo, error) {
+	index, lst, err := e.getTreeShard(cid, treeID)
+	if err != nil {
+		return pilorama.ExportInfo{}, err
+	}
+
+	return pilorama.ExportTree(w, lst[index].Shard, cid, treeID, snapshot)
+}
+
+// ImportTree reads the tree written by ExportTree from r and applies it to the shard
+// with provided identifier. If the identifier is nil, the shard is selected the same
+// way as for the tree operations.
+func (e *StorageEngine) ImportTree(r io.Reader, id *shard.ID) (pilorama.ExportInfo, error) {
+	f, err := e.TreeImportTarget(id)
+	if err != nil {
+		return pilorama.ExportInfo{}, err
+	}
+
+	return pilorama.ImportTree(r, f)
+}
+
+// TreeImportTarget returns the forest ImportTree applies the tree to: the shard
+// with provided identifier or the engine itself if the identifier is nil.
+func (e *StorageEngine) TreeImportTarget(id *shard.ID) (pilorama.Forest, error) {
+	if id == nil {
+		return e, nil
+	}
+
+	e.mtx.RLock()
+	sh, ok := e.shards[id.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return nil, errShardNotFound
+	}
+
+	return sh.Shard, nil
+}
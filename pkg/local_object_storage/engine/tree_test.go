@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"strconv"
 	"testing"
 
@@ -30,7 +31,7 @@ func benchmarkTreeVsSearch(b *testing.B, objCount int) {
 	for i := 0; i < objCount; i++ {
 		obj := generateObjectWithCID(b, cid)
 		addAttribute(obj, pilorama.AttributeFilename, strconv.Itoa(i))
-		err := Put(e, obj)
+		err := Put(context.Background(), e, obj)
 		if err != nil {
 			b.Fatal(err)
 		}
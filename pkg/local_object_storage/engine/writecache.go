@@ -2,6 +2,7 @@ package engine
 
 import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 )
 
 // FlushWriteCachePrm groups the parameters of FlushWriteCache operation.
@@ -40,3 +41,43 @@ func (e *StorageEngine) FlushWriteCache(p FlushWriteCachePrm) (FlushWriteCacheRe
 
 	return FlushWriteCacheRes{}, sh.FlushWriteCache(prm)
 }
+
+// WriteCacheStatsPrm groups the parameters of WriteCacheStats operation.
+type WriteCacheStatsPrm struct {
+	shardID *shard.ID
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *WriteCacheStatsPrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// WriteCacheStatsRes groups the resulting values of WriteCacheStats operation.
+type WriteCacheStatsRes struct {
+	stats writecache.Stats
+}
+
+// Stats returns the statistics of the shard's write-cache.
+func (r WriteCacheStatsRes) Stats() writecache.Stats {
+	return r.stats
+}
+
+// WriteCacheStats returns the write-cache statistics of a single shard.
+func (e *StorageEngine) WriteCacheStats(p WriteCacheStatsPrm) (WriteCacheStatsRes, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[p.shardID.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return WriteCacheStatsRes{}, errShardNotFound
+	}
+
+	stats, err := sh.WriteCacheStats()
+	if err != nil {
+		return WriteCacheStatsRes{}, err
+	}
+
+	return WriteCacheStatsRes{stats: stats}, nil
+}
@@ -0,0 +1,405 @@
+package meta
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"go.etcd.io/bbolt"
+)
+
+// IndexedObject describes the object which is indexed in the metabase
+// as physically stored in the blobstor.
+type IndexedObject struct {
+	addr      oid.Address
+	typ       objectSDK.Type
+	storageID []byte
+}
+
+// Address returns the object address.
+func (o IndexedObject) Address() oid.Address {
+	return o.addr
+}
+
+// Type returns the object type.
+func (o IndexedObject) Type() objectSDK.Type {
+	return o.typ
+}
+
+// StorageID returns the storage ID of the object.
+// Nil means the storage ID is unknown.
+func (o IndexedObject) StorageID() []byte {
+	return o.storageID
+}
+
+// IndexedObjectHandler is an IndexedObject handling function.
+type IndexedObjectHandler func(IndexedObject) error
+
+// IterateIndexedObjects iterates over all objects indexed in the metabase
+// as physically stored, including the removed ones.
+//
+// If h returns ErrInterruptIterator, nil returns immediately.
+// Returns other errors of h directly.
+func (db *DB) IterateIndexedObjects(h IndexedObjectHandler) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var cnr cid.ID
+
+		return tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			rawCID, postfix := parseContainerIDWithPrefix(&cnr, name)
+			if len(rawCID) == 0 {
+				return nil
+			}
+
+			typ, ok := objectTypeByPrefix(postfix)
+			if !ok {
+				return nil
+			}
+
+			key := make([]byte, bucketKeySize)
+			smallBucket := tx.Bucket(smallBucketName(cnr, key))
+
+			return b.ForEach(func(k, _ []byte) error {
+				var id oid.ID
+				if id.Decode(k) != nil {
+					return nil
+				}
+
+				var obj IndexedObject
+				obj.addr.SetContainer(cnr)
+				obj.addr.SetObject(id)
+				obj.typ = typ
+
+				if smallBucket != nil {
+					if v := smallBucket.Get(k); v != nil {
+						obj.storageID = slice.Copy(v)
+					}
+				}
+
+				return h(obj)
+			})
+		})
+	})
+	if errors.Is(err, ErrInterruptIterator) {
+		err = nil
+	}
+
+	return err
+}
+
+// GetIndexedObject returns the object with the given address if it
+// is indexed in the metabase as physically stored. The second return
+// value is false if there is no such object.
+func (db *DB) GetIndexedObject(addr oid.Address) (IndexedObject, bool, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return IndexedObject{}, false, ErrDegradedMode
+	}
+
+	var (
+		obj   IndexedObject
+		found bool
+	)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		key := make([]byte, bucketKeySize)
+		objKey := objectKey(addr.Object(), make([]byte, objectKeySize))
+
+		for _, prefix := range []byte{primaryPrefix, lockersPrefix, storageGroupPrefix, tombstonePrefix} {
+			b := tx.Bucket(bucketName(addr.Container(), prefix, key))
+			if b == nil || b.Get(objKey) == nil {
+				continue
+			}
+
+			obj.addr = addr
+			obj.typ, _ = objectTypeByPrefix(prefix)
+			found = true
+
+			var err error
+			obj.storageID, err = db.storageID(tx, addr)
+			return err
+		}
+
+		return nil
+	})
+
+	return obj, found, err
+}
+
+// RepairStorageID sets the storage ID of the object indexed in the metabase.
+// Unlike UpdateStorageID, it doesn't check the object availability, so it
+// must be used only to fix the metabase inconsistencies.
+func (db *DB) RepairStorageID(addr oid.Address, id []byte) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+		return updateStorageID(tx, addr, id)
+	})
+}
+
+func objectTypeByPrefix(prefix byte) (objectSDK.Type, bool) {
+	switch prefix {
+	case primaryPrefix:
+		return objectSDK.TypeRegular, true
+	case lockersPrefix:
+		return objectSDK.TypeLock, true
+	case storageGroupPrefix:
+		return objectSDK.TypeStorageGroup, true
+	case tombstonePrefix:
+		return objectSDK.TypeTombstone, true
+	default:
+		return 0, false
+	}
+}
+
+// CheckIntegrityPrm groups the parameters of CheckIntegrity operation.
+type CheckIntegrityPrm struct {
+	repair bool
+}
+
+// SetRepair is a CheckIntegrity option to fix the found inconsistencies.
+func (p *CheckIntegrityPrm) SetRepair(v bool) {
+	p.repair = v
+}
+
+// CheckIntegrityRes groups the resulting values of CheckIntegrity operation.
+type CheckIntegrityRes struct {
+	stored ObjectCounters
+	actual ObjectCounters
+
+	danglingLocks  []oid.Address
+	danglingGraves []oid.Address
+}
+
+// StoredCounters returns the object counters saved in the metabase.
+func (r CheckIntegrityRes) StoredCounters() ObjectCounters {
+	return r.stored
+}
+
+// ActualCounters returns the object counters calculated using the metabase indexes.
+func (r CheckIntegrityRes) ActualCounters() ObjectCounters {
+	return r.actual
+}
+
+// DanglingLocks returns addresses of the locked objects which lock records
+// reference removed LOCK objects.
+func (r CheckIntegrityRes) DanglingLocks() []oid.Address {
+	return r.danglingLocks
+}
+
+// DanglingGraves returns addresses of the objects which graveyard records
+// reference removed tombstones.
+func (r CheckIntegrityRes) DanglingGraves() []oid.Address {
+	return r.danglingGraves
+}
+
+// CheckIntegrity checks the internal consistency of the metabase: object counters,
+// lock records referencing removed (or unreadable) LOCK objects and graveyard
+// records referencing removed (or unreadable) tombstones.
+//
+// If repair option is set, counters are synchronized with the indexes and dangling
+// records are removed. Objects covered with dangling graves stay marked with GC,
+// so they are not returned from the metabase.
+func (db *DB) CheckIntegrity(prm CheckIntegrityPrm) (res CheckIntegrityRes, err error) {
+	defer util.Elapsed(db.metrics, "CheckIntegrity", &err)()
+
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if prm.repair && db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	f := func(tx *bbolt.Tx) error {
+		if b := tx.Bucket(shardInfoBucket); b != nil {
+			res.stored.phy = counterValue(b.Get(objectPhyCounterKey))
+			res.stored.logic = counterValue(b.Get(objectLogicCounterKey))
+		}
+
+		res.actual, err = countObjects(tx)
+		if err != nil {
+			return err
+		}
+
+		res.danglingLocks, err = checkLocks(tx, prm.repair)
+		if err != nil {
+			return fmt.Errorf("could not check lock records: %w", err)
+		}
+
+		res.danglingGraves, err = checkGraves(tx, prm.repair)
+		if err != nil {
+			return fmt.Errorf("could not check graveyard records: %w", err)
+		}
+
+		if prm.repair && res.stored != res.actual {
+			return syncCounter(tx, true)
+		}
+		return nil
+	}
+
+	if prm.repair {
+		err = db.boltDB.Update(f)
+	} else {
+		err = db.boltDB.View(f)
+	}
+
+	return res, err
+}
+
+func counterValue(data []byte) uint64 {
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(data)
+}
+
+// isRemovedKey checks whether the object with the given address key
+// is marked with GC or covered with a tombstone.
+func isRemovedKey(tx *bbolt.Tx, addrKey []byte) bool {
+	return inGraveyardWithKey(addrKey, tx.Bucket(graveyardBucketName), tx.Bucket(garbageBucketName)) != 0
+}
+
+// checkLocks returns the locked objects which records contain lockers
+// that are removed from the metabase. Tx MUST be writable if repair is true.
+func checkLocks(tx *bbolt.Tx, repair bool) ([]oid.Address, error) {
+	bucketLocked := tx.Bucket(bucketNameLocked)
+	if bucketLocked == nil {
+		return nil, nil
+	}
+
+	type lockRecord struct {
+		b       *bbolt.Bucket
+		key     []byte
+		lockers [][]byte
+	}
+
+	var (
+		res     []oid.Address
+		records []lockRecord
+	)
+
+	err := bucketLocked.ForEach(func(rawCnr, _ []byte) error {
+		var cnr cid.ID
+		if cnr.Decode(rawCnr) != nil {
+			return nil
+		}
+
+		b := bucketLocked.Bucket(rawCnr)
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			var addr oid.Address
+			var id oid.ID
+			if id.Decode(k) != nil {
+				return nil
+			}
+
+			addr.SetContainer(cnr)
+			addr.SetObject(id)
+
+			keyLockers, err := decodeList(v)
+			if err != nil {
+				// unreadable record, the whole record is dangling
+				res = append(res, addr)
+				records = append(records, lockRecord{b: b, key: slice.Copy(k)})
+				return nil
+			}
+
+			alive := make([][]byte, 0, len(keyLockers))
+			for i := range keyLockers {
+				if !isRemovedKey(tx, append(slice.Copy(rawCnr), keyLockers[i]...)) {
+					alive = append(alive, keyLockers[i])
+				}
+			}
+
+			if len(alive) != len(keyLockers) {
+				res = append(res, addr)
+				records = append(records, lockRecord{b: b, key: slice.Copy(k), lockers: alive})
+			}
+			return nil
+		})
+	})
+	if err != nil || !repair {
+		return res, err
+	}
+
+	for _, r := range records {
+		if len(r.lockers) == 0 {
+			err = r.b.Delete(r.key)
+		} else {
+			var v []byte
+			v, err = encodeList(r.lockers)
+			if err == nil {
+				err = r.b.Put(r.key, v)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not update lock record: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+// checkGraves returns the objects which graveyard records reference
+// removed tombstones. Tx MUST be writable if repair is true.
+func checkGraves(tx *bbolt.Tx, repair bool) ([]oid.Address, error) {
+	graveyardBKT := tx.Bucket(graveyardBucketName)
+	if graveyardBKT == nil {
+		return nil, nil
+	}
+
+	var (
+		res  []oid.Address
+		keys [][]byte
+	)
+
+	err := graveyardBKT.ForEach(func(k, v []byte) error {
+		var addr oid.Address
+		if decodeAddressFromKey(&addr, k) != nil {
+			return nil
+		}
+
+		var tomb oid.Address
+		if decodeAddressFromKey(&tomb, v) != nil || isRemovedKey(tx, v) {
+			res = append(res, addr)
+			keys = append(keys, slice.Copy(k))
+		}
+		return nil
+	})
+	if err != nil || !repair {
+		return res, err
+	}
+
+	for i := range keys {
+		if err := graveyardBKT.Delete(keys[i]); err != nil {
+			return nil, fmt.Errorf("could not remove grave: %w", err)
+		}
+	}
+
+	return res, nil
+}
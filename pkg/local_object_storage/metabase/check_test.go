@@ -0,0 +1,145 @@
+package meta_test
+
+import (
+	"testing"
+
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_IterateIndexedObjects(t *testing.T) {
+	db := newDB(t)
+
+	regular := generateObject(t)
+	require.NoError(t, metaPut(db, regular, []byte("blz")))
+
+	lock := generateObject(t)
+	lock.SetType(object.TypeLock)
+	require.NoError(t, metaPut(db, lock, []byte{}))
+
+	tomb := generateObject(t)
+	tomb.SetType(object.TypeTombstone)
+	require.NoError(t, putBig(db, tomb))
+
+	// removed objects are still indexed
+	require.NoError(t, metaInhume(db, objectcore.AddressOf(regular), objectcore.AddressOf(tomb)))
+
+	expected := map[oid.Address]meta.IndexedObject{}
+	require.NoError(t, db.IterateIndexedObjects(func(obj meta.IndexedObject) error {
+		expected[obj.Address()] = obj
+		return nil
+	}))
+	require.Len(t, expected, 3)
+
+	check := func(obj *object.Object, typ object.Type, storageID []byte) {
+		addr := objectcore.AddressOf(obj)
+
+		require.Equal(t, typ, expected[addr].Type())
+		require.Equal(t, storageID, expected[addr].StorageID())
+
+		res, ok, err := db.GetIndexedObject(addr)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, expected[addr], res)
+	}
+
+	check(regular, object.TypeRegular, []byte("blz"))
+	check(lock, object.TypeLock, []byte{})
+	check(tomb, object.TypeTombstone, nil)
+
+	_, ok, err := db.GetIndexedObject(oidtest.Address())
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	t.Run("repair storage ID", func(t *testing.T) {
+		addr := objectcore.AddressOf(regular)
+		require.NoError(t, db.RepairStorageID(addr, []byte("other")))
+
+		res, ok, err := db.GetIndexedObject(addr)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []byte("other"), res.StorageID())
+	})
+}
+
+func TestDB_CheckIntegrity(t *testing.T) {
+	db := newDB(t)
+
+	check := func(repair bool) meta.CheckIntegrityRes {
+		var prm meta.CheckIntegrityPrm
+		prm.SetRepair(repair)
+
+		res, err := db.CheckIntegrity(prm)
+		require.NoError(t, err)
+		return res
+	}
+
+	lockedObjs, lockObj := putAndLockObj(t, db, 2)
+	lockAddr := objectcore.AddressOf(lockObj)
+
+	obj := generateObject(t)
+	require.NoError(t, putBig(db, obj))
+
+	tomb := generateObjectWithCID(t, cidFromObject(obj))
+	tomb.SetType(object.TypeTombstone)
+	require.NoError(t, putBig(db, tomb))
+	require.NoError(t, metaInhume(db, objectcore.AddressOf(obj), objectcore.AddressOf(tomb)))
+
+	res := check(false)
+	require.Equal(t, res.StoredCounters(), res.ActualCounters())
+	require.Equal(t, uint64(5), res.ActualCounters().Phy())
+	require.Equal(t, uint64(4), res.ActualCounters().Logic())
+	require.Empty(t, res.DanglingLocks())
+	require.Empty(t, res.DanglingGraves())
+
+	// LOCK and tombstone are removed, but the records referencing them are not.
+	var inhumePrm meta.InhumePrm
+	inhumePrm.SetAddresses(lockAddr, objectcore.AddressOf(tomb))
+	inhumePrm.SetForceGCMark()
+
+	_, err := db.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	res = check(false)
+	require.ElementsMatch(t,
+		[]oid.Address{objectcore.AddressOf(lockedObjs[0]), objectcore.AddressOf(lockedObjs[1])},
+		res.DanglingLocks())
+	require.Equal(t, []oid.Address{objectcore.AddressOf(obj)}, res.DanglingGraves())
+
+	// check mode doesn't change anything
+	res = check(false)
+	require.Len(t, res.DanglingLocks(), 2)
+	require.Len(t, res.DanglingGraves(), 1)
+
+	res = check(true)
+	require.Len(t, res.DanglingLocks(), 2)
+	require.Len(t, res.DanglingGraves(), 1)
+
+	res = check(false)
+	require.Empty(t, res.DanglingLocks())
+	require.Empty(t, res.DanglingGraves())
+	require.Equal(t, res.StoredCounters(), res.ActualCounters())
+
+	for i := range lockedObjs {
+		var prm meta.IsLockedPrm
+		prm.SetAddress(objectcore.AddressOf(lockedObjs[i]))
+
+		lockedRes, err := db.IsLocked(prm)
+		require.NoError(t, err)
+		require.False(t, lockedRes.Locked())
+	}
+
+	// object covered with the dangling grave is still removed
+	_, err = metaExists(db, objectcore.AddressOf(obj))
+	require.Error(t, err)
+}
+
+func cidFromObject(obj *object.Object) cid.ID {
+	cnr, _ := obj.ContainerID()
+	return cnr
+}
@@ -3,11 +3,14 @@ package meta
 import (
 	"encoding/binary"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"go.etcd.io/bbolt"
 )
 
 func (db *DB) Containers() (list []cid.ID, err error) {
+	defer util.Elapsed(db.metrics, "Containers", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -42,6 +45,8 @@ func (db *DB) containers(tx *bbolt.Tx) ([]cid.ID, error) {
 }
 
 func (db *DB) ContainerSize(id cid.ID) (size uint64, err error) {
+	defer util.Elapsed(db.metrics, "ContainerSize", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
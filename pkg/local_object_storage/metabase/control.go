@@ -67,6 +67,7 @@ func (db *DB) openBolt() error {
 			db.initialized = true
 			err = nil
 		}
+		db.noNumericIndexes = storedVersion(tx) == 2
 		return err
 	})
 }
@@ -108,6 +109,13 @@ func (db *DB) init(reset bool) error {
 		string(bucketNameLocked):          {},
 	}
 
+	if !reset {
+		if err := db.upgradeFromV2(); err != nil {
+			return err
+		}
+		db.noNumericIndexes = false
+	}
+
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
 		var err error
 		if !reset {
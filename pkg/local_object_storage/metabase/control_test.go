@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -54,6 +55,6 @@ func metaExists(db *meta.DB, addr oid.Address) (bool, error) {
 	var existsPrm meta.ExistsPrm
 	existsPrm.SetAddress(addr)
 
-	res, err := db.Exists(existsPrm)
+	res, err := db.Exists(context.Background(), existsPrm)
 	return res.Exists(), err
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
@@ -43,6 +44,8 @@ func (o ObjectCounters) Phy() uint64 {
 // Returns only the errors that do not allow reading counter
 // in Bolt database.
 func (db *DB) ObjectCounters() (cc ObjectCounters, err error) {
+	defer util.Elapsed(db.metrics, "ObjectCounters", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -126,16 +129,42 @@ func syncCounter(tx *bbolt.Tx, force bool) error {
 		return nil
 	}
 
+	cc, err := countObjects(tx)
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, cc.phy)
+
+	err = b.Put(objectPhyCounterKey, data)
+	if err != nil {
+		return fmt.Errorf("could not update phy object counter: %w", err)
+	}
+
+	data = make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, cc.logic)
+
+	err = b.Put(objectLogicCounterKey, data)
+	if err != nil {
+		return fmt.Errorf("could not update logic object counter: %w", err)
+	}
+
+	return nil
+}
+
+// countObjects counts all the physically/logically stored objects
+// using internal indexes.
+func countObjects(tx *bbolt.Tx) (ObjectCounters, error) {
 	var addr oid.Address
-	var phyCounter uint64
-	var logicCounter uint64
+	var cc ObjectCounters
 
 	graveyardBKT := tx.Bucket(graveyardBucketName)
 	garbageBKT := tx.Bucket(garbageBucketName)
 	key := make([]byte, addressKeySize)
 
-	err = iteratePhyObjects(tx, func(cnr cid.ID, obj oid.ID) error {
-		phyCounter++
+	err := iteratePhyObjects(tx, func(cnr cid.ID, obj oid.ID) error {
+		cc.phy++
 
 		addr.SetContainer(cnr)
 		addr.SetObject(obj)
@@ -143,30 +172,14 @@ func syncCounter(tx *bbolt.Tx, force bool) error {
 		// check if an object is available: not with GCMark
 		// and not covered with a tombstone
 		if inGraveyardWithKey(addressKey(addr, key), graveyardBKT, garbageBKT) == 0 {
-			logicCounter++
+			cc.logic++
 		}
 
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("could not iterate objects: %w", err)
-	}
-
-	data := make([]byte, 8)
-	binary.LittleEndian.PutUint64(data, phyCounter)
-
-	err = b.Put(objectPhyCounterKey, data)
-	if err != nil {
-		return fmt.Errorf("could not update phy object counter: %w", err)
+		return ObjectCounters{}, fmt.Errorf("could not iterate objects: %w", err)
 	}
 
-	data = make([]byte, 8)
-	binary.LittleEndian.PutUint64(data, logicCounter)
-
-	err = b.Put(objectLogicCounterKey, data)
-	if err != nil {
-		return fmt.Errorf("could not update logic object counter: %w", err)
-	}
-
-	return nil
+	return cc, nil
 }
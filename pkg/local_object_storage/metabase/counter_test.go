@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"context"
 	"testing"
 
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -37,7 +38,7 @@ func TestCounters(t *testing.T) {
 		for i := 0; i < objCount; i++ {
 			prm.SetObject(oo[i])
 
-			_, err = db.Put(prm)
+			_, err = db.Put(context.Background(), prm)
 			require.NoError(t, err)
 
 			c, err = db.ObjectCounters()
@@ -283,7 +284,7 @@ func putObjs(t *testing.T, db *meta.DB, count int, withParent bool) []*object.Ob
 		oo = append(oo, o)
 
 		prm.SetObject(o)
-		_, err = db.Put(prm)
+		_, err = db.Put(context.Background(), prm)
 		require.NoError(t, err)
 
 		c, err := db.ObjectCounters()
@@ -12,7 +12,9 @@ import (
 	"time"
 
 	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/mr-tron/base58"
@@ -44,6 +46,10 @@ type DB struct {
 	boltDB *bbolt.DB
 
 	initialized bool
+
+	// noNumericIndexes is set if the metabase of version 2 is not
+	// upgraded yet, numeric filters are matched by object headers then.
+	noNumericIndexes bool
 }
 
 // Option is an option of DB constructor.
@@ -60,6 +66,8 @@ type cfg struct {
 	log *logger.Logger
 
 	epochState EpochState
+
+	metrics MetricsWriter
 }
 
 func defaultCfg() *cfg {
@@ -70,6 +78,7 @@ func defaultCfg() *cfg {
 		boltBatchDelay: bbolt.DefaultMaxBatchDelay,
 		boltBatchSize:  bbolt.DefaultMaxBatchSize,
 		log:            &logger.Logger{Logger: zap.L()},
+		metrics:        util.NoopMethodDurationWriter{},
 	}
 }
 
@@ -104,6 +113,10 @@ func New(opts ...Option) *DB {
 				matchSlow:   stringCommonPrefixMatcher,
 				matchBucket: stringCommonPrefixMatcherBucket,
 			},
+			objectcore.MatchNumGT: numericMatcher(objectcore.MatchNumGT),
+			objectcore.MatchNumGE: numericMatcher(objectcore.MatchNumGE),
+			objectcore.MatchNumLT: numericMatcher(objectcore.MatchNumLT),
+			objectcore.MatchNumLE: numericMatcher(objectcore.MatchNumLE),
 		},
 	}
 }
@@ -349,3 +362,10 @@ func WithEpochState(s EpochState) Option {
 		c.epochState = s
 	}
 }
+
+// WithMetrics returns option to specify metrics writer of DB.
+func WithMetrics(m MetricsWriter) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -59,7 +60,9 @@ type referenceNumber struct {
 type referenceCounter map[string]*referenceNumber
 
 // Delete removed object records from metabase indexes.
-func (db *DB) Delete(prm DeletePrm) (DeleteRes, error) {
+func (db *DB) Delete(prm DeletePrm) (res DeleteRes, err error) {
+	defer util.Elapsed(db.metrics, "Delete", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -71,7 +74,6 @@ func (db *DB) Delete(prm DeletePrm) (DeleteRes, error) {
 
 	var rawRemoved uint64
 	var availableRemoved uint64
-	var err error
 	var sizes = make([]uint64, len(prm.addrs))
 
 	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
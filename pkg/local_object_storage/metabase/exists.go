@@ -1,16 +1,21 @@
 package meta
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ExistsPrm groups the parameters of Exists operation.
@@ -40,7 +45,15 @@ func (p ExistsRes) Exists() bool {
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been placed in graveyard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (db *DB) Exists(prm ExistsPrm) (res ExistsRes, err error) {
+func (db *DB) Exists(ctx context.Context, prm ExistsPrm) (res ExistsRes, err error) {
+	defer util.Elapsed(db.metrics, "Exists", &err)()
+
+	_, span := tracing.StartSpanFromContext(ctx, "metabase.Exists",
+		trace.WithAttributes(
+			attribute.String("address", prm.addr.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -1,14 +1,19 @@
 package meta
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // GetPrm groups the parameters of Get operation.
@@ -46,7 +51,16 @@ func (r GetRes) Header() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectNotFound if object is missing in DB.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been placed in graveyard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (db *DB) Get(prm GetPrm) (res GetRes, err error) {
+func (db *DB) Get(ctx context.Context, prm GetPrm) (res GetRes, err error) {
+	defer util.Elapsed(db.metrics, "Get", &err)()
+
+	_, span := tracing.StartSpanFromContext(ctx, "metabase.Get",
+		trace.WithAttributes(
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("raw", prm.raw),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -2,6 +2,7 @@ package meta_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"runtime"
@@ -217,7 +218,7 @@ func benchmarkGet(b *testing.B, numOfObj int) {
 				getPrm.SetAddress(addrs[counter%len(addrs)])
 				counter++
 
-				_, err := db.Get(getPrm)
+				_, err := db.Get(context.Background(), getPrm)
 				if err != nil {
 					b.Fatal(err)
 				}
@@ -236,7 +237,7 @@ func benchmarkGet(b *testing.B, numOfObj int) {
 			var getPrm meta.GetPrm
 			getPrm.SetAddress(addrs[i%len(addrs)])
 
-			_, err := db.Get(getPrm)
+			_, err := db.Get(context.Background(), getPrm)
 			if err != nil {
 				b.Fatal(err)
 			}
@@ -249,6 +250,6 @@ func metaGet(db *meta.DB, addr oid.Address, raw bool) (*objectSDK.Object, error)
 	prm.SetAddress(addr)
 	prm.SetRaw(raw)
 
-	res, err := db.Get(prm)
+	res, err := db.Get(context.Background(), prm)
 	return res.Header(), err
 }
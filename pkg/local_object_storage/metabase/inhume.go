@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -90,6 +91,8 @@ var ErrLockObjectRemoval = logicerr.New("lock object removal")
 // NOTE: Marks any object with GC mark (despite any prohibitions on operations
 // with that object) if WithForceGCMark option has been provided.
 func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
+	defer util.Elapsed(db.metrics, "Inhume", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
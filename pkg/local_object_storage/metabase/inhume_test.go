@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -55,7 +56,7 @@ func TestInhumeTombOnTomb(t *testing.T) {
 	existsPrm.SetAddress(addr1)
 
 	// addr1 should become inhumed {addr1:addr2}
-	_, err = db.Exists(existsPrm)
+	_, err = db.Exists(context.Background(), existsPrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 
 	inhumePrm.SetAddresses(addr3)
@@ -69,13 +70,13 @@ func TestInhumeTombOnTomb(t *testing.T) {
 	// as a tomb-on-tomb; metabase should return ObjectNotFound
 	// NOT ObjectAlreadyRemoved since that record has been removed
 	// from graveyard but addr1 is still marked with GC
-	_, err = db.Exists(existsPrm)
+	_, err = db.Exists(context.Background(), existsPrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 
 	existsPrm.SetAddress(addr3)
 
 	// addr3 should be inhumed {addr3: addr1}
-	_, err = db.Exists(existsPrm)
+	_, err = db.Exists(context.Background(), existsPrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 
 	inhumePrm.SetAddresses(addr1)
@@ -90,7 +91,7 @@ func TestInhumeTombOnTomb(t *testing.T) {
 	// record with addr1 key should not appear in graveyard
 	// (tomb can not be inhumed) but should be kept as object
 	// with GC mark
-	_, err = db.Exists(existsPrm)
+	_, err = db.Exists(context.Background(), existsPrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 }
 
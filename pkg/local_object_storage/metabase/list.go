@@ -1,7 +1,11 @@
 package meta
 
 import (
+	"encoding/binary"
+	"errors"
+
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -20,6 +24,26 @@ type Cursor struct {
 	inBucketOffset []byte
 }
 
+// MarshalBinary encodes cursor into a binary form.
+func (c *Cursor) MarshalBinary() ([]byte, error) {
+	data := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(c.bucketName)+len(c.inBucketOffset))
+	n := binary.PutUvarint(data, uint64(len(c.bucketName)))
+	data = append(data[:n], c.bucketName...)
+	return append(data, c.inBucketOffset...), nil
+}
+
+// UnmarshalBinary decodes cursor from the binary form produced by MarshalBinary.
+func (c *Cursor) UnmarshalBinary(data []byte) error {
+	ln, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < ln {
+		return errors.New("invalid cursor")
+	}
+
+	c.bucketName = append([]byte{}, data[n:n+int(ln)]...)
+	c.inBucketOffset = append([]byte{}, data[n+int(ln):]...)
+	return nil
+}
+
 // ListPrm contains parameters for ListWithCursor operation.
 type ListPrm struct {
 	count  int
@@ -61,6 +85,8 @@ func (l ListRes) Cursor() *Cursor {
 // Returns ErrEndOfListing if there are no more objects to return or count
 // parameter set to zero.
 func (db *DB) ListWithCursor(prm ListPrm) (res ListRes, err error) {
+	defer util.Elapsed(db.metrics, "ListWithCursor", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
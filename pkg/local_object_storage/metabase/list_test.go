@@ -230,3 +230,41 @@ func metaListWithCursor(db *meta.DB, count uint32, cursor *meta.Cursor) ([]objec
 	r, err := db.ListWithCursor(listPrm)
 	return r.AddressList(), r.Cursor(), err
 }
+
+func TestListWithCursorMarshal(t *testing.T) {
+	db := newDB(t)
+
+	const total = 5
+
+	expected := make(map[string]int, total)
+	for i := 0; i < total; i++ {
+		obj := generateObject(t)
+		require.NoError(t, putBig(db, obj))
+		expected[object.AddressOf(obj).EncodeToString()] = 0
+	}
+
+	var cursor *meta.Cursor
+	for {
+		got, c, err := metaListWithCursor(db, 2, cursor)
+		if errors.Is(err, meta.ErrEndOfListing) {
+			break
+		}
+		require.NoError(t, err)
+		for _, obj := range got {
+			expected[obj.Address.EncodeToString()]++
+		}
+
+		data, err := c.MarshalBinary()
+		require.NoError(t, err)
+
+		cursor = new(meta.Cursor)
+		require.NoError(t, cursor.UnmarshalBinary(data))
+	}
+
+	for _, v := range expected {
+		require.Equal(t, 1, v)
+	}
+
+	require.Error(t, new(meta.Cursor).UnmarshalBinary(nil))
+	require.Error(t, new(meta.Cursor).UnmarshalBinary([]byte{10, 1}))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
@@ -25,7 +26,9 @@ func bucketNameLockers(idCnr cid.ID, key []byte) []byte {
 // Allows locking regular objects only (otherwise returns apistatus.LockNonRegularObject).
 //
 // Locked list should be unique. Panics if it is empty.
-func (db *DB) Lock(cnr cid.ID, locker oid.ID, locked []oid.ID) error {
+func (db *DB) Lock(cnr cid.ID, locker oid.ID, locked []oid.ID) (err error) {
+	defer util.Elapsed(db.metrics, "Lock", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -96,7 +99,9 @@ func (db *DB) Lock(cnr cid.ID, locker oid.ID, locked []oid.ID) error {
 }
 
 // FreeLockedBy unlocks all objects in DB which are locked by lockers.
-func (db *DB) FreeLockedBy(lockers []oid.Address) error {
+func (db *DB) FreeLockedBy(lockers []oid.Address) (err error) {
+	defer util.Elapsed(db.metrics, "FreeLockedBy", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -215,6 +220,8 @@ func (i IsLockedRes) Locked() bool {
 //
 // Returns only non-logical errors related to underlying database.
 func (db *DB) IsLocked(prm IsLockedPrm) (res IsLockedRes, err error) {
+	defer util.Elapsed(db.metrics, "IsLocked", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"context"
 	"testing"
 
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -202,7 +203,7 @@ func TestDB_IsLocked(t *testing.T) {
 	var putPrm meta.PutPrm
 	putPrm.SetObject(obj)
 
-	_, err = db.Put(putPrm)
+	_, err = db.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	prm.SetAddress(objectcore.AddressOf(obj))
@@ -0,0 +1,6 @@
+package meta
+
+import "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
+
+// MetricsWriter is an interface that must store metabase metrics.
+type MetricsWriter = util.MethodDurationWriter
@@ -3,6 +3,7 @@ package meta
 import (
 	"fmt"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
 )
@@ -49,6 +50,8 @@ func (p MovableRes) AddressList() []oid.Address {
 // ToMoveIt marks objects to move it into another shard. This useful for
 // faster HRW fetching.
 func (db *DB) ToMoveIt(prm ToMoveItPrm) (res ToMoveItRes, err error) {
+	defer util.Elapsed(db.metrics, "ToMoveIt", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -71,6 +74,8 @@ func (db *DB) ToMoveIt(prm ToMoveItPrm) (res ToMoveItRes, err error) {
 
 // DoNotMove removes `MoveIt` mark from the object.
 func (db *DB) DoNotMove(prm DoNotMovePrm) (res DoNotMoveRes, err error) {
+	defer util.Elapsed(db.metrics, "DoNotMove", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -0,0 +1,218 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"strconv"
+
+	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"go.etcd.io/bbolt"
+)
+
+const numericKeySize = 8
+
+var (
+	minNumeric = big.NewInt(math.MinInt64)
+	maxNumeric = big.NewInt(math.MaxInt64)
+)
+
+// numericKey encodes v so that the byte order of keys matches the numeric order.
+func numericKey(v int64) []byte {
+	key := make([]byte, numericKeySize)
+	binary.BigEndian.PutUint64(key, uint64(v)^(1<<63))
+	return key
+}
+
+// parseNumeric parses decimal integer attribute value.
+func parseNumeric(s string) (int64, bool) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	return v, err == nil
+}
+
+// numericValue returns numeric value of the header for the numeric index.
+// Only integer attributes and some of the system headers are indexed.
+func numericValue(key string, objVal []byte) (int64, bool) {
+	switch key {
+	case v2object.FilterHeaderCreationEpoch, v2object.FilterHeaderPayloadLength:
+		if len(objVal) != 8 {
+			return 0, false
+		}
+		u := binary.LittleEndian.Uint64(objVal)
+		return int64(u), u <= math.MaxInt64
+	default:
+		if isSystemKey(key) {
+			return 0, false
+		}
+		return parseNumeric(string(objVal))
+	}
+}
+
+// numericRange returns inclusive bounds of the keys matching the filter.
+// The last return value is false if no key can match.
+func numericRange(op objectSDK.SearchMatchType, fValue string) (int64, int64, bool) {
+	v, ok := new(big.Int).SetString(fValue, 10)
+	if !ok {
+		return 0, 0, false
+	}
+
+	lo, hi := new(big.Int).Set(minNumeric), new(big.Int).Set(maxNumeric)
+	switch op {
+	case objectcore.MatchNumGT:
+		lo.Add(v, big.NewInt(1))
+	case objectcore.MatchNumGE:
+		lo.Set(v)
+	case objectcore.MatchNumLT:
+		hi.Sub(v, big.NewInt(1))
+	case objectcore.MatchNumLE:
+		hi.Set(v)
+	default:
+		return 0, 0, false
+	}
+
+	if lo.Cmp(minNumeric) < 0 {
+		lo.Set(minNumeric)
+	}
+	if hi.Cmp(maxNumeric) > 0 {
+		hi.Set(maxNumeric)
+	}
+	if lo.Cmp(hi) > 0 {
+		return 0, 0, false
+	}
+	return lo.Int64(), hi.Int64(), true
+}
+
+func numericMatcher(op objectSDK.SearchMatchType) matcher {
+	return matcher{
+		matchSlow: func(key string, objVal []byte, fValue string) bool {
+			v, ok := numericValue(key, objVal)
+			if !ok {
+				return false
+			}
+			lo, hi, ok := numericRange(op, fValue)
+			return ok && lo <= v && v <= hi
+		},
+		matchBucket: func(b *bbolt.Bucket, _ string, fValue string, f func([]byte, []byte) error) error {
+			lo, hi, ok := numericRange(op, fValue)
+			if !ok {
+				return nil
+			}
+
+			hiKey := numericKey(hi)
+			c := b.Cursor()
+			for k, v := c.Seek(numericKey(lo)); k != nil && bytes.Compare(k, hiKey) <= 0; k, v = c.Next() {
+				if err := f(k, v); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// updateNumericIndexes updates numeric indexes of the integer attributes,
+// creation epoch and payload length of the object.
+func updateNumericIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFunc) error {
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+	objKey := objectKey(id, make([]byte, objectKeySize))
+
+	raw := make([]byte, 8)
+	key := make([]byte, bucketKeySize)
+	update := func(hdr string, val []byte) error {
+		v, ok := numericValue(hdr, val)
+		if !ok {
+			return nil
+		}
+		return f(tx, namedBucketItem{
+			name: numericBucketName(cnr, hdr, key),
+			key:  numericKey(v),
+			val:  objKey,
+		})
+	}
+
+	binary.LittleEndian.PutUint64(raw, obj.CreationEpoch())
+	if err := update(v2object.FilterHeaderCreationEpoch, raw); err != nil {
+		return err
+	}
+
+	binary.LittleEndian.PutUint64(raw, obj.PayloadSize())
+	if err := update(v2object.FilterHeaderPayloadLength, raw); err != nil {
+		return err
+	}
+
+	attrs := obj.Attributes()
+	for i := range attrs {
+		if err := update(attrs[i].Key(), []byte(attrs[i].Value())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addNumericIndexes builds numeric indexes for at most limit objects stored
+// in the metabase of version 2 starting after the object of the from marker
+// (from the first object if it is nil). Returns the marker of the last indexed
+// object or nil if all the objects are indexed.
+func addNumericIndexes(tx *bbolt.Tx, from []byte, limit int) ([]byte, error) {
+	var fromName, fromKey []byte
+	if len(from) > bucketKeySize {
+		fromName, fromKey = from[:bucketKeySize], from[bucketKeySize:]
+	}
+
+	// buckets are not created while iterating over them
+	var names [][]byte
+	err := tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+		if len(name) == bucketKeySize && bytes.Compare(name, fromName) >= 0 {
+			switch name[0] {
+			case primaryPrefix, tombstonePrefix, storageGroupPrefix, lockersPrefix:
+				names = append(names, slice.Copy(name))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var n int
+	for i := range names {
+		c := tx.Bucket(names[i]).Cursor()
+
+		k, v := c.First()
+		if bytes.Equal(names[i], fromName) {
+			k, v = c.Seek(fromKey)
+			if bytes.Equal(k, fromKey) {
+				k, v = c.Next()
+			}
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(v); err != nil {
+				return nil, err
+			}
+
+			if err := updateNumericIndexes(tx, obj, putFKBTIndexItem); err != nil {
+				return nil, err
+			}
+
+			if par := obj.Parent(); par != nil {
+				if _, ok := par.ID(); ok {
+					if err := updateNumericIndexes(tx, par, putFKBTIndexItem); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			if n++; n == limit {
+				return append(slice.Copy(names[i]), k...), nil
+			}
+		}
+	}
+	return nil, nil
+}
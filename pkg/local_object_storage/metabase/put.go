@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -10,10 +11,13 @@ import (
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/nspcc-dev/neo-go/pkg/io"
 	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type (
@@ -52,7 +56,15 @@ var (
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been placed in graveyard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (db *DB) Put(prm PutPrm) (res PutRes, err error) {
+func (db *DB) Put(ctx context.Context, prm PutPrm) (res PutRes, err error) {
+	defer util.Elapsed(db.metrics, "Put", &err)()
+
+	_, span := tracing.StartSpanFromContext(ctx, "metabase.Put",
+		trace.WithAttributes(
+			attribute.String("address", objectCore.AddressOf(prm.obj).EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -325,7 +337,7 @@ func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFun
 		}
 	}
 
-	return nil
+	return updateNumericIndexes(tx, obj, f)
 }
 
 func putUniqueIndexItem(tx *bbolt.Tx, item namedBucketItem) error {
@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"context"
 	"runtime"
 	"strconv"
 	"testing"
@@ -116,7 +117,7 @@ func metaPut(db *meta.DB, obj *objectSDK.Object, id []byte) error {
 	putPrm.SetObject(obj)
 	putPrm.SetStorageID(id)
 
-	_, err := db.Put(putPrm)
+	_, err := db.Put(context.Background(), putPrm)
 
 	return err
 }
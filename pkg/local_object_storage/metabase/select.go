@@ -1,12 +1,16 @@
 package meta
 
 import (
+	"bytes"
+	"container/heap"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
 
 	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -32,11 +36,16 @@ type (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	limit  int
+	cursor *oid.ID
+	attrs  []string
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	attrList [][]*string
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -49,13 +58,42 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetLimit is a Select option to limit the number of the selected objects.
+// Zero limit means no limit.
+func (p *SelectPrm) SetLimit(n int) {
+	p.limit = n
+}
+
+// SetCursor is a Select option to select only the objects
+// with identifiers greater than the cursor.
+func (p *SelectPrm) SetCursor(id oid.ID) {
+	p.cursor = &id
+}
+
+// SetAttributes is a Select option to return values
+// of the specified attributes of the selected objects.
+func (p *SelectPrm) SetAttributes(keys ...string) {
+	p.attrs = keys
+}
+
 // AddressList returns list of addresses of the selected objects.
+//
+// Addresses are sorted by object identifiers.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// AttributeList returns values of the requested attributes of the selected objects.
+// The i-th list corresponds to the i-th address and contains values in
+// the order of the requested keys, values of missing attributes are nil.
+func (r SelectRes) AttributeList() [][]*string {
+	return r.attrList
+}
+
 // Select returns list of addresses of objects that match search filters.
 func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
+	defer util.Elapsed(db.metrics, "Select", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -70,14 +108,20 @@ func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	currEpoch := db.epochState.CurrentEpoch()
 
 	return res, db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+		res.addrList, err = db.selectObjects(tx, prm, currEpoch)
+		if err != nil || len(prm.attrs) == 0 {
+			return err
+		}
 
-		return err
+		res.attrList = db.selectAttributes(tx, res.addrList, prm.attrs, currEpoch)
+		return nil
 	})
 }
 
-func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64) ([]oid.Address, error) {
-	group, err := groupFilters(fs)
+func (db *DB) selectObjects(tx *bbolt.Tx, prm SelectPrm, currEpoch uint64) ([]oid.Address, error) {
+	cnr := prm.cnr
+
+	group, err := groupFilters(prm.filters, !db.noNumericIndexes)
 	if err != nil {
 		return nil, err
 	}
@@ -88,33 +132,19 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		return nil, nil
 	}
 
-	// keep matched addresses in this cache
-	// value equal to number (index+1) of latest matched filter
-	mAddr := make(map[string]int)
-
-	expLen := len(group.fastFilters) // expected value of matched filters in mAddr
-
-	if len(group.fastFilters) == 0 {
-		expLen = 1
-
-		db.selectAll(tx, cnr, mAddr)
-	} else {
-		for i := range group.fastFilters {
-			db.selectFastFilter(tx, cnr, group.fastFilters[i], mAddr, i)
-		}
+	var after string
+	if prm.cursor != nil {
+		after = string(prm.cursor[:])
 	}
 
-	res := make([]oid.Address, 0, len(mAddr))
-
-	for a, ind := range mAddr {
-		if ind != expLen {
-			continue // ignore objects with unmatched fast filters
-		}
+	var res []oid.Address
 
+	// match checks the object and appends it to the result,
+	// returns false if the limit is reached
+	match := func(a string) (bool, error) {
 		var id oid.ID
-		err = id.Decode([]byte(a))
-		if err != nil {
-			return nil, err
+		if err := id.Decode([]byte(a)); err != nil {
+			return false, err
 		}
 
 		var addr oid.Address
@@ -122,32 +152,161 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		addr.SetObject(id)
 
 		if objectStatus(tx, addr, currEpoch) > 0 {
-			continue // ignore removed objects
+			return true, nil // ignore removed objects
 		}
 
 		if !db.matchSlowFilters(tx, addr, group.slowFilters, currEpoch) {
-			continue // ignore objects with unmatched slow filters
+			return true, nil // ignore objects with unmatched slow filters
 		}
 
 		res = append(res, addr)
+
+		return prm.limit <= 0 || len(res) < prm.limit, nil
+	}
+
+	if len(group.fastFilters) == 0 {
+		// all the objects are iterated in order, so go from
+		// the cursor and stop when the limit is reached
+		err = selectAllAfter(tx, cnr, []byte(after), func(k []byte) (bool, error) {
+			return match(string(k))
+		})
+		return res, err
+	}
+
+	// keep matched addresses in this cache
+	// value equal to number (index+1) of latest matched filter
+	mAddr := &addrCache{m: make(map[string]int), after: after}
+
+	for i := range group.fastFilters {
+		db.selectFastFilter(tx, cnr, group.fastFilters[i], mAddr, i)
+	}
+
+	keys := make(sortedKeys, 0, len(mAddr.m))
+	for a, ind := range mAddr.m {
+		if ind == len(group.fastFilters) { // ignore objects with unmatched fast filters
+			keys = append(keys, a)
+		}
+	}
+
+	// return the results in a deterministic order to continue from the cursor,
+	// the keys are popped in order, so only the returned ones are sorted
+	heap.Init(&keys)
+
+	for keys.Len() > 0 {
+		next, err := match(heap.Pop(&keys).(string))
+		if err != nil {
+			return nil, err
+		}
+		if !next {
+			break
+		}
 	}
 
 	return res, nil
 }
 
-// selectAll adds to resulting cache all available objects in metabase.
-func (db *DB) selectAll(tx *bbolt.Tx, cnr cid.ID, to map[string]int) {
-	bucketName := make([]byte, bucketKeySize)
-	selectAllFromBucket(tx, primaryBucketName(cnr, bucketName), to, 0)
-	selectAllFromBucket(tx, tombstoneBucketName(cnr, bucketName), to, 0)
-	selectAllFromBucket(tx, storageGroupBucketName(cnr, bucketName), to, 0)
-	selectAllFromBucket(tx, parentBucketName(cnr, bucketName), to, 0)
-	selectAllFromBucket(tx, bucketNameLockers(cnr, bucketName), to, 0)
+// sortedKeys is a min-heap of the object keys.
+type sortedKeys []string
+
+func (x sortedKeys) Len() int           { return len(x) }
+func (x sortedKeys) Less(i, j int) bool { return x[i] < x[j] }
+func (x sortedKeys) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+func (x *sortedKeys) Push(v interface{}) { *x = append(*x, v.(string)) }
+
+func (x *sortedKeys) Pop() interface{} {
+	old := *x
+	v := old[len(old)-1]
+	*x = old[:len(old)-1]
+	return v
+}
+
+// selectAttributes returns values of the attributes of the objects.
+func (db *DB) selectAttributes(tx *bbolt.Tx, addrs []oid.Address, keys []string, currEpoch uint64) [][]*string {
+	buf := make([]byte, addressKeySize)
+	res := make([][]*string, len(addrs))
+
+	for i := range addrs {
+		res[i] = make([]*string, len(keys))
+
+		obj, err := db.get(tx, addrs[i], buf, false, false, currEpoch)
+		if err != nil {
+			continue
+		}
+
+		attrs := obj.Attributes()
+		for j := range keys {
+			for k := range attrs {
+				if attrs[k].Key() == keys[j] {
+					val := attrs[k].Value()
+					res[i][j] = &val
+					break
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// selectAllAfter calls f for the keys of all available objects in metabase
+// greater than after in ascending order until f returns false or an error.
+func selectAllAfter(tx *bbolt.Tx, cnr cid.ID, after []byte, f func([]byte) (bool, error)) error {
+	var (
+		cursors []*bbolt.Cursor
+		keys    [][]byte
+	)
+
+	for _, name := range [][]byte{
+		primaryBucketName(cnr, make([]byte, bucketKeySize)),
+		tombstoneBucketName(cnr, make([]byte, bucketKeySize)),
+		storageGroupBucketName(cnr, make([]byte, bucketKeySize)),
+		parentBucketName(cnr, make([]byte, bucketKeySize)),
+		bucketNameLockers(cnr, make([]byte, bucketKeySize)),
+	} {
+		bkt := tx.Bucket(name)
+		if bkt == nil {
+			continue
+		}
+
+		c := bkt.Cursor()
+		k, _ := c.Seek(after)
+		if k != nil && bytes.Equal(k, after) {
+			k, _ = c.Next()
+		}
+
+		cursors = append(cursors, c)
+		keys = append(keys, k)
+	}
+
+	for {
+		var min []byte
+		for i := range keys {
+			if keys[i] != nil && (min == nil || bytes.Compare(keys[i], min) < 0) {
+				min = keys[i]
+			}
+		}
+		if min == nil {
+			return nil
+		}
+
+		next, err := f(min)
+		if err != nil || !next {
+			return err
+		}
+
+		// the same object can be indexed in several buckets
+		for i := range keys {
+			if keys[i] != nil && bytes.Equal(keys[i], min) {
+				keys[i], _ = cursors[i].Next()
+			}
+		}
+	}
 }
 
 // selectAllFromBucket goes through all keys in bucket and adds them in a
 // resulting cache. Keys should be stringed object ids.
-func selectAllFromBucket(tx *bbolt.Tx, name []byte, to map[string]int, fNum int) {
+func selectAllFromBucket(tx *bbolt.Tx, name []byte, to *addrCache, fNum int) {
 	bkt := tx.Bucket(name)
 	if bkt == nil {
 		return
@@ -166,11 +325,17 @@ func (db *DB) selectFastFilter(
 	tx *bbolt.Tx,
 	cnr cid.ID, // container we search on
 	f object.SearchFilter, // fast filter
-	to map[string]int, // resulting cache
+	to *addrCache, // resulting cache
 	fNum int, // index of filter
 ) {
 	currEpoch := db.epochState.CurrentEpoch()
 	bucketName := make([]byte, bucketKeySize)
+
+	if objectcore.IsNumericMatch(f.Operation()) {
+		db.selectFromFKBT(tx, numericBucketName(cnr, f.Header(), bucketName), f, to, fNum)
+		return
+	}
+
 	switch f.Header() {
 	case v2object.FilterHeaderObjectID:
 		db.selectObjectID(tx, f, cnr, to, fNum, currEpoch)
@@ -262,7 +427,7 @@ func (db *DB) selectFromFKBT(
 	tx *bbolt.Tx,
 	name []byte, // fkbt root bucket name
 	f object.SearchFilter, // filter for operation and value
-	to map[string]int, // resulting cache
+	to *addrCache, // resulting cache
 	fNum int, // index of filter
 ) { //
 	matchFunc, ok := db.matchers[f.Operation()]
@@ -300,7 +465,7 @@ func selectOutsideFKBT(
 	tx *bbolt.Tx,
 	incl [][]byte, // buckets
 	name []byte, // fkbt root bucket name
-	to map[string]int, // resulting cache
+	to *addrCache, // resulting cache
 	fNum int, // index of filter
 ) {
 	mExcl := make(map[string]struct{})
@@ -343,7 +508,7 @@ func (db *DB) selectFromList(
 	tx *bbolt.Tx,
 	name []byte, // list root bucket name
 	f object.SearchFilter, // filter for operation and value
-	to map[string]int, // resulting cache
+	to *addrCache, // resulting cache
 	fNum int, // index of filter
 ) { //
 	bkt := tx.Bucket(name)
@@ -403,7 +568,7 @@ func (db *DB) selectObjectID(
 	tx *bbolt.Tx,
 	f object.SearchFilter,
 	cnr cid.ID,
-	to map[string]int, // resulting cache
+	to *addrCache, // resulting cache
 	fNum int, // index of filter
 	currEpoch uint64,
 ) {
@@ -492,7 +657,16 @@ func (db *DB) matchSlowFilters(tx *bbolt.Tx, addr oid.Address, f object.SearchFi
 			data = make([]byte, 8)
 			binary.LittleEndian.PutUint64(data, obj.PayloadSize())
 		default:
-			continue // ignore unknown search attributes
+			if !objectcore.IsNumericMatch(f[i].Operation()) {
+				continue // ignore unknown search attributes
+			}
+
+			// numeric filter without numeric indexes
+			attr, ok := objectAttribute(obj, f[i].Header())
+			if !ok {
+				return false
+			}
+			data = []byte(attr)
 		}
 
 		if !matchFunc.matchSlow(f[i].Header(), data, f[i].Value()) {
@@ -503,16 +677,37 @@ func (db *DB) matchSlowFilters(tx *bbolt.Tx, addr oid.Address, f object.SearchFi
 	return true
 }
 
+// objectAttribute returns the value of the object attribute with the key.
+func objectAttribute(obj *object.Object, key string) (string, bool) {
+	attrs := obj.Attributes()
+	for i := range attrs {
+		if attrs[i].Key() == key {
+			return attrs[i].Value(), true
+		}
+	}
+	return "", false
+}
+
 // groupFilters divides filters in two groups: fast and slow. Fast filters
 // processed by indexes and slow filters processed after by unmarshaling
-// object headers.
-func groupFilters(filters object.SearchFilters) (filterGroup, error) {
+// object headers. Numeric filters are slow if there are no numeric indexes.
+func groupFilters(filters object.SearchFilters, numericIndexes bool) (filterGroup, error) {
 	res := filterGroup{
 		fastFilters: make(object.SearchFilters, 0, len(filters)),
 		slowFilters: make(object.SearchFilters, 0, len(filters)),
 	}
 
 	for i := range filters {
+		if objectcore.IsNumericMatch(filters[i].Operation()) {
+			// numeric filters are processed by numeric indexes for all headers
+			if numericIndexes {
+				res.fastFilters = append(res.fastFilters, filters[i])
+			} else {
+				res.slowFilters = append(res.slowFilters, filters[i])
+			}
+			continue
+		}
+
 		switch filters[i].Header() {
 		case v2object.FilterHeaderContainerID: // support deprecated field
 			err := res.cnr.DecodeString(filters[i].Value())
@@ -535,9 +730,21 @@ func groupFilters(filters object.SearchFilters) (filterGroup, error) {
 	return res, nil
 }
 
-func markAddressInCache(cache map[string]int, fNum int, addr string) {
-	if num := cache[addr]; num == fNum {
-		cache[addr] = num + 1
+// addrCache keeps the matched addresses with the number (index+1)
+// of the latest matched filter. Addresses not greater than the cursor
+// are skipped.
+type addrCache struct {
+	m     map[string]int
+	after string
+}
+
+func markAddressInCache(cache *addrCache, fNum int, addr string) {
+	if fNum == 0 && addr <= cache.after {
+		return
+	}
+
+	if num := cache.m[addr]; num == fNum {
+		cache.m[addr] = num + 1
 	}
 }
 
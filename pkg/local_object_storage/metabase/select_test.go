@@ -1,7 +1,9 @@
 package meta_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -526,6 +528,75 @@ func TestDB_SelectWithSlowFilters(t *testing.T) {
 	})
 }
 
+func TestDB_SelectNumeric(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	raw1 := generateObjectWithCID(t, cnr)
+	raw1.SetPayloadSize(10)
+	raw1.SetCreationEpoch(11)
+	addAttribute(raw1, "Timestamp", "-5")
+	require.NoError(t, putBig(db, raw1))
+
+	raw2 := generateObjectWithCID(t, cnr)
+	raw2.SetPayloadSize(20)
+	raw2.SetCreationEpoch(21)
+	addAttribute(raw2, "Timestamp", "100")
+	require.NoError(t, putBig(db, raw2))
+
+	raw3 := generateObjectWithCID(t, cnr)
+	raw3.SetPayloadSize(30)
+	raw3.SetCreationEpoch(31)
+	addAttribute(raw3, "Timestamp", "not a number")
+	require.NoError(t, putBig(db, raw3))
+
+	addr1, addr2, addr3 := object.AddressOf(raw1), object.AddressOf(raw2), object.AddressOf(raw3)
+
+	for _, tc := range []struct {
+		key   string
+		op    objectSDK.SearchMatchType
+		value string
+		exp   []oid.Address
+	}{
+		{"Timestamp", object.MatchNumGT, "-5", []oid.Address{addr2}},
+		{"Timestamp", object.MatchNumGE, "-5", []oid.Address{addr1, addr2}},
+		{"Timestamp", object.MatchNumLT, "100", []oid.Address{addr1}},
+		{"Timestamp", object.MatchNumLE, "100", []oid.Address{addr1, addr2}},
+		{"Timestamp", object.MatchNumLT, "-100", nil},
+		{"Timestamp", object.MatchNumGT, "99999999999999999999", nil},
+		{"Timestamp", object.MatchNumLT, "99999999999999999999", []oid.Address{addr1, addr2}},
+		{"Timestamp", object.MatchNumGT, "-99999999999999999999", []oid.Address{addr1, addr2}},
+		{"Timestamp", object.MatchNumGT, "abc", nil},
+		{"Unknown", object.MatchNumGT, "0", nil},
+		{v2object.FilterHeaderPayloadLength, object.MatchNumGT, "10", []oid.Address{addr2, addr3}},
+		{v2object.FilterHeaderPayloadLength, object.MatchNumLE, "20", []oid.Address{addr1, addr2}},
+		{v2object.FilterHeaderCreationEpoch, object.MatchNumGE, "21", []oid.Address{addr2, addr3}},
+		{v2object.FilterHeaderCreationEpoch, object.MatchNumLT, "21", []oid.Address{addr1}},
+		{v2object.FilterHeaderVersion, object.MatchNumGT, "0", nil},
+	} {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(tc.key, tc.value, tc.op)
+		testSelect(t, db, cnr, fs, tc.exp...)
+	}
+
+	t.Run("combined with other filters", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderPayloadLength, "10", object.MatchNumGT)
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "31", object.MatchNumLT)
+		fs.AddFilter("Timestamp", "100", objectSDK.MatchStringEqual)
+		testSelect(t, db, cnr, fs, addr2)
+	})
+
+	t.Run("deleted object", func(t *testing.T) {
+		require.NoError(t, metaDelete(db, addr2))
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("Timestamp", "0", object.MatchNumGT)
+		testSelect(t, db, cnr, fs)
+	})
+}
+
 func TestDB_SelectObjectID(t *testing.T) {
 	db := newDB(t)
 
@@ -766,6 +837,133 @@ func TestDB_SelectContainerID(t *testing.T) {
 	})
 }
 
+func TestDB_SelectLimitCursor(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	const objCount = 10
+	addrs := make([]oid.Address, objCount)
+	for i := range addrs {
+		raw := generateObjectWithCID(t, cnr)
+		addAttribute(raw, "foo", strconv.Itoa(i))
+		if i%2 == 0 {
+			addAttribute(raw, "even", "true")
+		}
+		require.NoError(t, putBig(db, raw))
+		addrs[i] = object.AddressOf(raw)
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		a, b := addrs[i].Object(), addrs[j].Object()
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+
+	var prm meta.SelectPrm
+	prm.SetContainerID(cnr)
+
+	res, err := db.Select(prm)
+	require.NoError(t, err)
+	require.Equal(t, addrs, res.AddressList())
+	require.Nil(t, res.AttributeList())
+
+	t.Run("pages", func(t *testing.T) {
+		var all []oid.Address
+
+		prm := prm
+		prm.SetLimit(3)
+		for {
+			res, err := db.Select(prm)
+			require.NoError(t, err)
+
+			page := res.AddressList()
+			require.LessOrEqual(t, len(page), 3)
+			if len(page) == 0 {
+				break
+			}
+
+			all = append(all, page...)
+			prm.SetCursor(page[len(page)-1].Object())
+		}
+		require.Equal(t, addrs, all)
+	})
+
+	t.Run("with filters", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("even", "true", objectSDK.MatchStringEqual)
+
+		prm := prm
+		prm.SetFilters(fs)
+		prm.SetLimit(2)
+		prm.SetCursor(addrs[0].Object())
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		require.Len(t, res.AddressList(), 2)
+		for _, addr := range res.AddressList() {
+			require.NotEqual(t, addrs[0], addr)
+		}
+	})
+
+	t.Run("pages with filters", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("even", "true", objectSDK.MatchStringEqual)
+
+		prm := prm
+		prm.SetFilters(fs)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		even := res.AddressList()
+		require.Len(t, even, objCount/2)
+
+		var all []oid.Address
+
+		prm.SetLimit(2)
+		for {
+			res, err := db.Select(prm)
+			require.NoError(t, err)
+
+			page := res.AddressList()
+			if len(page) == 0 {
+				break
+			}
+
+			all = append(all, page...)
+			prm.SetCursor(page[len(page)-1].Object())
+		}
+		require.Equal(t, even, all)
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		prm := prm
+		prm.SetAttributes("even", "foo", "missing")
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		require.Equal(t, addrs, res.AddressList())
+		require.Len(t, res.AttributeList(), objCount)
+
+		seen := make(map[string]struct{})
+		for _, vals := range res.AttributeList() {
+			require.Len(t, vals, 3)
+			require.Nil(t, vals[2])
+			require.NotNil(t, vals[1])
+
+			i, err := strconv.Atoi(*vals[1])
+			require.NoError(t, err)
+			if i%2 == 0 {
+				require.NotNil(t, vals[0])
+				require.Equal(t, "true", *vals[0])
+			} else {
+				require.Nil(t, vals[0])
+			}
+			seen[*vals[1]] = struct{}{}
+		}
+		require.Len(t, seen, objCount)
+	})
+}
+
 func BenchmarkSelect(b *testing.B) {
 	const objCount = 1000
 	db := newDB(b)
@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"go.etcd.io/bbolt"
@@ -29,6 +30,8 @@ func (r StorageIDRes) StorageID() []byte {
 // StorageID returns storage descriptor for objects from the blobstor.
 // It is put together with the object can makes get/delete operation faster.
 func (db *DB) StorageID(prm StorageIDPrm) (res StorageIDRes, err error) {
+	defer util.Elapsed(db.metrics, "StorageID", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -81,6 +84,8 @@ func (p *UpdateStorageIDPrm) SetStorageID(id []byte) {
 
 // UpdateStorageID updates storage descriptor for objects from the blobstor.
 func (db *DB) UpdateStorageID(prm UpdateStorageIDPrm) (res UpdateStorageIDRes, err error) {
+	defer util.Elapsed(db.metrics, "UpdateStorageID", &err)()
+
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
@@ -111,6 +111,16 @@ const (
 	//  Key: split ID
 	//  Value: list of object IDs
 	splitPrefix
+
+	//=======================
+	// Numeric index buckets.
+	//=======================
+
+	// numericPrefix is used for prefixing FKBT index buckets containing objects
+	// with integer attribute or system header values.
+	// Key: value as big-endian int64 with the sign bit flipped
+	// Value: bucket containing object IDs as keys
+	numericPrefix
 )
 
 const (
@@ -155,6 +165,13 @@ func attributeBucketName(cnr cid.ID, attributeKey string, key []byte) []byte {
 	return append(key[:bucketKeySize], attributeKey...)
 }
 
+// numericBucketName returns <CID>_num_<attributeKey>.
+func numericBucketName(cnr cid.ID, attributeKey string, key []byte) []byte {
+	key[0] = numericPrefix
+	cnr.Encode(key[1:])
+	return append(key[:bucketKeySize], attributeKey...)
+}
+
 // returns <CID> from attributeBucketName result, nil otherwise.
 func cidFromAttributeBucket(val []byte, attributeKey string) []byte {
 	if len(val) < bucketKeySize || val[0] != userAttributePrefix || !bytes.Equal(val[bucketKeySize:], []byte(attributeKey)) {
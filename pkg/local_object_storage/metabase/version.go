@@ -9,7 +9,7 @@ import (
 )
 
 // version contains current metabase version.
-const version = 2
+const version = 3
 
 var versionKey = []byte("version")
 
@@ -18,6 +18,62 @@ var versionKey = []byte("version")
 // the current code version.
 var ErrOutdatedVersion = logicerr.New("invalid version, resynchronization is required")
 
+// numericUpgradeKey is the key of the last object indexed by the
+// interrupted upgrade from version 2.
+var numericUpgradeKey = []byte("numeric_upgrade")
+
+// numericUpgradeBatchSize is the number of objects indexed in a single
+// transaction on upgrade from version 2.
+const numericUpgradeBatchSize = 1000
+
+// storedVersion returns the version of the metabase, zero if it is unknown.
+func storedVersion(tx *bbolt.Tx) uint64 {
+	b := tx.Bucket(shardInfoBucket)
+	if b == nil {
+		return 0
+	}
+
+	data := b.Get(versionKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(data)
+}
+
+// upgradeFromV2 builds numeric indexes of the metabase of version 2
+// (version 3 only adds them) and updates the version. Objects are indexed
+// in batches, each batch in a separate transaction. The progress is saved,
+// so the interrupted upgrade continues from the last indexed object.
+func (db *DB) upgradeFromV2() error {
+	for done := false; !done; {
+		err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+			if storedVersion(tx) != 2 {
+				done = true
+				return nil
+			}
+
+			b := tx.Bucket(shardInfoBucket)
+			next, err := addNumericIndexes(tx, b.Get(numericUpgradeKey), numericUpgradeBatchSize)
+			if err != nil {
+				return err
+			}
+			if next != nil {
+				return b.Put(numericUpgradeKey, next)
+			}
+
+			done = true
+			if err := b.Delete(numericUpgradeKey); err != nil {
+				return err
+			}
+			return updateVersion(tx, version)
+		})
+		if err != nil {
+			return fmt.Errorf("can't upgrade metabase from version 2: %w", err)
+		}
+	}
+	return nil
+}
+
 func checkVersion(tx *bbolt.Tx, initialized bool) error {
 	var knownVersion bool
 
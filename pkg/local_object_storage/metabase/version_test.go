@@ -1,12 +1,22 @@
 package meta
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
 
+	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	checksumtest "github.com/TrueCloudLab/frostfs-sdk-go/checksum/test"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
 )
@@ -84,4 +94,104 @@ func TestVersion(t *testing.T) {
 			require.NoError(t, db.Close())
 		})
 	})
+	t.Run("upgrade from version 2", func(t *testing.T) {
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+
+		var attr objectSDK.Attribute
+		attr.SetKey("Timestamp")
+		attr.SetValue("42")
+
+		cnr := cidtest.ID()
+		objs := make([]*objectSDK.Object, 3)
+		for i := range objs {
+			objs[i] = objectSDK.New()
+			objs[i].SetID(oidtest.ID())
+			objs[i].SetContainerID(cnr)
+			objs[i].SetOwnerID(usertest.ID())
+			objs[i].SetPayloadChecksum(checksumtest.Checksum())
+			objs[i].SetPayloadSize(10)
+			objs[i].SetAttributes(attr)
+
+			var prm PutPrm
+			prm.SetObject(objs[i])
+			_, err := db.Put(context.Background(), prm)
+			require.NoError(t, err)
+		}
+
+		// Drop numeric indexes the way they were absent in version 2.
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			var names [][]byte
+			_ = tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+				if name[0] == numericPrefix {
+					names = append(names, slice.Copy(name))
+				}
+				return nil
+			})
+			require.Equal(t, 3, len(names))
+			for i := range names {
+				require.NoError(t, tx.DeleteBucket(names[i]))
+			}
+			return updateVersion(tx, 2)
+		}))
+
+		// Index a single object the way the interrupted upgrade does.
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			next, err := addNumericIndexes(tx, nil, 1)
+			require.NoError(t, err)
+			require.NotNil(t, next)
+			return tx.Bucket(shardInfoBucket).Put(numericUpgradeKey, next)
+		}))
+		require.NoError(t, db.Close())
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("Timestamp", "40", objectcore.MatchNumGT)
+		fs.AddFilter(v2object.FilterHeaderPayloadLength, "10", objectcore.MatchNumLE)
+
+		var sPrm SelectPrm
+		sPrm.SetContainerID(cnr)
+		sPrm.SetFilters(fs)
+
+		expected := []oid.Address{
+			objectcore.AddressOf(objs[0]),
+			objectcore.AddressOf(objs[1]),
+			objectcore.AddressOf(objs[2]),
+		}
+
+		// Numeric filters are matched by headers in read-only mode.
+		require.NoError(t, db.Open(true))
+		res, err := db.Select(sPrm)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected, res.AddressList())
+
+		unmatched := objectSDK.SearchFilters{}
+		unmatched.AddFilter("Timestamp", "42", objectcore.MatchNumLT)
+		unmatched.AddFilter(v2object.FilterHeaderPayloadLength, "10", objectcore.MatchNumLE)
+
+		var uPrm SelectPrm
+		uPrm.SetContainerID(cnr)
+		uPrm.SetFilters(unmatched)
+		res, err = db.Select(uPrm)
+		require.NoError(t, err)
+		require.Empty(t, res.AddressList())
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		check(t, db)
+
+		res, err = db.Select(sPrm)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected, res.AddressList())
+
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Nil(t, tx.Bucket(shardInfoBucket).Get(numericUpgradeKey))
+			return nil
+		}))
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(true))
+		require.NoError(t, db.Close())
+	})
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	storageutil "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
 	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
@@ -29,12 +30,19 @@ type boltForest struct {
 	mtx     sync.Mutex
 	batches []*batch
 
+	// sorted is the set of the attributes put in the sorted children index,
+	// rawSorted is its binary representation stored in the trees.
+	sorted    map[string]struct{}
+	rawSorted []byte
+
 	cfg
 }
 
 var (
-	dataBucket = []byte{0}
-	logBucket  = []byte{1}
+	dataBucket        = []byte{0}
+	logBucket         = []byte{1}
+	snapshotBucket    = []byte{2}
+	rebuildDataBucket = []byte{3}
 )
 
 // ErrDegradedMode is returned when pilorama is in a degraded mode.
@@ -54,18 +62,36 @@ var ErrReadOnlyMode = logicerr.New("pilorama is in a read-only mode")
 // log storage (logBucket):
 // timestamp in big-endian -> log operation
 //
-// tree storage (dataBucket):
+// active tree bucket:
+// - 'd' -> name of the tree storage bucket in use, dataBucket if missing.
+//
+// tree storage (dataBucket or rebuildDataBucket):
 // - 't' + node (id) -> timestamp when the node first appeared,
 // - 'p' + node (id) -> parent (id),
 // - 'm' + node (id) -> serialized meta,
 // - 'c' + parent (id) + child (id) -> 0/1,
-// - 'i' + 0 + attrKey + 0 + attrValue + 0 + parent (id) + node (id) -> 0/1 (1 for automatically created nodes).
+// - 'i' + 0 + attrKey + 0 + attrValue + 0 + parent (id) + node (id) -> 0/1 (1 for automatically created nodes),
+// - 'a' + parent (id) + attrKey + escaped attrValue + node (id, big-endian) -> empty, children sorted by the attribute,
+// - 'h' -> snapshot height, operations below it are removed from the log,
+// - 'v' -> version of the tree indexes,
+// - 'l' -> zero-terminated names of the attributes in the sorted children index,
+// - 'u' -> last node (state key) put in the sorted children index being rebuilt.
+//
+// pending snapshot storage (snapshotBucket):
+// - 's' + node (id) -> parent (id) + timestamp + serialized meta,
+// - 'h' -> height of the snapshot being applied,
+// - 'r' -> empty, present if the tree storage is being rebuilt from the snapshot.
+//
+// The tree is rebuilt from the snapshot in the unused tree storage bucket,
+// which is then made active. The unused tree storage bucket left from
+// an interrupted rebuild or from the previous state is removed in batches.
 func NewBoltForest(opts ...Option) ForestStorage {
 	b := boltForest{
 		cfg: cfg{
 			perm:          os.ModePerm,
 			maxBatchDelay: bbolt.DefaultMaxBatchDelay,
 			maxBatchSize:  bbolt.DefaultMaxBatchSize,
+			metrics:       storageutil.NoopMethodDurationWriter{},
 		},
 	}
 
@@ -73,6 +99,8 @@ func NewBoltForest(opts ...Option) ForestStorage {
 		opts[i](&b.cfg)
 	}
 
+	b.sorted, b.rawSorted = sortedAttributeSet(sortedAttributes)
+
 	return &b
 }
 
@@ -122,7 +150,12 @@ func (t *boltForest) Init() error {
 	if t.mode.NoMetabase() || t.db.IsReadOnly() {
 		return nil
 	}
-	return t.db.Update(func(tx *bbolt.Tx) error {
+
+	// The trees with the outdated indexes and with the unused data
+	// left after the snapshot rebuild.
+	var outdated, unused [][]byte
+
+	err := t.db.Update(func(tx *bbolt.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(dataBucket)
 		if err != nil {
 			return err
@@ -131,8 +164,139 @@ func (t *boltForest) Init() error {
 		if err != nil {
 			return err
 		}
-		return nil
+		return tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			// Skip service buckets.
+			if len(name) < 32 {
+				return nil
+			}
+			if !t.indexesUpdated(treeDataBucket(b)) {
+				outdated = append(outdated, append([]byte(nil), name...))
+			}
+			if b.Bucket(unusedDataBucket(b)) != nil {
+				unused = append(unused, append([]byte(nil), name...))
+			}
+			return nil
+		})
 	})
+	if err != nil {
+		return err
+	}
+
+	for i := range outdated {
+		if err := t.updateIndexes(outdated[i]); err != nil {
+			return fmt.Errorf("can't update indexes of the tree %s: %w", outdated[i][32:], err)
+		}
+	}
+	for i := range unused {
+		if err := t.dropUnusedData(unused[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexBatchSize is the maximum number of the sorted children index keys
+// removed or the tree nodes indexed in a single transaction.
+const indexBatchSize = 10000
+
+// indexesUpdated checks whether the tree indexes are built by the current version
+// for the current set of the sorted attributes.
+func (t *boltForest) indexesUpdated(b *bbolt.Bucket) bool {
+	v := b.Get(indexVersionKey)
+	return len(v) != 0 && v[0] >= indexVersion && bytes.Equal(b.Get(sortedAttributesKey), t.rawSorted)
+}
+
+// updateIndexes builds the indexes missing in the trees created by the previous versions
+// and rebuilds the sorted children index if the set of the sorted attributes is changed.
+// The index is rebuilt in batches and the progress is saved in the tree, so the
+// interrupted rebuild is continued on the next initialization.
+func (t *boltForest) updateIndexes(fullID []byte) error {
+	for done := false; !done; {
+		err := t.db.Update(func(tx *bbolt.Tx) error {
+			var err error
+			done, err = t.updateIndexesBatch(treeDataBucket(tx.Bucket(fullID)))
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateIndexesBatch removes a batch of the outdated sorted children index keys
+// or, when there are none left, indexes a batch of the tree nodes. Returns true
+// when the indexes are updated.
+func (t *boltForest) updateIndexesBatch(b *bbolt.Bucket) (bool, error) {
+	if t.indexesUpdated(b) {
+		return true, nil
+	}
+
+	c := b.Cursor()
+
+	progress := b.Get(indexProgressKey)
+	if progress == nil {
+		for i := 0; i < indexBatchSize; i++ {
+			k, _ := c.Seek([]byte{'a'})
+			if len(k) == 0 || k[0] != 'a' {
+				// The nodes are indexed starting from the first state key.
+				return false, b.Put(indexProgressKey, []byte{'s'})
+			}
+			if err := c.Delete(); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}
+
+	// Keys can't be put while iterating over the bucket, collect them first.
+	var keys [][]byte
+	var last []byte
+
+	k, v := c.Seek(progress)
+	if bytes.Equal(k, progress) {
+		k, v = c.Next()
+	}
+	for i := 0; i < indexBatchSize && len(k) == 9 && k[0] == 's'; i++ {
+		var meta Meta
+		if err := meta.FromBytes(v[16:]); err != nil {
+			return false, err
+		}
+
+		node := binary.LittleEndian.Uint64(k[1:])
+		parent := binary.LittleEndian.Uint64(v)
+		for j := range meta.Items {
+			if t.isSortedIndexed(meta.Items, j) {
+				keys = append(keys, sortedKey(nil, meta.Items[j].Key, meta.Items[j].Value, parent, node))
+			}
+		}
+
+		last = append(last[:0], k...)
+		k, v = c.Next()
+	}
+	done := len(k) != 9 || k[0] != 's'
+
+	for i := range keys {
+		if err := b.Put(keys[i], []byte{}); err != nil {
+			return false, err
+		}
+	}
+
+	if !done {
+		return false, b.Put(indexProgressKey, last)
+	}
+	if err := b.Delete(indexProgressKey); err != nil {
+		return false, err
+	}
+	return true, t.putIndexVersion(b)
+}
+
+// putIndexVersion saves the version of the tree indexes and the sorted attributes.
+func (t *boltForest) putIndexVersion(b *bbolt.Bucket) error {
+	if err := b.Put(indexVersionKey, []byte{indexVersion}); err != nil {
+		return err
+	}
+	return b.Put(sortedAttributesKey, t.rawSorted)
 }
 func (t *boltForest) Close() error {
 	if t.db != nil {
@@ -142,7 +306,9 @@ func (t *boltForest) Close() error {
 }
 
 // TreeMove implements the Forest interface.
-func (t *boltForest) TreeMove(d CIDDescriptor, treeID string, m *Move) (*Move, error) {
+func (t *boltForest) TreeMove(d CIDDescriptor, treeID string, m *Move) (_ *Move, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeMove", &err)()
+
 	if !d.checkValid() {
 		return nil, ErrInvalidCIDDescriptor
 	}
@@ -164,16 +330,24 @@ func (t *boltForest) TreeMove(d CIDDescriptor, treeID string, m *Move) (*Move, e
 			return err
 		}
 
-		lm.Time = t.getLatestTimestamp(bLog, d.Position, d.Size)
-		if lm.Child == RootID {
-			lm.Child = t.findSpareID(bTree)
-		}
-		return t.do(bLog, bTree, make([]byte, 17), &lm)
+		return t.move(bLog, bTree, d, &lm)
 	})
 }
 
+// move applies the operation generated locally, the timestamp and
+// the child ID (if it is RootID) are set in lm.
+func (t *boltForest) move(bLog, bTree *bbolt.Bucket, d CIDDescriptor, lm *Move) error {
+	lm.Time = t.getLatestTimestamp(bLog, bTree, d.Position, d.Size)
+	if lm.Child == RootID {
+		lm.Child = t.findSpareID(bTree)
+	}
+	return t.do(bLog, bTree, make([]byte, 17), lm)
+}
+
 // TreeExists implements the Forest interface.
-func (t *boltForest) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
+func (t *boltForest) TreeExists(cid cidSDK.ID, treeID string) (_ bool, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeExists", &err)()
+
 	t.modeMtx.RLock()
 	defer t.modeMtx.RUnlock()
 
@@ -183,7 +357,7 @@ func (t *boltForest) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 
 	var exists bool
 
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err = t.db.View(func(tx *bbolt.Tx) error {
 		treeRoot := tx.Bucket(bucketName(cid, treeID))
 		exists = treeRoot != nil
 		return nil
@@ -193,7 +367,9 @@ func (t *boltForest) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 }
 
 // TreeAddByPath implements the Forest interface.
-func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string, path []string, meta []KeyValue) ([]Move, error) {
+func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string, path []string, meta []KeyValue) (_ []Move, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeAddByPath", &err)()
+
 	if !d.checkValid() {
 		return nil, ErrInvalidCIDDescriptor
 	}
@@ -211,63 +387,124 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 	}
 
 	var lm []Move
-	var key [17]byte
 
 	fullID := bucketName(d.CID, treeID)
-	err := t.db.Batch(func(tx *bbolt.Tx) error {
+	err = t.db.Batch(func(tx *bbolt.Tx) error {
 		bLog, bTree, err := t.getTreeBuckets(tx, fullID)
 		if err != nil {
 			return err
 		}
 
-		i, node, err := t.getPathPrefix(bTree, attr, path)
-		if err != nil {
-			return err
-		}
-
-		ts := t.getLatestTimestamp(bLog, d.Position, d.Size)
-		lm = make([]Move, len(path)-i+1)
-		for j := i; j < len(path); j++ {
-			lm[j-i] = Move{
-				Parent: node,
-				Meta: Meta{
-					Time:  ts,
-					Items: []KeyValue{{Key: attr, Value: []byte(path[j])}},
-				},
-				Child: t.findSpareID(bTree),
-			}
+		lm, err = t.addByPath(bLog, bTree, d, attr, path, meta)
+		return err
+	})
+	return lm, err
+}
 
-			err := t.do(bLog, bTree, key[:], &lm[j-i])
-			if err != nil {
-				return err
-			}
+// addByPath adds new node with the meta using provided path, missing
+// internal nodes are created. Returns the applied operations.
+func (t *boltForest) addByPath(bLog, bTree *bbolt.Bucket, d CIDDescriptor, attr string, path []string, meta []KeyValue) ([]Move, error) {
+	var key [17]byte
 
-			ts = nextTimestamp(ts, uint64(d.Position), uint64(d.Size))
-			node = lm[j-i].Child
-		}
+	i, node, err := t.getPathPrefix(bTree, attr, path)
+	if err != nil {
+		return nil, err
+	}
 
-		lm[len(lm)-1] = Move{
+	ts := t.getLatestTimestamp(bLog, bTree, d.Position, d.Size)
+	lm := make([]Move, len(path)-i+1)
+	for j := i; j < len(path); j++ {
+		lm[j-i] = Move{
 			Parent: node,
 			Meta: Meta{
 				Time:  ts,
-				Items: meta,
+				Items: []KeyValue{{Key: attr, Value: []byte(path[j])}},
 			},
 			Child: t.findSpareID(bTree),
 		}
-		return t.do(bLog, bTree, key[:], &lm[len(lm)-1])
+
+		err := t.do(bLog, bTree, key[:], &lm[j-i])
+		if err != nil {
+			return nil, err
+		}
+
+		ts = nextTimestamp(ts, uint64(d.Position), uint64(d.Size))
+		node = lm[j-i].Child
+	}
+
+	lm[len(lm)-1] = Move{
+		Parent: node,
+		Meta: Meta{
+			Time:  ts,
+			Items: meta,
+		},
+		Child: t.findSpareID(bTree),
+	}
+	return lm, t.do(bLog, bTree, key[:], &lm[len(lm)-1])
+}
+
+// TreeBatch implements the Forest interface.
+func (t *boltForest) TreeBatch(d CIDDescriptor, treeID string, ops []BatchOperation) (_ [][]Move, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeBatch", &err)()
+
+	if !d.checkValid() {
+		return nil, ErrInvalidCIDDescriptor
+	}
+	for i := range ops {
+		if ops[i].PathAttr != "" && !isAttributeInternal(ops[i].PathAttr) {
+			return nil, ErrNotPathAttribute
+		}
+	}
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+
+	var res [][]Move
+
+	fullID := bucketName(d.CID, treeID)
+	err = t.db.Update(func(tx *bbolt.Tx) error {
+		bLog, bTree, err := t.getTreeBuckets(tx, fullID)
+		if err != nil {
+			return err
+		}
+
+		res = make([][]Move, len(ops))
+		for i := range ops {
+			if ops[i].PathAttr != "" {
+				res[i], err = t.addByPath(bLog, bTree, d, ops[i].PathAttr, ops[i].Path, ops[i].Items)
+			} else {
+				res[i] = []Move{ops[i].Move}
+				err = t.move(bLog, bTree, d, &res[i][0])
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	})
-	return lm, err
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // getLatestTimestamp returns timestamp for a new operation which is guaranteed to be bigger than
 // all timestamps corresponding to already stored operations.
-func (t *boltForest) getLatestTimestamp(bLog *bbolt.Bucket, pos, size int) uint64 {
+func (t *boltForest) getLatestTimestamp(bLog, bTree *bbolt.Bucket, pos, size int) uint64 {
 	var ts uint64
 
 	c := bLog.Cursor()
 	key, _ := c.Last()
 	if len(key) != 0 {
 		ts = binary.BigEndian.Uint64(key)
+	} else if h := getSnapshotHeight(bTree); h != 0 {
+		ts = h - 1
 	}
 	return nextTimestamp(ts, uint64(pos), uint64(size))
 }
@@ -287,7 +524,9 @@ func (t *boltForest) findSpareID(bTree *bbolt.Bucket) uint64 {
 }
 
 // TreeApply implements the Forest interface.
-func (t *boltForest) TreeApply(d CIDDescriptor, treeID string, m *Move, backgroundSync bool) error {
+func (t *boltForest) TreeApply(d CIDDescriptor, treeID string, m *Move, backgroundSync bool) (err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeApply", &err)()
+
 	if !d.checkValid() {
 		return ErrInvalidCIDDescriptor
 	}
@@ -313,7 +552,7 @@ func (t *boltForest) TreeApply(d CIDDescriptor, treeID string, m *Move, backgrou
 
 			var logKey [8]byte
 			binary.BigEndian.PutUint64(logKey[:], m.Time)
-			seen = b.Get(logKey[:]) != nil
+			seen = b.Get(logKey[:]) != nil || m.Time < getSnapshotHeight(treeDataBucket(treeRoot))
 			return nil
 		})
 		if err != nil || seen {
@@ -385,7 +624,7 @@ func (t *boltForest) addBatch(d CIDDescriptor, treeID string, m *Move, ch chan e
 func (t *boltForest) getTreeBuckets(tx *bbolt.Tx, treeRoot []byte) (*bbolt.Bucket, *bbolt.Bucket, error) {
 	child := tx.Bucket(treeRoot)
 	if child != nil {
-		return child.Bucket(logBucket), child.Bucket(dataBucket), nil
+		return child.Bucket(logBucket), treeDataBucket(child), nil
 	}
 
 	child, err := tx.CreateBucket(treeRoot)
@@ -400,6 +639,9 @@ func (t *boltForest) getTreeBuckets(tx *bbolt.Tx, treeRoot []byte) (*bbolt.Bucke
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := t.putIndexVersion(bData); err != nil {
+		return nil, nil, err
+	}
 	return bLog, bData, nil
 }
 
@@ -408,6 +650,15 @@ func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, ms []*M
 	var tmp Move
 	var cKey [17]byte
 
+	// Operations below the snapshot height are already included in the snapshot.
+	height := getSnapshotHeight(treeBucket)
+	for len(ms) != 0 && ms[0].Time < height {
+		ms = ms[1:]
+	}
+	if len(ms) == 0 {
+		return nil
+	}
+
 	c := logBucket.Cursor()
 
 	key, value := c.Last()
@@ -494,19 +745,32 @@ func (t *boltForest) redo(b *bbolt.Bucket, key []byte, op *Move, rawMeta []byte)
 		if err := meta.FromBytes(currMeta); err != nil {
 			return err
 		}
-		for i := range meta.Items {
-			if isAttributeInternal(meta.Items[i].Key) {
-				key = internalKey(key, meta.Items[i].Key, string(meta.Items[i].Value), parent, op.Child)
-				err := b.Delete(key)
-				if err != nil {
-					return err
-				}
-			}
+		if err := t.removeIndexes(b, key, op.Child, parent, meta); err != nil {
+			return err
 		}
 	}
 	return t.addNode(b, key, op.Child, op.Parent, ts, op.Meta, rawMeta)
 }
 
+// removeIndexes removes the index keys of the node attributes.
+func (t *boltForest) removeIndexes(b *bbolt.Bucket, key []byte, node, parent Node, meta Meta) error {
+	for i := range meta.Items {
+		if isAttributeInternal(meta.Items[i].Key) {
+			key = internalKey(key, meta.Items[i].Key, string(meta.Items[i].Value), parent, node)
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+		}
+		if t.isSortedIndexed(meta.Items, i) {
+			key = sortedKey(key, meta.Items[i].Key, meta.Items[i].Value, parent, node)
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // removeNode removes node keys from the tree except the children key or its parent.
 func (t *boltForest) removeNode(b *bbolt.Bucket, key []byte, node, parent Node) error {
 	k := stateKey(key, node)
@@ -514,13 +778,8 @@ func (t *boltForest) removeNode(b *bbolt.Bucket, key []byte, node, parent Node)
 
 	var meta Meta
 	if err := meta.FromBytes(rawMeta); err == nil {
-		for i := range meta.Items {
-			if isAttributeInternal(meta.Items[i].Key) {
-				err := b.Delete(internalKey(nil, meta.Items[i].Key, string(meta.Items[i].Value), parent, node))
-				if err != nil {
-					return err
-				}
-			}
+		if err := t.removeIndexes(b, nil, node, parent, meta); err != nil {
+			return err
 		}
 	}
 	return b.Delete(k)
@@ -552,6 +811,17 @@ func (t *boltForest) addNode(b *bbolt.Bucket, key []byte, child, parent Node, ti
 			return err
 		}
 	}
+
+	for i := range meta.Items {
+		if !t.isSortedIndexed(meta.Items, i) {
+			continue
+		}
+
+		key = sortedKey(key, meta.Items[i].Key, meta.Items[i].Value, parent, child)
+		if err := b.Put(key, []byte{}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -565,6 +835,16 @@ func (t *boltForest) undo(m *Move, b *bbolt.Bucket, key []byte) error {
 		return t.removeNode(b, key, m.Child, m.Parent)
 	}
 
+	if currParent, _, currMeta, inTree := t.getState(b, stateKey(key, m.Child)); inTree {
+		var meta Meta
+		if err := meta.FromBytes(currMeta); err != nil {
+			return err
+		}
+		if err := t.removeIndexes(b, nil, m.Child, currParent, meta); err != nil {
+			return err
+		}
+	}
+
 	var meta Meta
 	if err := meta.FromBytes(rawMeta); err != nil {
 		return err
@@ -587,7 +867,9 @@ func (t *boltForest) isAncestor(b *bbolt.Bucket, parent, child Node) bool {
 }
 
 // TreeGetByPath implements the Forest interface.
-func (t *boltForest) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, path []string, latest bool) ([]Node, error) {
+func (t *boltForest) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, path []string, latest bool) (_ []Node, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeGetByPath", &err)()
+
 	if !isAttributeInternal(attr) {
 		return nil, ErrNotPathAttribute
 	}
@@ -611,7 +893,7 @@ func (t *boltForest) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, pa
 			return ErrTreeNotFound
 		}
 
-		b := treeRoot.Bucket(dataBucket)
+		b := treeDataBucket(treeRoot)
 
 		i, curNode, err := t.getPathPrefix(b, attr, path[:len(path)-1])
 		if err != nil {
@@ -646,7 +928,9 @@ func (t *boltForest) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, pa
 }
 
 // TreeGetMeta implements the forest interface.
-func (t *boltForest) TreeGetMeta(cid cidSDK.ID, treeID string, nodeID Node) (Meta, Node, error) {
+func (t *boltForest) TreeGetMeta(cid cidSDK.ID, treeID string, nodeID Node) (_ Meta, _ Node, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeGetMeta", &err)()
+
 	t.modeMtx.RLock()
 	defer t.modeMtx.RUnlock()
 
@@ -659,13 +943,13 @@ func (t *boltForest) TreeGetMeta(cid cidSDK.ID, treeID string, nodeID Node) (Met
 	var m Meta
 	var parentID uint64
 
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err = t.db.View(func(tx *bbolt.Tx) error {
 		treeRoot := tx.Bucket(bucketName(cid, treeID))
 		if treeRoot == nil {
 			return ErrTreeNotFound
 		}
 
-		b := treeRoot.Bucket(dataBucket)
+		b := treeDataBucket(treeRoot)
 		if data := b.Get(key); len(data) != 0 {
 			parentID = binary.LittleEndian.Uint64(data)
 		}
@@ -677,7 +961,9 @@ func (t *boltForest) TreeGetMeta(cid cidSDK.ID, treeID string, nodeID Node) (Met
 }
 
 // TreeGetChildren implements the Forest interface.
-func (t *boltForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node) ([]uint64, error) {
+func (t *boltForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node) (_ []uint64, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeGetChildren", &err)()
+
 	t.modeMtx.RLock()
 	defer t.modeMtx.RUnlock()
 
@@ -691,13 +977,13 @@ func (t *boltForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node)
 
 	var children []uint64
 
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err = t.db.View(func(tx *bbolt.Tx) error {
 		treeRoot := tx.Bucket(bucketName(cid, treeID))
 		if treeRoot == nil {
 			return ErrTreeNotFound
 		}
 
-		b := treeRoot.Bucket(dataBucket)
+		b := treeDataBucket(treeRoot)
 		c := b.Cursor()
 		for k, _ := c.Seek(key); len(k) == 17 && binary.LittleEndian.Uint64(k[1:]) == nodeID; k, _ = c.Next() {
 			children = append(children, binary.LittleEndian.Uint64(k[9:]))
@@ -708,8 +994,57 @@ func (t *boltForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node)
 	return children, err
 }
 
+// TreeGetSortedChildren implements the Forest interface.
+func (t *boltForest) TreeGetSortedChildren(cid cidSDK.ID, treeID string, nodeID Node, attr string, after *SortCursor, count int) (_ []Node, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeGetSortedChildren", &err)()
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	if _, ok := t.sorted[attr]; !ok {
+		return nil, ErrUnsortedAttribute
+	}
+
+	prefix := sortedPrefix(nil, attr, nodeID)
+
+	var children []Node
+
+	err = t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		c := treeDataBucket(treeRoot).Cursor()
+
+		var k []byte
+		if after == nil {
+			k, _ = c.Seek(prefix)
+		} else {
+			start := sortedKey(nil, attr, after.Value, nodeID, after.ID)
+			k, _ = c.Seek(start)
+			if bytes.Equal(k, start) {
+				k, _ = c.Next()
+			}
+		}
+
+		for ; bytes.HasPrefix(k, prefix) && (count == 0 || len(children) < count); k, _ = c.Next() {
+			children = append(children, binary.BigEndian.Uint64(k[len(k)-8:]))
+		}
+		return nil
+	})
+
+	return children, err
+}
+
 // TreeList implements the Forest interface.
-func (t *boltForest) TreeList(cid cidSDK.ID) ([]string, error) {
+func (t *boltForest) TreeList(cid cidSDK.ID) (_ []string, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeList", &err)()
+
 	t.modeMtx.RLock()
 	defer t.modeMtx.RUnlock()
 
@@ -723,7 +1058,7 @@ func (t *boltForest) TreeList(cid cidSDK.ID) ([]string, error) {
 
 	cidLen := len(cidRaw)
 
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err = t.db.View(func(tx *bbolt.Tx) error {
 		c := tx.Cursor()
 		for k, _ := c.Seek(cidRaw); k != nil; k, _ = c.Next() {
 			if !bytes.HasPrefix(k, cidRaw) {
@@ -742,8 +1077,47 @@ func (t *boltForest) TreeList(cid cidSDK.ID) ([]string, error) {
 	return ids, nil
 }
 
+// TreeListTrees implements the ForestStorage interface.
+func (t *boltForest) TreeListTrees() (_ []ContainerIDTreeID, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeListTrees", &err)()
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	var ids []ContainerIDTreeID
+
+	err = t.db.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+			// Skip service buckets.
+			if len(name) < 32 {
+				return nil
+			}
+
+			var id ContainerIDTreeID
+			if err := id.CID.Decode(name[:32]); err != nil {
+				return fmt.Errorf("invalid container ID in the bucket name: %w", err)
+			}
+			id.TreeID = string(name[32:])
+
+			ids = append(ids, id)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list trees: %w", err)
+	}
+
+	return ids, nil
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
-func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error) {
+func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (_ Move, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeGetOpLog", &err)()
+
 	t.modeMtx.RLock()
 	defer t.modeMtx.RUnlock()
 
@@ -756,7 +1130,7 @@ func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (
 
 	var lm Move
 
-	err := t.db.View(func(tx *bbolt.Tx) error {
+	err = t.db.View(func(tx *bbolt.Tx) error {
 		treeRoot := tx.Bucket(bucketName(cid, treeID))
 		if treeRoot == nil {
 			return ErrTreeNotFound
@@ -773,7 +1147,9 @@ func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (
 }
 
 // TreeDrop implements the pilorama.Forest interface.
-func (t *boltForest) TreeDrop(cid cidSDK.ID, treeID string) error {
+func (t *boltForest) TreeDrop(cid cidSDK.ID, treeID string) (err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeDrop", &err)()
+
 	t.modeMtx.RLock()
 	defer t.modeMtx.RUnlock()
 
@@ -804,6 +1180,431 @@ func (t *boltForest) TreeDrop(cid cidSDK.ID, treeID string) error {
 	})
 }
 
+// compactBatchSize is the maximum number of log operations removed in a single transaction.
+const compactBatchSize = 10000
+
+// TreeCompact implements the Forest interface.
+func (t *boltForest) TreeCompact(cid cidSDK.ID, treeID string, height uint64) (err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeCompact", &err)()
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	fullID := bucketName(cid, treeID)
+
+	// The height is saved first, so the operations below it are ignored
+	// even if the log is not cleaned completely.
+	var compacted bool
+	err = t.db.Update(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(fullID)
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		b := treeDataBucket(treeRoot)
+		if height <= getSnapshotHeight(b) {
+			compacted = true
+			return nil
+		}
+		return b.Put(snapshotHeightKey, toUint64(height))
+	})
+	if err != nil || compacted {
+		return err
+	}
+
+	return t.compactLog(fullID, height)
+}
+
+// compactLog removes the operations below the height from the log in batches.
+func (t *boltForest) compactLog(fullID []byte, height uint64) error {
+	for compacted := false; !compacted; {
+		err := t.db.Update(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(fullID)
+			if treeRoot == nil {
+				return ErrTreeNotFound
+			}
+
+			bTree := treeDataBucket(treeRoot)
+			c := treeRoot.Bucket(logBucket).Cursor()
+
+			var key [9]byte
+			for i := 0; i < compactBatchSize; i++ {
+				k, _ := c.First()
+				if k == nil || binary.BigEndian.Uint64(k) >= height {
+					compacted = true
+					return nil
+				}
+
+				if err := bTree.Delete(oldKey(key[:], binary.BigEndian.Uint64(k))); err != nil {
+					return err
+				}
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not compact tree log: %w", err)
+		}
+	}
+	return nil
+}
+
+// TreeGetSnapshot implements the Forest interface.
+func (t *boltForest) TreeGetSnapshot(cid cidSDK.ID, treeID string, height uint64, cursor *SnapshotCursor, count int) (_ uint64, _ []SnapshotNode, err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeGetSnapshot", &err)()
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return 0, nil, ErrDegradedMode
+	}
+
+	if cursor == nil {
+		cursor = new(SnapshotCursor)
+	}
+
+	var nodes []SnapshotNode
+
+	err = t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		b := treeDataBucket(treeRoot)
+		if h := getSnapshotHeight(b); height == 0 {
+			height = h
+		} else if height < h {
+			return ErrInvalidSnapshotHeight
+		}
+		if height == 0 || count <= 0 {
+			return nil
+		}
+
+		if cursor.moved == nil || cursor.height != height {
+			cursor.height = height
+			cursor.moved = make(map[Node]Timestamp)
+			cursor.logHeight = height
+		}
+
+		// The state of the nodes moved above the snapshot height
+		// is saved together with the first such operation. Only
+		// the operations added since the previous call are scanned.
+		key := make([]byte, 9)
+		binary.BigEndian.PutUint64(key, cursor.logHeight)
+
+		c := treeRoot.Bucket(logBucket).Cursor()
+		for k, v := c.Seek(key[:8]); k != nil; k, v = c.Next() {
+			child := binary.LittleEndian.Uint64(v)
+			if _, ok := cursor.moved[child]; !ok {
+				cursor.moved[child] = binary.BigEndian.Uint64(k)
+			}
+			cursor.logHeight = binary.BigEndian.Uint64(k) + 1
+		}
+
+		c = b.Cursor()
+		start := stateKey(make([]byte, 9), cursor.last)
+		k, v := c.Seek(start)
+		if cursor.started && bytes.Equal(k, start) {
+			k, v = c.Next()
+		}
+
+		for ; len(k) == 9 && k[0] == 's' && len(nodes) < count; k, v = c.Next() {
+			n := SnapshotNode{ID: binary.LittleEndian.Uint64(k[1:])}
+			cursor.last = n.ID
+			cursor.started = true
+
+			data := v
+			if ts, ok := cursor.moved[n.ID]; ok {
+				data = b.Get(oldKey(key, ts))
+				if data == nil {
+					// The node was added after the snapshot.
+					continue
+				}
+			}
+
+			n.Parent = binary.LittleEndian.Uint64(data)
+			n.Time = binary.LittleEndian.Uint64(data[8:])
+			if err := n.Meta.FromBytes(data[16:]); err != nil {
+				return err
+			}
+			nodes = append(nodes, n)
+		}
+		return nil
+	})
+
+	return height, nodes, err
+}
+
+// TreeApplySnapshot implements the Forest interface.
+func (t *boltForest) TreeApplySnapshot(d CIDDescriptor, treeID string, height uint64, nodes []SnapshotNode, more bool) (err error) {
+	defer storageutil.Elapsed(t.metrics, "TreeApplySnapshot", &err)()
+
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	fullID := bucketName(d.CID, treeID)
+
+	var applied bool
+	err = t.db.Update(func(tx *bbolt.Tx) error {
+		_, bTree, err := t.getTreeBuckets(tx, fullID)
+		if err != nil {
+			return err
+		}
+		treeRoot := tx.Bucket(fullID)
+		if height <= getSnapshotHeight(bTree) {
+			applied = true
+			if treeRoot.Bucket(snapshotBucket) != nil {
+				return treeRoot.DeleteBucket(snapshotBucket)
+			}
+			return nil
+		}
+
+		return t.stageSnapshot(treeRoot, height, nodes)
+	})
+	if err != nil {
+		return err
+	}
+	if applied {
+		return t.dropUnusedData(fullID)
+	}
+	if more {
+		return nil
+	}
+	return t.rebuildFromSnapshot(fullID, height)
+}
+
+// applySnapshotBatchSize is the maximum number of snapshot nodes added to the tree in a single transaction.
+const applySnapshotBatchSize = 10000
+
+// errSnapshotChanged is returned when the pending snapshot is replaced during the rebuild.
+var errSnapshotChanged = errors.New("pending snapshot is changed")
+
+// rebuildFromSnapshot builds the tree from the pending snapshot in the unused
+// tree storage bucket and makes it active. The snapshot nodes are moved in batches,
+// so the interrupted rebuild is continued when the snapshot is applied again.
+// The operations below the snapshot height are removed from the log afterwards.
+func (t *boltForest) rebuildFromSnapshot(fullID []byte, height uint64) error {
+	if err := t.dropUnusedData(fullID); err != nil {
+		return err
+	}
+
+	for done := false; !done; {
+		err := t.db.Update(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(fullID)
+			if treeRoot == nil {
+				return ErrTreeNotFound
+			}
+			bSnapshot := treeRoot.Bucket(snapshotBucket)
+			if bSnapshot == nil || getSnapshotHeight(bSnapshot) != height {
+				return errSnapshotChanged
+			}
+			bTree, err := t.getRebuildBucket(treeRoot, bSnapshot)
+			if err != nil {
+				return err
+			}
+
+			var key [17]byte
+			var meta Meta
+			c := bSnapshot.Cursor()
+			for i := 0; i < applySnapshotBatchSize; i++ {
+				k, v := c.Seek([]byte{'s'})
+				if len(k) != 9 || k[0] != 's' {
+					done = true
+					return t.switchToSnapshot(treeRoot, bTree, height)
+				}
+
+				if err := meta.FromBytes(v[16:]); err != nil {
+					return err
+				}
+				id := binary.LittleEndian.Uint64(k[1:])
+				parent := binary.LittleEndian.Uint64(v)
+				ts := binary.LittleEndian.Uint64(v[8:])
+				if err := t.addNode(bTree, key[:], id, parent, ts, meta, v[16:]); err != nil {
+					return err
+				}
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not apply tree snapshot: %w", err)
+		}
+	}
+
+	if err := t.dropUnusedData(fullID); err != nil {
+		return err
+	}
+	return t.compactLog(fullID, height)
+}
+
+// getRebuildBucket returns the unused tree storage bucket the tree is rebuilt in.
+// The bucket is created on the first call for the pending snapshot.
+func (t *boltForest) getRebuildBucket(treeRoot, bSnapshot *bbolt.Bucket) (*bbolt.Bucket, error) {
+	name := unusedDataBucket(treeRoot)
+	if bSnapshot.Get(rebuildKey) != nil {
+		if b := treeRoot.Bucket(name); b != nil {
+			return b, nil
+		}
+	}
+
+	if treeRoot.Bucket(name) != nil {
+		if err := treeRoot.DeleteBucket(name); err != nil {
+			return nil, err
+		}
+	}
+	b, err := treeRoot.CreateBucket(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.putIndexVersion(b); err != nil {
+		return nil, err
+	}
+	return b, bSnapshot.Put(rebuildKey, []byte{})
+}
+
+// switchToSnapshot re-applies the operations above the snapshot height
+// to the rebuilt tree and makes it active.
+func (t *boltForest) switchToSnapshot(treeRoot, bTree *bbolt.Bucket, height uint64) error {
+	var key [17]byte
+	var lm Move
+
+	start := make([]byte, 8)
+	binary.BigEndian.PutUint64(start, height)
+
+	c := treeRoot.Bucket(logBucket).Cursor()
+	for k, v := c.Seek(start); k != nil; k, v = c.Next() {
+		if err := t.logFromBytes(&lm, v); err != nil {
+			return err
+		}
+		if err := t.redo(bTree, key[:], &lm, v[16:]); err != nil {
+			return err
+		}
+	}
+
+	if err := bTree.Put(snapshotHeightKey, toUint64(height)); err != nil {
+		return err
+	}
+	if err := treeRoot.Put(activeDataKey, unusedDataBucket(treeRoot)); err != nil {
+		return err
+	}
+	return treeRoot.DeleteBucket(snapshotBucket)
+}
+
+// dropUnusedData removes the unused tree storage bucket in batches.
+// The bucket the tree is being rebuilt in is kept.
+func (t *boltForest) dropUnusedData(fullID []byte) error {
+	for dropped := false; !dropped; {
+		err := t.db.Update(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(fullID)
+			if treeRoot == nil {
+				dropped = true
+				return nil
+			}
+			if b := treeRoot.Bucket(snapshotBucket); b != nil && b.Get(rebuildKey) != nil {
+				dropped = true
+				return nil
+			}
+
+			name := unusedDataBucket(treeRoot)
+			b := treeRoot.Bucket(name)
+			if b == nil {
+				dropped = true
+				return nil
+			}
+
+			c := b.Cursor()
+			for i := 0; i < compactBatchSize; i++ {
+				if k, _ := c.First(); k == nil {
+					dropped = true
+					return treeRoot.DeleteBucket(name)
+				}
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not remove unused tree data: %w", err)
+		}
+	}
+	return nil
+}
+
+// treeDataBucket returns the active tree storage bucket.
+func treeDataBucket(treeRoot *bbolt.Bucket) *bbolt.Bucket {
+	return treeRoot.Bucket(activeDataBucket(treeRoot))
+}
+
+// activeDataBucket returns the name of the active tree storage bucket.
+func activeDataBucket(treeRoot *bbolt.Bucket) []byte {
+	if bytes.Equal(treeRoot.Get(activeDataKey), rebuildDataBucket) {
+		return rebuildDataBucket
+	}
+	return dataBucket
+}
+
+// unusedDataBucket returns the name of the inactive tree storage bucket.
+func unusedDataBucket(treeRoot *bbolt.Bucket) []byte {
+	if bytes.Equal(activeDataBucket(treeRoot), rebuildDataBucket) {
+		return dataBucket
+	}
+	return rebuildDataBucket
+}
+
+// stageSnapshot saves the snapshot nodes to the pending snapshot bucket.
+// The nodes of a pending snapshot with a different height are removed.
+func (t *boltForest) stageSnapshot(treeRoot *bbolt.Bucket, height uint64, nodes []SnapshotNode) error {
+	b := treeRoot.Bucket(snapshotBucket)
+	if b != nil && getSnapshotHeight(b) != height {
+		if err := treeRoot.DeleteBucket(snapshotBucket); err != nil {
+			return err
+		}
+		b = nil
+	}
+	if b == nil {
+		var err error
+		b, err = treeRoot.CreateBucket(snapshotBucket)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(snapshotHeightKey, toUint64(height)); err != nil {
+			return err
+		}
+	}
+
+	var key [9]byte
+	for i := range nodes {
+		err := t.putState(b, stateKey(key[:], nodes[i].ID), nodes[i].Parent, nodes[i].Time, nodes[i].Meta.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *boltForest) getPathPrefix(bTree *bbolt.Bucket, attr string, path []string) (int, Node, error) {
 	c := bTree.Cursor()
 
@@ -866,6 +1667,37 @@ func bucketName(cid cidSDK.ID, treeID string) []byte {
 	return treeRoot
 }
 
+// snapshotHeightKey is a key for the snapshot height of the tree.
+var snapshotHeightKey = []byte{'h'}
+
+// activeDataKey is a key for the name of the active tree storage bucket.
+var activeDataKey = []byte{'d'}
+
+// rebuildKey is a key marking the pending snapshot the tree is being rebuilt from.
+var rebuildKey = []byte{'r'}
+
+// indexVersionKey is a key for the version of the tree indexes.
+var indexVersionKey = []byte{'v'}
+
+// indexProgressKey is a key for the last tree node put in the sorted children index
+// being rebuilt, 's' if the outdated index is removed, but no node is put yet.
+var indexProgressKey = []byte{'u'}
+
+// sortedAttributesKey is a key for the attributes in the sorted children index.
+var sortedAttributesKey = []byte{'l'}
+
+// indexVersion is the current version of the tree indexes.
+// Version 1 adds the sorted children index.
+const indexVersion = 1
+
+func getSnapshotHeight(b *bbolt.Bucket) Timestamp {
+	data := b.Get(snapshotHeightKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(data)
+}
+
 // 'o' + time -> old meta.
 func oldKey(key []byte, ts Timestamp) []byte {
 	key[0] = 'o'
@@ -934,6 +1766,52 @@ func internalKey(key []byte, k, v string, parent, node Node) []byte {
 	return key
 }
 
+// 'a' + parent (id) + attribute name (string) + attribute value (escaped) + node (id, big-endian) -> empty.
+// The attribute value is escaped to preserve the order: zero bytes are replaced with 0x00 0xFF
+// and the value is terminated with 0x00 0x01.
+func sortedKey(key []byte, k string, v []byte, parent, node Node) []byte {
+	key = sortedPrefix(key, k, parent)
+	for _, c := range v {
+		if c == 0 {
+			key = append(key, 0, 0xFF)
+		} else {
+			key = append(key, c)
+		}
+	}
+	key = append(key, 0, 1)
+
+	var raw [8]byte
+	binary.BigEndian.PutUint64(raw[:], node)
+	return append(key, raw[:]...)
+}
+
+// sortedPrefix returns the prefix of the sorted index keys for the children of the parent.
+func sortedPrefix(key []byte, k string, parent Node) []byte {
+	key = append(key[:0], 'a')
+
+	var raw [8]byte
+	binary.LittleEndian.PutUint64(raw[:], parent)
+	key = append(key, raw[:]...)
+
+	l := len(k)
+	key = append(key, byte(l), byte(l>>8))
+	return append(key, k...)
+}
+
+// isSortedIndexed returns true iff the i-th attribute is put in the sorted children index.
+// Only the first attribute with the same name is indexed.
+func (t *boltForest) isSortedIndexed(items []KeyValue, i int) bool {
+	if _, ok := t.sorted[items[i].Key]; !ok || !isAttributeSortable(items[i]) {
+		return false
+	}
+	for j := 0; j < i; j++ {
+		if items[j].Key == items[i].Key {
+			return false
+		}
+	}
+	return true
+}
+
 func toUint64(x uint64) []byte {
 	var a [8]byte
 	binary.LittleEndian.PutUint64(a[:], x)
@@ -0,0 +1,256 @@
+package pilorama
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	neoio "github.com/nspcc-dev/neo-go/pkg/io"
+)
+
+// Export file format:
+// magic (4 bytes) + version (1 byte) + container ID (32 bytes) + tree ID (var string) +
+// snapshot height (8 bytes) + records.
+//
+// Each record starts with a type byte:
+// - exportNode: node ID + parent ID + timestamp (8 bytes each) + meta,
+// - exportOperation: parent ID + child ID (8 bytes each) + meta,
+// - exportEnd: no payload, the last record in the file.
+//
+// All node records precede operation records.
+var exportMagic = []byte("TREE")
+
+const exportVersion = 1
+
+const (
+	exportEnd byte = iota
+	exportNode
+	exportOperation
+)
+
+const exportBatchSize = 1000
+
+// ErrInvalidExport is returned when the tree export data is malformed.
+var ErrInvalidExport = logicerr.New("invalid tree export")
+
+// ExportInfo contains information about the exported or imported tree.
+type ExportInfo struct {
+	CID    cidSDK.ID
+	TreeID string
+	// Height is the snapshot height, zero if there is no snapshot.
+	Height uint64
+	// Nodes is the number of snapshot nodes.
+	Nodes int
+	// Operations is the number of log operations.
+	Operations int
+}
+
+// ExportTree writes the tree to w in a portable format.
+//
+// If snapshot is false, the snapshot at the height the tree log was compacted
+// to is written together with the log operations above it. Otherwise, the current
+// tree state is written as a snapshot without log operations.
+func ExportTree(w io.Writer, f Forest, cid cidSDK.ID, treeID string, snapshot bool) (ExportInfo, error) {
+	info := ExportInfo{CID: cid, TreeID: treeID}
+
+	var cursor SnapshotCursor
+
+	height, nodes, err := f.TreeGetSnapshot(cid, treeID, 0, &cursor, exportBatchSize)
+	if err != nil {
+		return info, err
+	}
+
+	if snapshot {
+		// The current state is the state at the height above the last operation.
+		h, err := lastHeight(f, cid, treeID, height)
+		if err != nil {
+			return info, err
+		}
+		if h != height {
+			height = h
+			cursor = SnapshotCursor{}
+			_, nodes, err = f.TreeGetSnapshot(cid, treeID, height, &cursor, exportBatchSize)
+			if err != nil {
+				return info, err
+			}
+		}
+	}
+
+	info.Height = height
+
+	bw := bufio.NewWriter(w)
+	bin := neoio.NewBinWriterFromIO(bw)
+
+	rawCID := make([]byte, 32)
+	cid.Encode(rawCID)
+
+	bin.WriteBytes(exportMagic)
+	bin.WriteB(exportVersion)
+	bin.WriteBytes(rawCID)
+	bin.WriteString(treeID)
+	bin.WriteU64LE(height)
+
+	for len(nodes) != 0 {
+		for i := range nodes {
+			bin.WriteB(exportNode)
+			bin.WriteU64LE(nodes[i].ID)
+			bin.WriteU64LE(nodes[i].Parent)
+			bin.WriteU64LE(nodes[i].Time)
+			nodes[i].Meta.EncodeBinary(bin)
+		}
+		if bin.Err != nil {
+			return info, bin.Err
+		}
+		info.Nodes += len(nodes)
+		if len(nodes) < exportBatchSize {
+			break
+		}
+
+		_, nodes, err = f.TreeGetSnapshot(cid, treeID, height, &cursor, exportBatchSize)
+		if err != nil {
+			return info, err
+		}
+	}
+
+	if !snapshot {
+		for h := height; ; {
+			lm, err := f.TreeGetOpLog(cid, treeID, h)
+			if err != nil {
+				return info, err
+			}
+			if lm.Time == 0 {
+				break
+			}
+
+			bin.WriteB(exportOperation)
+			bin.WriteU64LE(lm.Parent)
+			bin.WriteU64LE(lm.Child)
+			lm.Meta.EncodeBinary(bin)
+			if bin.Err != nil {
+				return info, bin.Err
+			}
+			info.Operations++
+
+			h = lm.Time + 1
+		}
+	}
+
+	bin.WriteB(exportEnd)
+	if bin.Err != nil {
+		return info, bin.Err
+	}
+	return info, bw.Flush()
+}
+
+// lastHeight returns the height above the last log operation of the tree.
+func lastHeight(f Forest, cid cidSDK.ID, treeID string, height uint64) (uint64, error) {
+	for {
+		lm, err := f.TreeGetOpLog(cid, treeID, height)
+		if err != nil || lm.Time == 0 {
+			return height, err
+		}
+		height = lm.Time + 1
+	}
+}
+
+// ImportTree reads the tree written by ExportTree from r and applies it to f.
+// The snapshot is applied first, then log operations are applied on top of it.
+func ImportTree(r io.Reader, f Forest) (ExportInfo, error) {
+	var info ExportInfo
+
+	bin := neoio.NewBinReaderFromIO(bufio.NewReader(r))
+
+	magic := make([]byte, len(exportMagic))
+	bin.ReadBytes(magic)
+	version := bin.ReadB()
+	if bin.Err != nil {
+		return info, fmt.Errorf("%w: %v", ErrInvalidExport, bin.Err)
+	}
+	if !bytes.Equal(magic, exportMagic) {
+		return info, fmt.Errorf("%w: invalid magic", ErrInvalidExport)
+	}
+	if version != exportVersion {
+		return info, fmt.Errorf("%w: unsupported version %d", ErrInvalidExport, version)
+	}
+
+	rawCID := make([]byte, 32)
+	bin.ReadBytes(rawCID)
+	info.TreeID = bin.ReadString()
+	info.Height = bin.ReadU64LE()
+	if bin.Err != nil {
+		return info, fmt.Errorf("%w: %v", ErrInvalidExport, bin.Err)
+	}
+	if err := info.CID.Decode(rawCID); err != nil {
+		return info, fmt.Errorf("%w: invalid container ID: %v", ErrInvalidExport, err)
+	}
+
+	d := CIDDescriptor{CID: info.CID, Position: 0, Size: 1}
+
+	// The snapshot is applied in batches to keep the memory usage bounded.
+	nodes := make([]SnapshotNode, 0, exportBatchSize)
+	var snapshotApplied bool
+	applySnapshot := func() error {
+		if snapshotApplied || info.Height == 0 {
+			return nil
+		}
+		snapshotApplied = true
+		return f.TreeApplySnapshot(d, info.TreeID, info.Height, nodes, false)
+	}
+
+	for {
+		typ := bin.ReadB()
+		if bin.Err != nil {
+			return info, fmt.Errorf("%w: %v", ErrInvalidExport, bin.Err)
+		}
+
+		switch typ {
+		case exportEnd:
+			return info, applySnapshot()
+		case exportNode:
+			if snapshotApplied || info.Height == 0 {
+				return info, fmt.Errorf("%w: unexpected node record", ErrInvalidExport)
+			}
+
+			var n SnapshotNode
+			n.ID = bin.ReadU64LE()
+			n.Parent = bin.ReadU64LE()
+			n.Time = bin.ReadU64LE()
+			n.Meta.DecodeBinary(bin)
+			if bin.Err != nil {
+				return info, fmt.Errorf("%w: %v", ErrInvalidExport, bin.Err)
+			}
+
+			nodes = append(nodes, n)
+			info.Nodes++
+
+			if len(nodes) == exportBatchSize {
+				if err := f.TreeApplySnapshot(d, info.TreeID, info.Height, nodes, true); err != nil {
+					return info, err
+				}
+				nodes = nodes[:0]
+			}
+		case exportOperation:
+			if err := applySnapshot(); err != nil {
+				return info, err
+			}
+
+			var m Move
+			m.Parent = bin.ReadU64LE()
+			m.Child = bin.ReadU64LE()
+			m.Meta.DecodeBinary(bin)
+			if bin.Err != nil {
+				return info, fmt.Errorf("%w: %v", ErrInvalidExport, bin.Err)
+			}
+
+			if err := f.TreeApply(d, info.TreeID, &m, true); err != nil {
+				return info, err
+			}
+			info.Operations++
+		default:
+			return info, fmt.Errorf("%w: unknown record type %d", ErrInvalidExport, typ)
+		}
+	}
+}
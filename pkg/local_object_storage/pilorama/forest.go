@@ -1,6 +1,7 @@
 package pilorama
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
@@ -12,6 +13,8 @@ import (
 type memoryForest struct {
 	// treeMap maps tree identifier (container ID + name) to the replicated log.
 	treeMap map[string]*state
+	// sorted is the set of the attributes the children can be sorted by.
+	sorted map[string]struct{}
 }
 
 var _ Forest = (*memoryForest)(nil)
@@ -19,8 +22,10 @@ var _ Forest = (*memoryForest)(nil)
 // NewMemoryForest creates new empty forest.
 // TODO: this function will eventually be removed and is here for debugging.
 func NewMemoryForest() ForestStorage {
+	sorted, _ := sortedAttributeSet(sortedAttributes)
 	return &memoryForest{
 		treeMap: make(map[string]*state),
+		sorted:  sorted,
 	}
 }
 
@@ -88,10 +93,36 @@ func (f *memoryForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string
 		},
 		Child: s.findSpareID(),
 	})
+	s.operations = append(s.operations, op)
 	lm[len(lm)-1] = op.Move
 	return lm, nil
 }
 
+// TreeBatch implements the Forest interface.
+func (f *memoryForest) TreeBatch(d CIDDescriptor, treeID string, ops []BatchOperation) ([][]Move, error) {
+	if !d.checkValid() {
+		return nil, ErrInvalidCIDDescriptor
+	}
+	for i := range ops {
+		if ops[i].PathAttr != "" && !isAttributeInternal(ops[i].PathAttr) {
+			return nil, ErrNotPathAttribute
+		}
+	}
+
+	// Operations can't fail after the validation, so they are applied atomically.
+	res := make([][]Move, len(ops))
+	for i := range ops {
+		if ops[i].PathAttr != "" {
+			res[i], _ = f.TreeAddByPath(d, treeID, ops[i].PathAttr, ops[i].Path, ops[i].Items)
+		} else {
+			m := ops[i].Move
+			lm, _ := f.TreeMove(d, treeID, &m)
+			res[i] = []Move{*lm}
+		}
+	}
+	return res, nil
+}
+
 // TreeApply implements the Forest interface.
 func (f *memoryForest) TreeApply(d CIDDescriptor, treeID string, op *Move, _ bool) error {
 	if !d.checkValid() {
@@ -166,6 +197,47 @@ func (f *memoryForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node
 	return res, nil
 }
 
+// TreeGetSortedChildren implements the Forest interface.
+func (f *memoryForest) TreeGetSortedChildren(cid cidSDK.ID, treeID string, nodeID Node, attr string, after *SortCursor, count int) ([]Node, error) {
+	if _, ok := f.sorted[attr]; !ok {
+		return nil, ErrUnsortedAttribute
+	}
+
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+
+	var children []SortCursor
+	for _, child := range s.childMap[nodeID] {
+		for _, kv := range s.infoMap[child].Meta.Items {
+			if kv.Key == attr {
+				if isAttributeSortable(kv) {
+					children = append(children, SortCursor{Value: kv.Value, ID: child})
+				}
+				break
+			}
+		}
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].less(&children[j])
+	})
+
+	var res []Node
+	for i := range children {
+		if after != nil && !after.less(&children[i]) {
+			continue
+		}
+		if count != 0 && len(res) == count {
+			break
+		}
+		res = append(res, children[i].ID)
+	}
+	return res, nil
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (f *memoryForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error) {
 	fullID := cid.String() + "/" + treeID
@@ -220,9 +292,107 @@ func (f *memoryForest) TreeList(cid cidSDK.ID) ([]string, error) {
 	return res, nil
 }
 
+// TreeListTrees implements the ForestStorage interface.
+func (f *memoryForest) TreeListTrees() ([]ContainerIDTreeID, error) {
+	var res []ContainerIDTreeID
+
+	for k := range f.treeMap {
+		cidStr, treeID, _ := strings.Cut(k, "/")
+
+		var id ContainerIDTreeID
+		if err := id.CID.DecodeString(cidStr); err != nil {
+			return nil, fmt.Errorf("invalid container ID: %w", err)
+		}
+		id.TreeID = treeID
+
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
 // TreeExists implements the pilorama.Forest interface.
 func (f *memoryForest) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	fullID := cid.EncodeToString() + "/" + treeID
 	_, ok := f.treeMap[fullID]
 	return ok, nil
 }
+
+// TreeCompact implements the pilorama.Forest interface.
+func (f *memoryForest) TreeCompact(cid cidSDK.ID, treeID string, height uint64) error {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return ErrTreeNotFound
+	}
+
+	s.compact(height)
+	return nil
+}
+
+// TreeGetSnapshot implements the pilorama.Forest interface.
+func (f *memoryForest) TreeGetSnapshot(cid cidSDK.ID, treeID string, height uint64, cursor *SnapshotCursor, count int) (uint64, []SnapshotNode, error) {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return 0, nil, ErrTreeNotFound
+	}
+
+	if height == 0 {
+		height = s.height
+	} else if height < s.height {
+		return 0, nil, ErrInvalidSnapshotHeight
+	}
+	if height == 0 || count <= 0 {
+		return height, nil, nil
+	}
+
+	if cursor == nil {
+		cursor = new(SnapshotCursor)
+	}
+
+	info := s.snapshot(height)
+
+	ids := make([]Node, 0, len(info))
+	for id := range info {
+		if id > cursor.last || !cursor.started {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) > count {
+		ids = ids[:count]
+	}
+	if len(ids) != 0 {
+		cursor.last = ids[len(ids)-1]
+		cursor.started = true
+	}
+
+	res := make([]SnapshotNode, len(ids))
+	for i := range ids {
+		res[i] = SnapshotNode{
+			ID:     ids[i],
+			Parent: info[ids[i]].Parent,
+			Time:   info[ids[i]].Meta.Time,
+			Meta:   info[ids[i]].Meta,
+		}
+	}
+	return height, res, nil
+}
+
+// TreeApplySnapshot implements the pilorama.Forest interface.
+func (f *memoryForest) TreeApplySnapshot(d CIDDescriptor, treeID string, height uint64, nodes []SnapshotNode, more bool) error {
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	fullID := d.CID.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		s = newState()
+		f.treeMap[fullID] = s
+	}
+
+	s.applySnapshot(height, nodes, more)
+	return nil
+}
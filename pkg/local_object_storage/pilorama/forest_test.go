@@ -1,6 +1,8 @@
 package pilorama
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"os"
@@ -13,6 +15,7 @@ import (
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
 )
 
 var providers = []struct {
@@ -173,6 +176,270 @@ func testForestTreeGetChildren(t *testing.T, s Forest) {
 	})
 }
 
+func TestForest_TreeGetSortedChildren(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeGetSortedChildren(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeGetSortedChildren(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	treeAdd := func(t *testing.T, child, parent Node, name string) {
+		var meta []KeyValue
+		if name != "" {
+			meta = []KeyValue{{Key: AttributeFilename, Value: []byte(name)}}
+		}
+		_, err := s.TreeMove(d, treeID, &Move{
+			Parent: parent,
+			Child:  child,
+			Meta:   Meta{Items: meta},
+		})
+		require.NoError(t, err)
+	}
+
+	treeAdd(t, 10, 0, "b")
+	treeAdd(t, 3, 0, "a")
+	treeAdd(t, 2, 0, "a")
+	treeAdd(t, 6, 0, "ab")
+	treeAdd(t, 7, 0, "a\x00")
+	treeAdd(t, 11, 0, "")
+	treeAdd(t, 12, 0, string(make([]byte, maxSortedAttributeSize)))
+	treeAdd(t, 4, 10, "c")
+
+	testGetSorted := func(t *testing.T, nodeID Node, expected []Node) {
+		actual, err := s.TreeGetSortedChildren(cid, treeID, nodeID, AttributeFilename, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		// Iterate with a cursor.
+		var after *SortCursor
+		actual = nil
+		for {
+			nodes, err := s.TreeGetSortedChildren(cid, treeID, nodeID, AttributeFilename, after, 2)
+			require.NoError(t, err)
+			require.True(t, len(nodes) <= 2)
+			if len(nodes) == 0 {
+				break
+			}
+			actual = append(actual, nodes...)
+
+			last := nodes[len(nodes)-1]
+			m, _, err := s.TreeGetMeta(cid, treeID, last)
+			require.NoError(t, err)
+			after = &SortCursor{Value: m.GetAttr(AttributeFilename), ID: last}
+		}
+		require.Equal(t, expected, actual)
+	}
+
+	testGetSorted(t, 0, []Node{2, 3, 7, 6, 10})
+	testGetSorted(t, 10, []Node{4})
+	testGetSorted(t, 4, nil)
+
+	t.Run("cursor", func(t *testing.T) {
+		nodes, err := s.TreeGetSortedChildren(cid, treeID, 0, AttributeFilename, &SortCursor{Value: []byte("a")}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []Node{2, 3, 7, 6, 10}, nodes)
+
+		nodes, err = s.TreeGetSortedChildren(cid, treeID, 0, AttributeFilename, &SortCursor{Value: []byte("a"), ID: TrashID}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []Node{7, 6, 10}, nodes)
+	})
+	t.Run("move and rename", func(t *testing.T) {
+		treeAdd(t, 3, 10, "a")
+		treeAdd(t, 10, 0, "0")
+
+		testGetSorted(t, 0, []Node{10, 2, 7, 6})
+		testGetSorted(t, 10, []Node{3, 4})
+	})
+	t.Run("remove", func(t *testing.T) {
+		treeAdd(t, 2, TrashID, "")
+
+		testGetSorted(t, 0, []Node{10, 7, 6})
+	})
+	t.Run("missing tree", func(t *testing.T) {
+		_, err := s.TreeGetSortedChildren(cid, treeID+"123", 0, AttributeFilename, nil, 0)
+		require.ErrorIs(t, err, ErrTreeNotFound)
+	})
+}
+
+func TestBoltForest_UpdateIndexes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	f := NewBoltForest(WithPath(path))
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	for _, name := range []string{"c", "a", "b"} {
+		_, err := f.TreeAddByPath(d, treeID, AttributeFilename, nil,
+			[]KeyValue{{Key: AttributeFilename, Value: []byte(name)}})
+		require.NoError(t, err)
+	}
+
+	expected, err := f.TreeGetSortedChildren(cid, treeID, RootID, AttributeFilename, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(expected))
+
+	// Remove the index the same way it is missing in the trees created by the previous versions.
+	err = f.(*boltForest).db.Update(func(tx *bbolt.Tx) error {
+		b := treeDataBucket(tx.Bucket(bucketName(cid, treeID)))
+
+		var keys [][]byte
+		c := b.Cursor()
+		for k, _ := c.Seek([]byte{'a'}); len(k) != 0 && k[0] == 'a'; k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+		for i := range keys {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return b.Delete(indexVersionKey)
+	})
+	require.NoError(t, err)
+
+	actual, err := f.TreeGetSortedChildren(cid, treeID, RootID, AttributeFilename, nil, 0)
+	require.NoError(t, err)
+	require.Empty(t, actual)
+	require.NoError(t, f.Close())
+
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	actual, err = f.TreeGetSortedChildren(cid, treeID, RootID, AttributeFilename, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestBoltForest_UpdateIndexesInterrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	f := NewBoltForest(WithPath(path))
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	for _, name := range []string{"c", "a", "b", "d"} {
+		_, err := f.TreeAddByPath(d, treeID, AttributeFilename, nil,
+			[]KeyValue{{Key: AttributeFilename, Value: []byte(name)}})
+		require.NoError(t, err)
+	}
+
+	expected, err := f.TreeGetSortedChildren(cid, treeID, RootID, AttributeFilename, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(expected))
+
+	// Leave the index of the first node only as if the rebuild was interrupted after it.
+	err = f.(*boltForest).db.Update(func(tx *bbolt.Tx) error {
+		b := treeDataBucket(tx.Bucket(bucketName(cid, treeID)))
+
+		c := b.Cursor()
+		first, _ := c.Seek([]byte{'s'})
+		first = append([]byte(nil), first...)
+		node := binary.LittleEndian.Uint64(first[1:])
+
+		var keys [][]byte
+		for k, _ := c.Seek([]byte{'a'}); len(k) != 0 && k[0] == 'a'; k, _ = c.Next() {
+			if binary.BigEndian.Uint64(k[len(k)-8:]) != node {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+		}
+		require.Equal(t, 3, len(keys))
+
+		for i := range keys {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		if err := b.Put(indexProgressKey, first); err != nil {
+			return err
+		}
+		return b.Delete(indexVersionKey)
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	actual, err := f.TreeGetSortedChildren(cid, treeID, RootID, AttributeFilename, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	err = f.(*boltForest).db.View(func(tx *bbolt.Tx) error {
+		b := treeDataBucket(tx.Bucket(bucketName(cid, treeID)))
+		require.Nil(t, b.Get(indexProgressKey))
+		require.True(t, f.(*boltForest).indexesUpdated(b))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestBoltForest_SortedAttributes(t *testing.T) {
+	const attrSize = "Size"
+
+	path := filepath.Join(t.TempDir(), "test.db")
+	f := NewBoltForest(WithPath(path))
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	for _, name := range []string{"c", "a", "b"} {
+		_, err := f.TreeAddByPath(d, treeID, AttributeFilename, nil, []KeyValue{
+			{Key: AttributeFilename, Value: []byte(name)},
+			{Key: attrSize, Value: []byte{'z' - name[0]}},
+		})
+		require.NoError(t, err)
+	}
+
+	byName, err := f.TreeGetSortedChildren(cid, treeID, RootID, AttributeFilename, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(byName))
+
+	_, err = f.TreeGetSortedChildren(cid, treeID, RootID, attrSize, nil, 0)
+	require.ErrorIs(t, err, ErrUnsortedAttribute)
+	require.NoError(t, f.Close())
+
+	// The index is rebuilt if the sorted attributes are changed by a new version.
+	f = NewBoltForest(WithPath(path))
+	f.(*boltForest).sorted, f.(*boltForest).rawSorted = sortedAttributeSet([]string{attrSize})
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	_, err = f.TreeGetSortedChildren(cid, treeID, RootID, AttributeFilename, nil, 0)
+	require.ErrorIs(t, err, ErrUnsortedAttribute)
+
+	bySize, err := f.TreeGetSortedChildren(cid, treeID, RootID, attrSize, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, []Node{byName[2], byName[1], byName[0]}, bySize)
+
+	err = f.(*boltForest).db.View(func(tx *bbolt.Tx) error {
+		var count int
+		c := treeDataBucket(tx.Bucket(bucketName(cid, treeID))).Cursor()
+		for k, _ := c.Seek([]byte{'a'}); len(k) != 0 && k[0] == 'a'; k, _ = c.Next() {
+			count++
+		}
+		require.Equal(t, len(bySize), count)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestForest_TreeDrop(t *testing.T) {
 	for i := range providers {
 		t.Run(providers[i].name, func(t *testing.T) {
@@ -401,6 +668,83 @@ func testForestTreeAddByPath(t *testing.T, s Forest) {
 	})
 }
 
+func TestForest_TreeBatch(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeBatch(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeBatch(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	meta := []KeyValue{{Key: AttributeFilename, Value: []byte("file.txt")}}
+
+	t.Run("invalid descriptor", func(t *testing.T) {
+		_, err := s.TreeBatch(CIDDescriptor{cid, 0, 0}, treeID, []BatchOperation{{}})
+		require.ErrorIs(t, err, ErrInvalidCIDDescriptor)
+	})
+	t.Run("invalid attribute", func(t *testing.T) {
+		_, err := s.TreeBatch(d, treeID, []BatchOperation{
+			{PathAttr: AttributeFilename, Path: []string{"path"}, Move: Move{Meta: Meta{Items: meta}}},
+			{PathAttr: AttributeVersion, Path: []string{"path"}, Move: Move{Meta: Meta{Items: meta}}},
+		})
+		require.ErrorIs(t, err, ErrNotPathAttribute)
+
+		nodes, err := s.TreeGetByPath(cid, treeID, AttributeFilename, []string{"path"}, false)
+		if err != nil {
+			require.ErrorIs(t, err, ErrTreeNotFound)
+		}
+		require.Empty(t, nodes)
+	})
+
+	res, err := s.TreeBatch(d, treeID, []BatchOperation{
+		{PathAttr: AttributeFilename, Path: []string{"path", "to"}, Move: Move{Meta: Meta{Items: meta}}},
+		{PathAttr: AttributeFilename, Path: []string{"path"}, Move: Move{Meta: Meta{Items: []KeyValue{{Key: AttributeFilename, Value: []byte("dir")}}}}},
+		{Move: Move{Parent: RootID, Child: RootID, Meta: Meta{Items: []KeyValue{{Key: AttributeFilename, Value: []byte("other")}}}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res))
+	require.Equal(t, 3, len(res[0]))
+	require.Equal(t, 1, len(res[1]))
+	require.Equal(t, 1, len(res[2]))
+
+	// Nodes created by the previous operations of the batch are used.
+	require.Equal(t, res[0][0].Child, res[1][0].Parent)
+
+	// Each operation has its own timestamp.
+	var last Timestamp
+	for i := range res {
+		for j := range res[i] {
+			require.True(t, res[i][j].Time > last || last == 0)
+			last = res[i][j].Time
+		}
+	}
+
+	fileID := res[0][2].Child
+	dirID := res[1][0].Child
+	otherID := res[2][0].Child
+	testMeta(t, s, cid, treeID, fileID, res[0][1].Child, Meta{Time: res[0][2].Time, Items: meta})
+
+	res, err = s.TreeBatch(d, treeID, []BatchOperation{
+		{Move: Move{Parent: dirID, Child: fileID, Meta: Meta{Items: meta}}},
+		{Move: Move{Parent: TrashID, Child: otherID}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+
+	nodes, err := s.TreeGetByPath(cid, treeID, AttributeFilename, []string{"path", "dir", "file.txt"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{fileID}, nodes)
+
+	nodes, err = s.TreeGetByPath(cid, treeID, AttributeFilename, []string{"other"}, false)
+	require.NoError(t, err)
+	require.Empty(t, nodes)
+}
+
 func TestForest_Apply(t *testing.T) {
 	for i := range providers {
 		t.Run(providers[i].name, func(t *testing.T) {
@@ -716,6 +1060,12 @@ func compareForests(t *testing.T, expected, actual Forest, cid cidSDK.ID, treeID
 		require.Equal(t, expectedParent, actualParent, "node id: %d", i)
 		require.Equal(t, expectedMeta, actualMeta, "node id: %d", i)
 
+		expectedChildren, err := expected.TreeGetSortedChildren(cid, treeID, i, AttributeFilename, nil, 0)
+		require.NoError(t, err)
+		actualChildren, err := actual.TreeGetSortedChildren(cid, treeID, i, AttributeFilename, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, expectedChildren, actualChildren, "node id: %d", i)
+
 		if ma, ok := actual.(*memoryForest); ok {
 			me := expected.(*memoryForest)
 			require.Equal(t, len(me.treeMap), len(ma.treeMap))
@@ -1029,4 +1379,389 @@ func testTreeGetTrees(t *testing.T, s Forest) {
 
 		require.ElementsMatch(t, treeIDs[cid], trees)
 	}
+
+	var expected []ContainerIDTreeID
+	for cid, ids := range treeIDs {
+		for _, treeID := range ids {
+			expected = append(expected, ContainerIDTreeID{CID: cid, TreeID: treeID})
+		}
+	}
+
+	all, err := s.(ForestStorage).TreeListTrees()
+	require.NoError(t, err)
+	require.ElementsMatch(t, expected, all)
+}
+
+func TestForest_TreeCompact(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeCompact(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestTreeCompact(t *testing.T, constructor func(t testing.TB, _ ...Option) Forest) {
+	rand.Seed(42)
+
+	const (
+		nodeCount = 5
+		opCount   = 40
+	)
+
+	ops := prepareRandomTree(nodeCount, opCount)
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	compareMeta := func(t *testing.T, expected, actual Forest) {
+		for i := uint64(0); i < nodeCount+10; i++ {
+			expectedMeta, expectedParent, err := expected.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			actualMeta, actualParent, err := actual.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			require.Equal(t, expectedParent, actualParent, "node id: %d", i)
+			require.Equal(t, expectedMeta, actualMeta, "node id: %d", i)
+		}
+	}
+
+	expected := constructor(t)
+	s := constructor(t)
+	for i := range ops {
+		require.NoError(t, expected.TreeApply(d, treeID, &ops[i], false))
+		require.NoError(t, s.TreeApply(d, treeID, &ops[i], false))
+	}
+
+	t.Run("missing tree", func(t *testing.T) {
+		require.ErrorIs(t, s.TreeCompact(cid, "missing", 1), ErrTreeNotFound)
+	})
+
+	height := ops[len(ops)/2].Time
+	require.NoError(t, s.TreeCompact(cid, treeID, height))
+	compareMeta(t, expected, s)
+
+	lm, err := s.TreeGetOpLog(cid, treeID, 0)
+	require.NoError(t, err)
+	require.Equal(t, ops[len(ops)/2], lm)
+
+	t.Run("compaction below the snapshot is ignored", func(t *testing.T) {
+		require.NoError(t, s.TreeCompact(cid, treeID, height-1))
+
+		h, _, err := s.TreeGetSnapshot(cid, treeID, 0, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, height, h)
+	})
+
+	t.Run("operations below the snapshot are ignored", func(t *testing.T) {
+		op := ops[0]
+		op.Parent = TrashID
+		require.NoError(t, s.TreeApply(d, treeID, &op, false))
+		compareMeta(t, expected, s)
+	})
+
+	var snapshot []SnapshotNode
+	var cursor SnapshotCursor
+	for {
+		h, nodes, err := s.TreeGetSnapshot(cid, treeID, 0, &cursor, 2)
+		require.NoError(t, err)
+		require.Equal(t, height, h)
+		if len(nodes) == 0 {
+			break
+		}
+		require.True(t, len(nodes) <= 2)
+
+		snapshot = append(snapshot, nodes...)
+	}
+
+	t.Run("bootstrap from snapshot", func(t *testing.T) {
+		actual := constructor(t)
+		for i := 0; i < len(snapshot); i += 2 {
+			end := i + 2
+			if end > len(snapshot) {
+				end = len(snapshot)
+			}
+			require.NoError(t, actual.TreeApplySnapshot(d, treeID, height, snapshot[i:end], true))
+
+			h, _, err := actual.TreeGetSnapshot(cid, treeID, 0, nil, 0)
+			require.NoError(t, err)
+			require.Zero(t, h, "the snapshot must not be applied until the last part")
+		}
+		require.NoError(t, actual.TreeApplySnapshot(d, treeID, height, nil, false))
+		for h := uint64(0); ; {
+			lm, err := s.TreeGetOpLog(cid, treeID, h)
+			require.NoError(t, err)
+			if lm.Time == 0 {
+				break
+			}
+			require.NoError(t, actual.TreeApply(d, treeID, &lm, true))
+			h = lm.Time + 1
+		}
+		compareMeta(t, expected, actual)
+	})
+
+	t.Run("rebase on snapshot", func(t *testing.T) {
+		actual := constructor(t)
+
+		tail := append([]Move(nil), ops[len(ops)/2:]...)
+		rand.Shuffle(len(tail), func(i, j int) { tail[i], tail[j] = tail[j], tail[i] })
+		for i := range tail {
+			require.NoError(t, actual.TreeApply(d, treeID, &tail[i], false))
+		}
+
+		require.NoError(t, actual.TreeApplySnapshot(d, treeID, height, snapshot, false))
+		compareMeta(t, expected, actual)
+
+		// Older snapshot must not be applied.
+		require.NoError(t, actual.TreeApplySnapshot(d, treeID, height-1, nil, false))
+		compareMeta(t, expected, actual)
+	})
+
+	t.Run("new operations are above the snapshot", func(t *testing.T) {
+		last := ops[len(ops)-1].Time
+		require.NoError(t, s.TreeCompact(cid, treeID, last+10))
+
+		lm, err := s.TreeGetOpLog(cid, treeID, 0)
+		require.NoError(t, err)
+		require.Equal(t, Move{}, lm)
+
+		m, err := s.TreeMove(d, treeID, &Move{Parent: RootID, Child: RootID})
+		require.NoError(t, err)
+		require.True(t, m.Time >= last+10)
+	})
+
+	t.Run("operations between pages are ignored", func(t *testing.T) {
+		getPages := func(cursor *SnapshotCursor, h uint64, f func()) []SnapshotNode {
+			var res []SnapshotNode
+			for {
+				_, nodes, err := s.TreeGetSnapshot(cid, treeID, h, cursor, 1)
+				require.NoError(t, err)
+				if len(nodes) == 0 {
+					return res
+				}
+				res = append(res, nodes...)
+				if f != nil {
+					f()
+					f = nil
+				}
+			}
+		}
+
+		h, _, err := s.TreeGetSnapshot(cid, treeID, 0, nil, 0)
+		require.NoError(t, err)
+
+		expected := getPages(new(SnapshotCursor), h, nil)
+		require.NotEmpty(t, expected)
+
+		actual := getPages(new(SnapshotCursor), h, func() {
+			for i := Node(1); i < nodeCount+5; i++ {
+				_, err := s.TreeMove(d, treeID, &Move{Parent: TrashID, Child: i})
+				require.NoError(t, err)
+			}
+		})
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestBoltForest_ApplySnapshotInterrupted(t *testing.T) {
+	rand.Seed(42)
+
+	const nodeCount = 5
+
+	ops := prepareRandomTree(nodeCount, 40)
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	expected := NewMemoryForest()
+	for i := range ops {
+		require.NoError(t, expected.TreeApply(d, treeID, &ops[i], false))
+	}
+
+	getSnapshot := func(height uint64) []SnapshotNode {
+		s := NewMemoryForest()
+		for i := range ops {
+			require.NoError(t, s.TreeApply(d, treeID, &ops[i], false))
+		}
+		require.NoError(t, s.TreeCompact(cid, treeID, height))
+
+		_, nodes, err := s.TreeGetSnapshot(cid, treeID, height, nil, len(ops))
+		require.NoError(t, err)
+		return nodes
+	}
+
+	path := filepath.Join(t.TempDir(), "test.db")
+	f := NewBoltForest(WithPath(path))
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	for i := range ops {
+		require.NoError(t, f.TreeApply(d, treeID, &ops[i], false))
+	}
+
+	checkTree := func(t *testing.T, active []byte) {
+		for i := uint64(0); i < nodeCount+10; i++ {
+			expectedMeta, expectedParent, err := expected.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			actualMeta, actualParent, err := f.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			require.Equal(t, expectedParent, actualParent, "node id: %d", i)
+			require.Equal(t, expectedMeta, actualMeta, "node id: %d", i)
+		}
+
+		require.NoError(t, f.(*boltForest).db.View(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(bucketName(cid, treeID))
+			require.Equal(t, active, activeDataBucket(treeRoot))
+			require.Nil(t, treeRoot.Bucket(unusedDataBucket(treeRoot)), "unused data must be removed")
+			require.Nil(t, treeRoot.Bucket(snapshotBucket))
+			return nil
+		}))
+	}
+
+	height := ops[len(ops)/4].Time
+	require.NoError(t, f.TreeApplySnapshot(d, treeID, height, getSnapshot(height), false))
+	checkTree(t, rebuildDataBucket)
+
+	t.Run("resume after restart", func(t *testing.T) {
+		height := ops[len(ops)/2].Time
+		snapshot := getSnapshot(height)
+		require.NoError(t, f.TreeApplySnapshot(d, treeID, height, snapshot, true))
+
+		// Start the rebuild with a part of the nodes as if it was interrupted.
+		require.NoError(t, f.(*boltForest).db.Update(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(bucketName(cid, treeID))
+			bSnapshot := treeRoot.Bucket(snapshotBucket)
+			bTree, err := f.(*boltForest).getRebuildBucket(treeRoot, bSnapshot)
+			require.NoError(t, err)
+
+			var key [17]byte
+			n := snapshot[0]
+			require.NoError(t, f.(*boltForest).addNode(bTree, key[:], n.ID, n.Parent, n.Time, n.Meta, n.Meta.Bytes()))
+			return bSnapshot.Delete(stateKey(key[:], n.ID))
+		}))
+
+		require.NoError(t, f.Close())
+		require.NoError(t, f.Open(false))
+		require.NoError(t, f.Init())
+
+		require.NoError(t, f.(*boltForest).db.View(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(bucketName(cid, treeID))
+			require.NotNil(t, treeRoot.Bucket(unusedDataBucket(treeRoot)), "rebuilt data must be kept")
+			return nil
+		}))
+
+		require.NoError(t, f.TreeApplySnapshot(d, treeID, height, snapshot[1:], false))
+		checkTree(t, dataBucket)
+	})
+
+	t.Run("unused data is removed on init", func(t *testing.T) {
+		require.NoError(t, f.(*boltForest).db.Update(func(tx *bbolt.Tx) error {
+			b, err := tx.Bucket(bucketName(cid, treeID)).CreateBucket(rebuildDataBucket)
+			require.NoError(t, err)
+			return b.Put([]byte{'t'}, []byte{})
+		}))
+
+		require.NoError(t, f.Close())
+		require.NoError(t, f.Open(false))
+		require.NoError(t, f.Init())
+		checkTree(t, dataBucket)
+	})
+}
+
+func TestForest_ExportImport(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestExportImport(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestExportImport(t *testing.T, constructor func(t testing.TB, _ ...Option) Forest) {
+	rand.Seed(42)
+
+	const (
+		nodeCount = 5
+		opCount   = 40
+	)
+
+	ops := prepareRandomTree(nodeCount, opCount)
+	for i := range ops {
+		// Zero timestamp denotes the end of the operation log.
+		ops[i].Time++
+	}
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	s := constructor(t)
+	for i := range ops {
+		require.NoError(t, s.TreeApply(d, treeID, &ops[i], false))
+	}
+
+	compareTrees := func(t *testing.T, expected, actual Forest) {
+		for i := uint64(0); i < nodeCount+10; i++ {
+			expectedMeta, expectedParent, err := expected.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			actualMeta, actualParent, err := actual.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			require.Equal(t, expectedParent, actualParent, "node id: %d", i)
+			require.Equal(t, expectedMeta, actualMeta, "node id: %d", i)
+		}
+	}
+
+	check := func(t *testing.T, snapshot bool, expectedHeight uint64, expectedOps int) {
+		var buf bytes.Buffer
+		info, err := ExportTree(&buf, s, cid, treeID, snapshot)
+		require.NoError(t, err)
+		require.Equal(t, expectedHeight, info.Height)
+		require.Equal(t, expectedOps, info.Operations)
+
+		actual := constructor(t)
+		imported, err := ImportTree(&buf, actual)
+		require.NoError(t, err)
+		require.Equal(t, info, imported)
+		compareTrees(t, s, actual)
+
+		lm, err := actual.TreeGetOpLog(cid, treeID, 0)
+		require.NoError(t, err)
+		if expectedOps == 0 {
+			require.Equal(t, Move{}, lm)
+		} else {
+			require.Equal(t, ops[len(ops)-expectedOps], lm)
+		}
+	}
+
+	t.Run("log", func(t *testing.T) {
+		check(t, false, 0, len(ops))
+	})
+	t.Run("snapshot", func(t *testing.T) {
+		check(t, true, ops[len(ops)-1].Time+1, 0)
+	})
+
+	height := ops[len(ops)/2].Time
+	require.NoError(t, s.TreeCompact(cid, treeID, height))
+
+	t.Run("compacted log", func(t *testing.T) {
+		check(t, false, height, len(ops)-len(ops)/2)
+	})
+	t.Run("snapshot of compacted log", func(t *testing.T) {
+		check(t, true, ops[len(ops)-1].Time+1, 0)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := ExportTree(&buf, s, cid, treeID, false)
+		require.NoError(t, err)
+
+		data := buf.Bytes()
+
+		_, err = ImportTree(bytes.NewReader(data[:len(data)-1]), constructor(t))
+		require.ErrorIs(t, err, ErrInvalidExport)
+
+		data[0]++
+		_, err = ImportTree(bytes.NewReader(data), constructor(t))
+		require.ErrorIs(t, err, ErrInvalidExport)
+	})
 }
@@ -1,5 +1,7 @@
 package pilorama
 
+import "sort"
+
 // nodeInfo couples parent and metadata.
 type nodeInfo struct {
 	Parent Node
@@ -15,6 +17,11 @@ type move struct {
 // state represents state being replicated.
 type state struct {
 	operations []move
+	// height is the snapshot height, operations below it are removed from the log.
+	height Timestamp
+	// pending contains the nodes of the snapshot being applied at pendingHeight.
+	pending       []SnapshotNode
+	pendingHeight Timestamp
 	tree
 }
 
@@ -56,6 +63,11 @@ func (s *state) undo(op *move) {
 // Apply puts op in log at a proper position, re-applies all subsequent operations
 // from log and changes s in-place.
 func (s *state) Apply(op *Move) error {
+	if op.Time < s.height {
+		// The operation is already included in the snapshot.
+		return nil
+	}
+
 	var index int
 	for index = len(s.operations); index > 0; index-- {
 		if s.operations[index-1].Time <= op.Time {
@@ -128,11 +140,79 @@ func (s *state) removeChild(child, parent Node) {
 
 func (s *state) timestamp(pos, size int) Timestamp {
 	if len(s.operations) == 0 {
+		if s.height != 0 {
+			return nextTimestamp(s.height-1, uint64(pos), uint64(size))
+		}
 		return nextTimestamp(0, uint64(pos), uint64(size))
 	}
 	return nextTimestamp(s.operations[len(s.operations)-1].Time, uint64(pos), uint64(size))
 }
 
+// compact removes operations below the height from the log.
+func (s *state) compact(height Timestamp) {
+	if height <= s.height {
+		return
+	}
+
+	n := sort.Search(len(s.operations), func(i int) bool {
+		return s.operations[i].Time >= height
+	})
+	s.operations = append([]move(nil), s.operations[n:]...)
+	s.height = height
+}
+
+// snapshot returns the tree state at the height.
+func (s *state) snapshot(height Timestamp) map[Node]nodeInfo {
+	res := make(map[Node]nodeInfo, len(s.infoMap))
+	for id, info := range s.infoMap {
+		res[id] = info
+	}
+
+	for i := len(s.operations) - 1; i >= 0 && s.operations[i].Time >= height; i-- {
+		if s.operations[i].HasOld {
+			res[s.operations[i].Child] = s.operations[i].Old
+		} else {
+			delete(res, s.operations[i].Child)
+		}
+	}
+	return res
+}
+
+// applySnapshot replaces the tree state with the snapshot and re-applies
+// the operations stored at or above the height.
+func (s *state) applySnapshot(height Timestamp, nodes []SnapshotNode, more bool) {
+	if height <= s.height {
+		s.pending = nil
+		return
+	}
+
+	if s.pendingHeight != height {
+		s.pending = nil
+		s.pendingHeight = height
+	}
+	s.pending = append(s.pending, nodes...)
+	if more {
+		return
+	}
+
+	nodes, s.pending = s.pending, nil
+
+	s.tree = *newTree()
+	for i := range nodes {
+		s.infoMap[nodes[i].ID] = nodeInfo{Parent: nodes[i].Parent, Meta: nodes[i].Meta}
+		s.childMap[nodes[i].Parent] = append(s.childMap[nodes[i].Parent], nodes[i].ID)
+	}
+
+	ops := s.operations
+	s.operations = nil
+	s.height = height
+	for i := range ops {
+		if ops[i].Time >= height {
+			s.operations = append(s.operations, s.do(&ops[i].Move))
+		}
+	}
+}
+
 func (s *state) findSpareID() Node {
 	id := uint64(1)
 	for _, ok := s.infoMap[id]; ok; _, ok = s.infoMap[id] {
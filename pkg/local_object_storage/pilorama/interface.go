@@ -16,6 +16,10 @@ type Forest interface {
 	// The path is constructed by descending from the root using the values of the attr in meta.
 	// Internal nodes in path should have exactly one attribute, otherwise a new node is created.
 	TreeAddByPath(d CIDDescriptor, treeID string, attr string, path []string, meta []KeyValue) ([]Move, error)
+	// TreeBatch applies the operations to the tree atomically: either all operations are applied or none.
+	// Operations are applied in order the same way as with TreeMove or TreeAddByPath,
+	// the resulting log operations are returned for each of them.
+	TreeBatch(d CIDDescriptor, treeID string, ops []BatchOperation) ([][]Move, error)
 	// TreeApply applies replicated operation from another node.
 	// If background is true, TreeApply will first check whether an operation exists.
 	TreeApply(d CIDDescriptor, treeID string, m *Move, backgroundSync bool) error
@@ -31,6 +35,14 @@ type Forest interface {
 	// TreeGetChildren returns children of the node with the specified ID. The order is arbitrary.
 	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
 	TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node) ([]uint64, error)
+	// TreeGetSortedChildren returns children of the node with the specified ID sorted by the value
+	// of the attr in meta and then by ID. Children are returned starting after the cursor, nil
+	// cursor means starting from the beginning. At most count children are returned, zero count
+	// means no limit. Children without the attribute or with the attribute too large to be
+	// indexed are not returned. Should return ErrUnsortedAttribute if the children can't be
+	// sorted by the attribute, only AttributeFilename is sortable by default.
+	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
+	TreeGetSortedChildren(cid cidSDK.ID, treeID string, nodeID Node, attr string, after *SortCursor, count int) ([]Node, error)
 	// TreeGetOpLog returns first log operation stored at or above the height.
 	// In case no such operation is found, empty Move and nil error should be returned.
 	TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error)
@@ -44,6 +56,25 @@ type Forest interface {
 	// TreeExists checks if a tree exists locally.
 	// If the tree is not found, false and a nil error should be returned.
 	TreeExists(cid cidSDK.ID, treeID string) (bool, error)
+	// TreeCompact removes log operations with timestamps below the height from the tree.
+	// The tree state is kept as a snapshot, operations below the snapshot height can no
+	// longer be applied and are ignored by TreeApply. Compaction below the current snapshot
+	// height is a no-op. Should return ErrTreeNotFound if the tree is not found.
+	TreeCompact(cid cidSDK.ID, treeID string, height uint64) error
+	// TreeGetSnapshot returns the snapshot height and at most count nodes of the tree
+	// state at this height. Zero height means the height the tree log was compacted to,
+	// the height must not be less than it, otherwise ErrInvalidSnapshotHeight is returned.
+	// Nodes are returned in a stable order starting from the cursor position, the cursor
+	// is advanced past the returned nodes. Nil cursor means starting from the beginning.
+	// Should return ErrTreeNotFound if the tree is not found.
+	TreeGetSnapshot(cid cidSDK.ID, treeID string, height uint64, cursor *SnapshotCursor, count int) (uint64, []SnapshotNode, error)
+	// TreeApplySnapshot replaces the tree state below the height with the snapshot nodes.
+	// Operations stored at or above the height are re-applied on top of the snapshot,
+	// operations below are removed. If the tree snapshot height is not less than height,
+	// the snapshot is ignored. The tree is created if it doesn't exist.
+	// Large snapshots can be applied in parts: if more is set, the nodes are saved until
+	// the call with more unset and the same height, the tree is not changed until then.
+	TreeApplySnapshot(d CIDDescriptor, treeID string, height uint64, nodes []SnapshotNode, more bool) error
 }
 
 type ForestStorage interface {
@@ -54,6 +85,9 @@ type ForestStorage interface {
 	Close() error
 	SetMode(m mode.Mode) error
 	Forest
+
+	// TreeListTrees returns identifiers of all trees stored in the forest.
+	TreeListTrees() ([]ContainerIDTreeID, error)
 }
 
 const (
@@ -61,6 +95,12 @@ const (
 	AttributeVersion  = "Version"
 )
 
+// ContainerIDTreeID identifies a tree in the forest.
+type ContainerIDTreeID struct {
+	CID    cidSDK.ID
+	TreeID string
+}
+
 // CIDDescriptor contains container ID and information about the node position
 // in the list of container nodes.
 type CIDDescriptor struct {
@@ -0,0 +1,6 @@
+package pilorama
+
+import "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
+
+// MetricsWriter is an interface that must store pilorama metrics.
+type MetricsWriter = util.MethodDurationWriter
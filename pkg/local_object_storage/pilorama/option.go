@@ -13,6 +13,7 @@ type cfg struct {
 	noSync        bool
 	maxBatchDelay time.Duration
 	maxBatchSize  int
+	metrics       MetricsWriter
 }
 
 func WithPath(path string) Option {
@@ -44,3 +45,9 @@ func WithMaxBatchSize(size int) Option {
 		c.maxBatchSize = size
 	}
 }
+
+func WithMetrics(m MetricsWriter) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
@@ -1,7 +1,9 @@
 package pilorama
 
 import (
+	"bytes"
 	"math"
+	"sort"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 )
@@ -35,6 +37,43 @@ type Move struct {
 	Child Node
 }
 
+// BatchOperation represents a single operation of the tree batch.
+type BatchOperation struct {
+	// Move is applied the same way as with TreeMove if PathAttr is empty.
+	Move
+	// PathAttr is the attribute used to construct the path. If not empty,
+	// the node with Move meta is added the same way as with TreeAddByPath,
+	// Move parent and child are ignored in this case.
+	PathAttr string
+	// Path is the path to the parent of the new node.
+	Path []string
+}
+
+// SnapshotNode represents the state of a single node in a tree snapshot.
+type SnapshotNode struct {
+	ID     Node
+	Parent Node
+	// Time is the timestamp of the first node appearance in the tree.
+	Time Timestamp
+	Meta Meta
+}
+
+// SnapshotCursor is a position in the tree snapshot returned by TreeGetSnapshot.
+// Zero value points to the beginning of the snapshot. The cursor is advanced by
+// each call, so it must be reused to get the next nodes of the same snapshot.
+type SnapshotCursor struct {
+	// last is the ID of the last returned node, valid if started is set.
+	last    Node
+	started bool
+	// height is the snapshot height the cursor was used with.
+	height uint64
+	// moved contains the nodes moved above the snapshot height together with
+	// the timestamp of the first such move. It is filled incrementally, logHeight
+	// is the timestamp the log is to be scanned from on the next call.
+	moved     map[Node]Timestamp
+	logHeight Timestamp
+}
+
 const (
 	// RootID represents the ID of a root node.
 	RootID = 0
@@ -48,6 +87,12 @@ var (
 	// ErrNotPathAttribute is returned when the path is trying to be constructed with a non-internal
 	// attribute. Currently the only attribute allowed is AttributeFilename.
 	ErrNotPathAttribute = logicerr.New("attribute can't be used in path construction")
+	// ErrInvalidSnapshotHeight is returned when the requested snapshot height is below
+	// the height the tree log was compacted to.
+	ErrInvalidSnapshotHeight = logicerr.New("snapshot height is below the compacted log")
+	// ErrUnsortedAttribute is returned when the children are requested to be sorted
+	// by the attribute which is not put in the sorted children index.
+	ErrUnsortedAttribute = logicerr.New("children can't be sorted by the attribute")
 )
 
 // isAttributeInternal returns true iff key can be used in `*ByPath` methods.
@@ -55,3 +100,55 @@ var (
 func isAttributeInternal(key string) bool {
 	return key == AttributeFilename
 }
+
+// maxSortedAttributeSize is the maximum total size of the attribute name and value
+// for the attribute to be used in TreeGetSortedChildren.
+const maxSortedAttributeSize = 8 << 10
+
+// sortedAttributes are the attributes put in the sorted children index. The set
+// is the same on all the nodes, so the children of a tree can be sorted by the
+// same attributes on any container node.
+var sortedAttributes = []string{AttributeFilename}
+
+// isAttributeSortable returns true iff the attribute is small enough to be put
+// in the sorted children index.
+func isAttributeSortable(kv KeyValue) bool {
+	return len(kv.Key)+len(kv.Value) <= maxSortedAttributeSize
+}
+
+// sortedAttributeSet returns the set of the sorted attributes and its
+// canonical binary representation stored together with the index.
+func sortedAttributeSet(attrs []string) (map[string]struct{}, []byte) {
+	set := make(map[string]struct{}, len(attrs))
+	for i := range attrs {
+		set[attrs[i]] = struct{}{}
+	}
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var raw []byte
+	for i := range names {
+		raw = append(raw, names[i]...)
+		raw = append(raw, 0)
+	}
+	return set, raw
+}
+
+// SortCursor denotes the position in the list of children sorted by the attribute.
+type SortCursor struct {
+	// Value is the attribute value of the last returned child.
+	Value []byte
+	// ID is the ID of the last returned child.
+	ID Node
+}
+
+func (c *SortCursor) less(other *SortCursor) bool {
+	if cmp := bytes.Compare(c.Value, other.Value); cmp != 0 {
+		return cmp < 0
+	}
+	return c.ID < other.ID
+}
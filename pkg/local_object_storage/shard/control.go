@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -153,6 +154,8 @@ func (s *Shard) Init() error {
 
 	s.gc.init()
 
+	s.initScrubber()
+
 	return nil
 }
 
@@ -222,7 +225,7 @@ func (s *Shard) refillMetabase() error {
 		mPrm.SetObject(obj)
 		mPrm.SetStorageID(descriptor)
 
-		_, err := s.metaBase.Put(mPrm)
+		_, err := s.metaBase.Put(context.Background(), mPrm)
 		if err != nil && !meta.IsErrRemoved(err) && !errors.Is(err, meta.ErrObjectIsExpired) {
 			return err
 		}
@@ -243,6 +246,8 @@ func (s *Shard) refillMetabase() error {
 
 // Close releases all Shard's components.
 func (s *Shard) Close() error {
+	s.stopScrubber()
+
 	components := []interface{ Close() error }{}
 
 	if s.pilorama != nil {
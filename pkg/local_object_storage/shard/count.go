@@ -0,0 +1,26 @@
+package shard
+
+import (
+	"fmt"
+
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+)
+
+// ObjectCounters returns object counters of the shard metabase.
+//
+// Returns ErrDegradedMode if the shard is in degraded mode.
+func (s *Shard) ObjectCounters() (meta.ObjectCounters, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return meta.ObjectCounters{}, ErrDegradedMode
+	}
+
+	cc, err := s.metaBase.ObjectCounters()
+	if err != nil {
+		return meta.ObjectCounters{}, fmt.Errorf("could not get object counters: %w", err)
+	}
+
+	return cc, nil
+}
@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"math/rand"
 	"os"
@@ -102,7 +103,7 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 
 		var prm shard.PutPrm
 		prm.SetObject(objects[i])
-		_, err := sh.Put(prm)
+		_, err := sh.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
@@ -232,7 +233,7 @@ func TestStream(t *testing.T) {
 
 		var prm shard.PutPrm
 		prm.SetObject(objects[i])
-		_, err := sh1.Put(prm)
+		_, err := sh1.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
@@ -275,7 +276,7 @@ func checkRestore(t *testing.T, sh *shard.Shard, prm shard.RestorePrm, objects [
 
 	for i := range objects {
 		getPrm.SetAddress(object.AddressOf(objects[i]))
-		res, err := sh.Get(getPrm)
+		res, err := sh.Get(context.Background(), getPrm)
 		require.NoError(t, err)
 		require.Equal(t, objects[i], res.Object())
 	}
@@ -331,7 +332,7 @@ func TestDumpIgnoreErrors(t *testing.T) {
 
 		var prm shard.PutPrm
 		prm.SetObject(objects[i])
-		_, err := sh.Put(prm)
+		_, err := sh.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
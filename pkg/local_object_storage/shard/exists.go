@@ -1,6 +1,8 @@
 package shard
 
 import (
+	"context"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -52,7 +54,7 @@ func (s *Shard) Exists(prm ExistsPrm) (ExistsRes, error) {
 		existsPrm.SetAddress(prm.addr)
 
 		var res meta.ExistsRes
-		res, err = s.metaBase.Exists(existsPrm)
+		res, err = s.metaBase.Exists(context.Background(), existsPrm)
 		exists = res.Exists()
 	}
 
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
@@ -8,9 +9,12 @@ import (
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -61,7 +65,15 @@ func (r GetRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Get(prm GetPrm) (GetRes, error) {
+func (s *Shard) Get(ctx context.Context, prm GetPrm) (_ GetRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "Shard.Get",
+		trace.WithAttributes(
+			s.idAttribute(),
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("skip_meta", prm.skipMeta),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -70,7 +82,7 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 		getPrm.Address = prm.addr
 		getPrm.StorageID = id
 
-		res, err := stor.Get(getPrm)
+		res, err := stor.Get(ctx, getPrm)
 		if err != nil {
 			return nil, err
 		}
@@ -79,11 +91,11 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 	}
 
 	wc := func(c writecache.Cache) (*objectSDK.Object, error) {
-		return c.Get(prm.addr)
+		return c.Get(ctx, prm.addr)
 	}
 
 	skipMeta := prm.skipMeta || s.info.Mode.NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	obj, hasMeta, err := s.fetchObjectData(ctx, prm.addr, skipMeta, cb, wc)
 
 	return GetRes{
 		obj:     obj,
@@ -96,7 +108,7 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 var emptyStorageID = make([]byte, 0)
 
 // fetchObjectData looks through writeCache and blobStor to find object.
-func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
+func (s *Shard) fetchObjectData(ctx context.Context, addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
 	var (
 		mErr error
 		mRes meta.ExistsRes
@@ -107,7 +119,7 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 		var mPrm meta.ExistsPrm
 		mPrm.SetAddress(addr)
 
-		mRes, mErr = s.metaBase.Exists(mPrm)
+		mRes, mErr = s.metaBase.Exists(ctx, mPrm)
 		if mErr != nil && !s.info.Mode.NoMetabase() {
 			return nil, false, mErr
 		}
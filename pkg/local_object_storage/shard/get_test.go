@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -38,7 +39,7 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 
 		putPrm.SetObject(obj)
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		getPrm.SetAddress(object.AddressOf(obj))
@@ -56,7 +57,7 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 
 		putPrm.SetObject(obj)
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		getPrm.SetAddress(object.AddressOf(obj))
@@ -84,7 +85,7 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 
 		putPrm.SetObject(child)
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		getPrm.SetAddress(object.AddressOf(child))
@@ -110,11 +111,11 @@ func testShardGet(t *testing.T, hasWriteCache bool) {
 }
 
 func testGet(t *testing.T, sh *shard.Shard, getPrm shard.GetPrm, hasWriteCache bool) (shard.GetRes, error) {
-	res, err := sh.Get(getPrm)
+	res, err := sh.Get(context.Background(), getPrm)
 	if hasWriteCache {
 		require.Eventually(t, func() bool {
 			if shard.IsErrNotFound(err) {
-				res, err = sh.Get(getPrm)
+				res, err = sh.Get(context.Background(), getPrm)
 			}
 			return !shard.IsErrNotFound(err)
 		}, time.Second, time.Millisecond*100)
@@ -1,9 +1,14 @@
 package shard
 
 import (
+	"context"
+
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // HeadPrm groups the parameters of Head operation.
@@ -43,16 +48,23 @@ func (r HeadRes) Object() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectNotFound if object is missing in Shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
+func (s *Shard) Head(ctx context.Context, prm HeadPrm) (_ HeadRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "Shard.Head",
+		trace.WithAttributes(
+			s.idAttribute(),
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("raw", prm.raw),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	var obj *objectSDK.Object
-	var err error
 	if s.GetMode().NoMetabase() {
 		var getPrm GetPrm
 		getPrm.SetAddress(prm.addr)
 		getPrm.SetIgnoreMeta(true)
 
 		var res GetRes
-		res, err = s.Get(getPrm)
+		res, err = s.Get(ctx, getPrm)
 		obj = res.Object()
 	} else {
 		var headParams meta.GetPrm
@@ -60,7 +72,7 @@ func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
 		headParams.SetRaw(prm.raw)
 
 		var res meta.GetRes
-		res, err = s.metaBase.Get(headParams)
+		res, err = s.metaBase.Get(ctx, headParams)
 		obj = res.Header()
 	}
 
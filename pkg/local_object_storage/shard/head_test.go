@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -35,7 +36,7 @@ func testShardHead(t *testing.T, hasWriteCache bool) {
 
 		putPrm.SetObject(obj)
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		headPrm.SetAddress(object.AddressOf(obj))
@@ -60,7 +61,7 @@ func testShardHead(t *testing.T, hasWriteCache bool) {
 
 		putPrm.SetObject(child)
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		headPrm.SetAddress(object.AddressOf(parent))
@@ -74,18 +75,18 @@ func testShardHead(t *testing.T, hasWriteCache bool) {
 		headPrm.SetAddress(object.AddressOf(parent))
 		headPrm.SetRaw(false)
 
-		head, err := sh.Head(headPrm)
+		head, err := sh.Head(context.Background(), headPrm)
 		require.NoError(t, err)
 		require.Equal(t, parent.CutPayload(), head.Object())
 	})
 }
 
 func testHead(t *testing.T, sh *shard.Shard, headPrm shard.HeadPrm, hasWriteCache bool) (shard.HeadRes, error) {
-	res, err := sh.Head(headPrm)
+	res, err := sh.Head(context.Background(), headPrm)
 	if hasWriteCache {
 		require.Eventually(t, func() bool {
 			if shard.IsErrNotFound(err) {
-				res, err = sh.Head(headPrm)
+				res, err = sh.Head(context.Background(), headPrm)
 			}
 			return !shard.IsErrNotFound(err)
 		}, time.Second, time.Millisecond*100)
@@ -3,6 +3,7 @@ package shard
 import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"github.com/mr-tron/base58"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -26,6 +27,17 @@ func (s *Shard) ID() *ID {
 	return s.info.ID
 }
 
+// idAttribute returns shard identifier as a tracing span attribute.
+// ID is empty until it is read from the metabase.
+func (s *Shard) idAttribute() attribute.KeyValue {
+	var id string
+	if s.info.ID != nil {
+		id = s.info.ID.String()
+	}
+
+	return attribute.String("shard_id", id)
+}
+
 // UpdateID reads shard ID saved in the metabase and updates it if it is missing.
 func (s *Shard) UpdateID() (err error) {
 	if err = s.metaBase.Open(false); err != nil {
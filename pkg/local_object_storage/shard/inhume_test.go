@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -40,7 +41,7 @@ func testShardInhume(t *testing.T, hasWriteCache bool) {
 	var getPrm shard.GetPrm
 	getPrm.SetAddress(object.AddressOf(obj))
 
-	_, err := sh.Put(putPrm)
+	_, err := sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	_, err = testGet(t, sh, getPrm, hasWriteCache)
@@ -49,6 +50,6 @@ func testShardInhume(t *testing.T, hasWriteCache bool) {
 	_, err = sh.Inhume(inhPrm)
 	require.NoError(t, err)
 
-	_, err = sh.Get(getPrm)
+	_, err = sh.Get(context.Background(), getPrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 }
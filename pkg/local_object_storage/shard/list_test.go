@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -51,7 +52,7 @@ func testShardList(t *testing.T, sh *shard.Shard) {
 
 			putPrm.SetObject(obj)
 
-			_, err := sh.Put(putPrm)
+			_, err := sh.Put(context.Background(), putPrm)
 			require.NoError(t, err)
 		}
 	}
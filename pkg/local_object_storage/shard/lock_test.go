@@ -74,7 +74,7 @@ func TestShard_Lock(t *testing.T) {
 	var putPrm shard.PutPrm
 	putPrm.SetObject(obj)
 
-	_, err := sh.Put(putPrm)
+	_, err := sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	// lock the object
@@ -83,7 +83,7 @@ func TestShard_Lock(t *testing.T) {
 	require.NoError(t, err)
 
 	putPrm.SetObject(lock)
-	_, err = sh.Put(putPrm)
+	_, err = sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	t.Run("inhuming locked objects", func(t *testing.T) {
@@ -136,7 +136,7 @@ func TestShard_Lock(t *testing.T) {
 		var getPrm shard.GetPrm
 		getPrm.SetAddress(objectcore.AddressOf(obj))
 
-		_, err = sh.Get(getPrm)
+		_, err = sh.Get(context.Background(), getPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 }
@@ -156,7 +156,7 @@ func TestShard_IsLocked(t *testing.T) {
 	var putPrm shard.PutPrm
 	putPrm.SetObject(obj)
 
-	_, err := sh.Put(putPrm)
+	_, err := sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	// not locked object is not locked
@@ -0,0 +1,51 @@
+package shard
+
+import (
+	"time"
+)
+
+// Storage components of the shard used as the metric labels.
+// BlobStor sub-storages are labeled with their types.
+const (
+	componentWriteCache = "writecache"
+	componentMetabase   = "metabase"
+	componentPilorama   = "pilorama"
+)
+
+// componentMetrics passes the metrics of a single shard
+// component to the MetricsWriter.
+type componentMetrics struct {
+	component string
+	mw        MetricsWriter
+}
+
+func (m componentMetrics) AddMethodDuration(method string, success bool, d time.Duration) {
+	m.mw.AddMethodDuration(m.component, method, success, d)
+}
+
+// writeCacheMetrics passes the write-cache metrics to the MetricsWriter.
+type writeCacheMetrics struct {
+	componentMetrics
+}
+
+func (m writeCacheMetrics) SetObjectCounter(storage string, v uint64) {
+	m.mw.SetWriteCacheObjectCounter(storage, v)
+}
+
+func (m writeCacheMetrics) SetSize(storage string, v uint64) {
+	m.mw.SetWriteCacheSize(storage, v)
+}
+
+func (m writeCacheMetrics) AddFlush(success bool, size uint64) {
+	m.mw.AddWriteCacheFlush(success, size)
+}
+
+// blobStorMetrics passes the metrics of BlobStor sub-storages
+// to the MetricsWriter.
+type blobStorMetrics struct {
+	mw MetricsWriter
+}
+
+func (m blobStorMetrics) AddMethodDuration(storage, method string, success bool, d time.Duration) {
+	m.mw.AddMethodDuration(storage, method, success, d)
+}
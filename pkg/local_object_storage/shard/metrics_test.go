@@ -1,8 +1,11 @@
 package shard_test
 
 import (
+	"context"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
@@ -11,6 +14,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/stretchr/testify/require"
@@ -21,6 +25,20 @@ type metricsStore struct {
 	cnrSize     map[string]int64
 	pldSize     int64
 	readOnly    bool
+
+	// methods are accessed from background workers too.
+	mtx     *sync.Mutex
+	methods map[string]int
+	fails   map[string]int
+
+	wcObjects     map[string]uint64
+	wcSize        map[string]uint64
+	wcFlushed     int
+	wcFlushedSize uint64
+	wcFlushErrors int
+
+	scrubbed  int
+	corrupted int
 }
 
 func (m metricsStore) SetShardID(_ string) {}
@@ -66,6 +84,59 @@ func (m *metricsStore) AddToPayloadSize(size int64) {
 	m.pldSize += size
 }
 
+func (m *metricsStore) AddMethodDuration(component, method string, success bool, _ time.Duration) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.methods[component+"."+method]++
+	if !success {
+		m.fails[component+"."+method]++
+	}
+}
+
+func (m *metricsStore) SetWriteCacheObjectCounter(storage string, v uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.wcObjects[storage] = v
+}
+
+func (m *metricsStore) SetWriteCacheSize(storage string, v uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.wcSize[storage] = v
+}
+
+func (m *metricsStore) AddWriteCacheFlush(success bool, size uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if success {
+		m.wcFlushed++
+		m.wcFlushedSize += size
+	} else {
+		m.wcFlushErrors++
+	}
+}
+
+func (m *metricsStore) AddScrubbedObject(corrupted bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.scrubbed++
+	if corrupted {
+		m.corrupted++
+	}
+}
+
+func (m *metricsStore) methodCalls(component, method string) (int, int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.methods[component+"."+method], m.fails[component+"."+method]
+}
+
 const physical = "phy"
 const logical = "logic"
 const readonly = "readonly"
@@ -108,7 +179,7 @@ func TestCounters(t *testing.T) {
 		for i := 0; i < objNumber; i++ {
 			prm.SetObject(oo[i])
 
-			_, err := sh.Put(prm)
+			_, err := sh.Put(context.Background(), prm)
 			require.NoError(t, err)
 		}
 
@@ -185,7 +256,96 @@ func TestCounters(t *testing.T) {
 	})
 }
 
-func shardWithMetrics(t *testing.T, path string) (*shard.Shard, *metricsStore) {
+func TestMethodDurations(t *testing.T) {
+	sh, mm := shardWithMetrics(t, t.TempDir())
+
+	obj := generateObject(t)
+	addr := objectcore.AddressOf(obj)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(context.Background(), putPrm)
+	require.NoError(t, err)
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(addr)
+
+	_, err = sh.Get(context.Background(), getPrm)
+	require.NoError(t, err)
+
+	getPrm.SetAddress(objectcore.AddressOf(generateObject(t)))
+
+	_, err = sh.Get(context.Background(), getPrm)
+	require.Error(t, err)
+
+	for _, tc := range []struct {
+		component, method string
+		calls             int
+	}{
+		{fstree.Type, "Put", 1},
+		{fstree.Type, "Get", 1},
+		{"metabase", "Put", 1},
+		{"metabase", "Exists", 2},
+	} {
+		calls, fails := mm.methodCalls(tc.component, tc.method)
+		require.Equal(t, tc.calls, calls, tc.component+"."+tc.method)
+		require.Zero(t, fails, "logical errors must not be reported as failures")
+	}
+}
+
+func TestWriteCacheMetrics(t *testing.T) {
+	path := t.TempDir()
+	sh, mm := shardWithMetrics(t, path,
+		shard.WithWriteCache(true),
+		shard.WithWriteCacheOptions(
+			writecache.WithPath(filepath.Join(path, "wcache")),
+			writecache.WithSmallObjectSize(1024)))
+
+	small := generateObject(t)
+	big := generateObject(t)
+	addPayload(big, 2048)
+
+	var putPrm shard.PutPrm
+	for _, obj := range []*object.Object{small, big} {
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(context.Background(), putPrm)
+		require.NoError(t, err)
+	}
+
+	smallData, err := small.Marshal()
+	require.NoError(t, err)
+	bigData, err := big.Marshal()
+	require.NoError(t, err)
+
+	st, err := sh.WriteCacheStats()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), st.ObjectsDB)
+	require.Equal(t, uint64(len(smallData)), st.SizeDB)
+	require.Equal(t, uint64(1), st.ObjectsFS)
+	require.Equal(t, uint64(len(bigData)), st.SizeFS)
+
+	mm.mtx.Lock()
+	require.Equal(t, map[string]uint64{writecache.StorageDB: st.ObjectsDB, writecache.StorageFSTree: st.ObjectsFS}, mm.wcObjects)
+	require.Equal(t, map[string]uint64{writecache.StorageDB: st.SizeDB, writecache.StorageFSTree: st.SizeFS}, mm.wcSize)
+	mm.mtx.Unlock()
+
+	require.NoError(t, sh.FlushWriteCache(shard.FlushWriteCachePrm{}))
+
+	st, err = sh.WriteCacheStats()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, st.Flushed, uint64(2))
+	require.Zero(t, st.FlushErrors)
+
+	mm.mtx.Lock()
+	require.Equal(t, st.Flushed, uint64(mm.wcFlushed))
+	require.Equal(t, st.FlushedSize, mm.wcFlushedSize)
+	require.Zero(t, mm.wcFlushErrors)
+	mm.mtx.Unlock()
+}
+
+func shardWithMetrics(t *testing.T, path string, opts ...shard.Option) (*shard.Shard, *metricsStore) {
 	blobOpts := []blobstor.Option{
 		blobstor.WithStorages([]blobstor.SubStorage{
 			{
@@ -203,16 +363,22 @@ func shardWithMetrics(t *testing.T, path string) (*shard.Shard, *metricsStore) {
 			"logic": 0,
 		},
 		cnrSize: make(map[string]int64),
+		mtx:     new(sync.Mutex),
+		methods: make(map[string]int),
+		fails:   make(map[string]int),
+
+		wcObjects: make(map[string]uint64),
+		wcSize:    make(map[string]uint64),
 	}
 
-	sh := shard.New(
+	sh := shard.New(append([]shard.Option{
 		shard.WithBlobStorOptions(blobOpts...),
 		shard.WithPiloramaOptions(pilorama.WithPath(filepath.Join(path, "pilorama"))),
 		shard.WithMetaBaseOptions(
 			meta.WithPath(filepath.Join(path, "meta")),
 			meta.WithEpochState(epochState{})),
 		shard.WithMetricsWriter(mm),
-	)
+	}, opts...)...)
 	require.NoError(t, sh.Open())
 	require.NoError(t, sh.Init())
 
@@ -1,12 +1,16 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -29,7 +33,14 @@ func (p *PutPrm) SetObject(obj *object.Object) {
 // did not allow to completely save the object.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Put(prm PutPrm) (PutRes, error) {
+func (s *Shard) Put(ctx context.Context, prm PutPrm) (_ PutRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "Shard.Put",
+		trace.WithAttributes(
+			s.idAttribute(),
+			attribute.String("address", objectCore.AddressOf(prm.obj).EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -54,7 +65,7 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 	// ahead of `Put` by storage engine
 	tryCache := s.hasWriteCache() && !m.NoMetabase()
 	if tryCache {
-		res, err = s.writeCache.Put(putPrm)
+		res, err = s.writeCache.Put(ctx, putPrm)
 	}
 	if err != nil || !tryCache {
 		if err != nil {
@@ -62,7 +73,7 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 				zap.String("err", err.Error()))
 		}
 
-		res, err = s.blobStor.Put(putPrm)
+		res, err = s.blobStor.Put(ctx, putPrm)
 		if err != nil {
 			return PutRes{}, fmt.Errorf("could not put object to BLOB storage: %w", err)
 		}
@@ -72,7 +83,7 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 		var pPrm meta.PutPrm
 		pPrm.SetObject(prm.obj)
 		pPrm.SetStorageID(res.StorageID)
-		if _, err := s.metaBase.Put(pPrm); err != nil {
+		if _, err := s.metaBase.Put(ctx, pPrm); err != nil {
 			// may we need to handle this case in a special way
 			// since the object has been successfully written to BlobStor
 			return PutRes{}, fmt.Errorf("could not put object to metabase: %w", err)
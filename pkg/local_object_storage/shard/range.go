@@ -1,13 +1,18 @@
 package shard
 
 import (
+	"context"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // RngPrm groups the parameters of GetRange operation.
@@ -66,7 +71,16 @@ func (r RngRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
+func (s *Shard) GetRange(ctx context.Context, prm RngPrm) (_ RngRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "Shard.GetRange",
+		trace.WithAttributes(
+			s.idAttribute(),
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Int64("offset", int64(prm.off)),
+			attribute.Int64("length", int64(prm.ln)),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -77,7 +91,7 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 		getRngPrm.Range.SetLength(prm.ln)
 		getRngPrm.StorageID = id
 
-		res, err := stor.GetRange(getRngPrm)
+		res, err := stor.GetRange(ctx, getRngPrm)
 		if err != nil {
 			return nil, err
 		}
@@ -89,7 +103,7 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 	}
 
 	wc := func(c writecache.Cache) (*object.Object, error) {
-		res, err := c.Get(prm.addr)
+		res, err := c.Get(ctx, prm.addr)
 		if err != nil {
 			return nil, err
 		}
@@ -107,7 +121,7 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 	}
 
 	skipMeta := prm.skipMeta || s.info.Mode.NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	obj, hasMeta, err := s.fetchObjectData(ctx, prm.addr, skipMeta, cb, wc)
 
 	return RngRes{
 		obj:     obj,
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -131,7 +132,7 @@ func putObject(sh *Shard, obj *objectSDK.Object) error {
 	var prm PutPrm
 	prm.SetObject(obj)
 
-	_, err := sh.Put(prm)
+	_, err := sh.Put(context.Background(), prm)
 	return err
 }
 
@@ -2,6 +2,7 @@ package shard
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"io"
@@ -122,7 +123,7 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 		}
 
 		putPrm.SetObject(obj)
-		_, err = s.Put(putPrm)
+		_, err = s.Put(context.Background(), putPrm)
 		if err != nil && !IsErrObjectExpired(err) && !IsErrRemoved(err) {
 			return RestoreRes{}, err
 		}
@@ -0,0 +1,348 @@
+package shard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-sdk-go/checksum"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ScrubStatus contains the progress of the current
+// (or the last finished) shard scrubbing.
+type ScrubStatus struct {
+	// Running is true if the scrubbing is in progress.
+	Running bool
+	// StartedAt is the time when the scrubbing was started.
+	StartedAt time.Time
+	// FinishedAt is the time when the scrubbing was finished.
+	// Zero if the scrubbing is in progress.
+	FinishedAt time.Time
+	// Checked is the number of verified objects.
+	Checked uint64
+	// Corrupted is the number of corrupted objects found.
+	Corrupted uint64
+	// ReadErrors is the number of objects which could not be read
+	// from the blobstor. Such objects are not marked as corrupted.
+	ReadErrors uint64
+	// Error is the error the scrubbing was finished with.
+	Error error
+}
+
+// ErrScrubInProgress is returned when the scrubbing is requested
+// while the previous one is not finished.
+var ErrScrubInProgress = logicerr.New("shard scrubbing is already in progress")
+
+var errScrubStopped = errors.New("shard scrubbing is stopped")
+
+type scrubCfg struct {
+	interval  time.Duration
+	rateLimit uint32
+}
+
+func defaultScrubCfg() scrubCfg {
+	return scrubCfg{
+		rateLimit: 100,
+	}
+}
+
+// scrubber periodically verifies payloads of the objects stored in the blobstor.
+type scrubber struct {
+	mtx    sync.Mutex
+	status ScrubStatus
+
+	startCh  chan struct{}
+	stopCh   chan struct{}
+	onceStop sync.Once
+	wg       sync.WaitGroup
+}
+
+// WithScrubInterval returns option to set the interval between shard scrubbings.
+// Zero value disables periodic scrubbing, it can still be started with StartScrub.
+func WithScrubInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.scrubCfg.interval = d
+	}
+}
+
+// WithScrubRateLimit returns option to set the maximum number of objects
+// verified by the shard scrubbing per second. Zero value disables the limit.
+func WithScrubRateLimit(v uint32) Option {
+	return func(c *cfg) {
+		c.scrubCfg.rateLimit = v
+	}
+}
+
+func (s *Shard) initScrubber() {
+	s.scrub = &scrubber{
+		startCh: make(chan struct{}, 1),
+		stopCh:  make(chan struct{}),
+	}
+
+	s.scrub.wg.Add(1)
+	go s.scrubLoop()
+}
+
+func (s *Shard) stopScrubber() {
+	// If Init was unsuccessful scrubber can be nil.
+	if s.scrub == nil {
+		return
+	}
+
+	s.scrub.onceStop.Do(func() {
+		close(s.scrub.stopCh)
+	})
+	s.scrub.wg.Wait()
+}
+
+func (s *Shard) scrubLoop() {
+	defer s.scrub.wg.Done()
+
+	var tickCh <-chan time.Time
+	if s.scrubCfg.interval > 0 {
+		t := time.NewTicker(s.scrubCfg.interval)
+		defer t.Stop()
+
+		tickCh = t.C
+	}
+
+	for {
+		select {
+		case <-s.scrub.stopCh:
+			return
+		case <-tickCh:
+			if !s.scrub.tryStart() {
+				continue
+			}
+		case <-s.scrub.startCh:
+		}
+
+		s.log.Info("shard scrubbing started")
+
+		err := s.scrubBlobStor()
+		if errors.Is(err, errScrubStopped) {
+			err = nil
+		}
+
+		st := s.scrub.finish(err)
+		s.log.Info("shard scrubbing finished",
+			zap.Uint64("checked", st.Checked),
+			zap.Uint64("corrupted", st.Corrupted),
+			zap.Uint64("read errors", st.ReadErrors),
+			zap.Error(err))
+	}
+}
+
+// StartScrub starts verifying payloads of all objects stored in the blobstor in background.
+// Corrupted objects are marked as garbage, so they can be replicated from other nodes.
+func (s *Shard) StartScrub() error {
+	if s.scrub == nil {
+		return errors.New("shard is not initialized")
+	}
+
+	if !s.scrub.tryStart() {
+		return ErrScrubInProgress
+	}
+
+	s.scrub.startCh <- struct{}{}
+	return nil
+}
+
+// ScrubStatus returns the status of the current (or the last finished) scrubbing.
+func (s *Shard) ScrubStatus() ScrubStatus {
+	if s.scrub == nil {
+		return ScrubStatus{}
+	}
+
+	s.scrub.mtx.Lock()
+	defer s.scrub.mtx.Unlock()
+
+	return s.scrub.status
+}
+
+func (s *scrubber) tryStart() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.status.Running {
+		return false
+	}
+
+	s.status = ScrubStatus{
+		Running:   true,
+		StartedAt: time.Now(),
+	}
+	return true
+}
+
+func (s *scrubber) finish(err error) ScrubStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.status.Running = false
+	s.status.FinishedAt = time.Now()
+	s.status.Error = err
+	return s.status
+}
+
+func (s *scrubber) addReadError() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.status.ReadErrors++
+}
+
+func (s *scrubber) addChecked(corrupted bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.status.Checked++
+	if corrupted {
+		s.status.Corrupted++
+	}
+}
+
+func (s *Shard) scrubBlobStor() error {
+	var limitCh <-chan time.Time
+	if s.scrubCfg.rateLimit > 0 {
+		t := time.NewTicker(time.Second / time.Duration(s.scrubCfg.rateLimit))
+		defer t.Stop()
+
+		limitCh = t.C
+	}
+
+	wait := func() error {
+		if limitCh != nil {
+			select {
+			case <-s.scrub.stopCh:
+				return errScrubStopped
+			case <-limitCh:
+			}
+		} else {
+			select {
+			case <-s.scrub.stopCh:
+				return errScrubStopped
+			default:
+			}
+		}
+		return nil
+	}
+
+	var prm common.IteratePrm
+	prm.IgnoreErrors = true
+	prm.Handler = func(elem common.IterationElement) error {
+		if err := wait(); err != nil {
+			return err
+		}
+
+		err := verifyObject(elem.Address, elem.ObjectData)
+
+		s.scrub.addChecked(err != nil)
+		if s.metricsWriter != nil {
+			s.metricsWriter.AddScrubbedObject(err != nil)
+		}
+
+		if err != nil {
+			s.log.Error("corrupted object found during shard scrubbing",
+				zap.Stringer("address", elem.Address),
+				zap.Error(err))
+
+			s.markCorrupted(elem.Address)
+		}
+		return nil
+	}
+	prm.ErrorHandler = func(addr oid.Address, err error) error {
+		if err := wait(); err != nil {
+			return err
+		}
+
+		// Read errors may be transient (EIO, EMFILE, EACCES), the data
+		// itself is not proven to be corrupted, so the object is left intact.
+		s.scrub.addReadError()
+
+		s.log.Warn("can't read object during shard scrubbing",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+		return nil
+	}
+
+	_, err := s.blobStor.Iterate(prm)
+	return err
+}
+
+// markCorrupted marks the object as garbage, so that it is removed by GC
+// and the policers of other container nodes replicate a healthy copy.
+func (s *Shard) markCorrupted(addr oid.Address) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() || s.info.Mode.NoMetabase() {
+		s.log.Warn("can't mark corrupted object as garbage, shard is in read-only mode",
+			zap.Stringer("address", addr))
+		return
+	}
+
+	var prm meta.InhumePrm
+	prm.SetAddresses(addr)
+	prm.SetGCMark()
+
+	_, err := s.metaBase.Inhume(prm)
+	if err != nil {
+		s.log.Error("can't mark corrupted object as garbage",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+	}
+}
+
+// verifyObject checks that the object data corresponds to its identifier
+// and payload checksums.
+func verifyObject(addr oid.Address, data []byte) error {
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return fmt.Errorf("could not unmarshal object: %w", err)
+	}
+
+	if id, ok := obj.ID(); !ok || !id.Equals(addr.Object()) {
+		return errors.New("object ID does not match the address")
+	}
+
+	if err := objectSDK.VerifyID(obj); err != nil {
+		return fmt.Errorf("invalid object header: %w", err)
+	}
+
+	if cs, ok := obj.PayloadChecksum(); ok {
+		if err := verifyChecksum(cs, obj.Payload()); err != nil {
+			return fmt.Errorf("invalid payload checksum: %w", err)
+		}
+	}
+
+	if cs, ok := obj.PayloadHomomorphicHash(); ok {
+		if err := verifyChecksum(cs, obj.Payload()); err != nil {
+			return fmt.Errorf("invalid payload homomorphic hash: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func verifyChecksum(expected checksum.Checksum, payload []byte) error {
+	if t := expected.Type(); t != checksum.SHA256 && t != checksum.TZ {
+		return nil
+	}
+
+	var actual checksum.Checksum
+	checksum.Calculate(&actual, expected.Type(), payload)
+
+	if !bytes.Equal(expected.Value(), actual.Value()) {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
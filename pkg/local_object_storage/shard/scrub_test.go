@@ -0,0 +1,151 @@
+package shard_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-sdk-go/checksum"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/TrueCloudLab/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardScrub(t *testing.T) {
+	dir := t.TempDir()
+	sh, mm := shardWithMetrics(t, dir, shard.WithScrubRateLimit(0))
+
+	const objCount = 5
+
+	objects := make([]*object.Object, objCount)
+	for i := range objects {
+		objects[i] = generateObjectWithCID(t, cidtest.ID())
+
+		var csumTZ checksum.Checksum
+		csumTZ.SetTillichZemor(tz.Sum(objects[i].Payload()))
+		objects[i].SetPayloadHomomorphicHash(csumTZ)
+		require.NoError(t, object.CalculateAndSetID(objects[i]))
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(objects[i])
+
+		_, err := sh.Put(context.Background(), putPrm)
+		require.NoError(t, err)
+	}
+
+	waitScrub := func() shard.ScrubStatus {
+		require.Eventually(t, func() bool {
+			return !sh.ScrubStatus().Running
+		}, 5*time.Second, 10*time.Millisecond)
+
+		return sh.ScrubStatus()
+	}
+
+	require.NoError(t, sh.StartScrub())
+
+	st := waitScrub()
+	require.NoError(t, st.Error)
+	require.Equal(t, uint64(objCount), st.Checked)
+	require.Equal(t, uint64(0), st.Corrupted)
+	require.False(t, st.FinishedAt.IsZero())
+
+	// Containers are unique, so the object file is found by the container suffix.
+	// Payload is the last field of the serialized object,
+	// so flipping the last byte of the file breaks the payload checksum.
+	corruptedAddr := objectcore.AddressOf(objects[0])
+	corruptedFile := ""
+	require.NoError(t, filepath.WalkDir(filepath.Join(dir, "blob"), func(p string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && strings.HasSuffix(p, "."+corruptedAddr.Container().EncodeToString()) {
+			corruptedFile = p
+		}
+		return err
+	}))
+	require.NotEmpty(t, corruptedFile)
+
+	data, err := os.ReadFile(corruptedFile)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xFF
+	require.NoError(t, os.WriteFile(corruptedFile, data, 0644))
+
+	require.NoError(t, sh.StartScrub())
+
+	st = waitScrub()
+	require.NoError(t, st.Error)
+	require.Equal(t, uint64(objCount), st.Checked)
+	require.Equal(t, uint64(1), st.Corrupted)
+
+	require.Equal(t, 2*objCount, mm.scrubbed)
+	require.Equal(t, 1, mm.corrupted)
+
+	for i := range objects {
+		var headPrm shard.HeadPrm
+		headPrm.SetAddress(objectcore.AddressOf(objects[i]))
+
+		_, err := sh.Head(context.Background(), headPrm)
+		if i == 0 {
+			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestShardScrub_ReadError(t *testing.T) {
+	dir := t.TempDir()
+	sh, mm := shardWithMetrics(t, dir, shard.WithScrubRateLimit(0))
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addr := objectcore.AddressOf(obj)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(context.Background(), putPrm)
+	require.NoError(t, err)
+
+	objFile := ""
+	require.NoError(t, filepath.WalkDir(filepath.Join(dir, "blob"), func(p string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && strings.HasSuffix(p, "."+addr.Container().EncodeToString()) {
+			objFile = p
+		}
+		return err
+	}))
+	require.NotEmpty(t, objFile)
+
+	data, err := os.ReadFile(objFile)
+	require.NoError(t, err)
+
+	// Replace the object file with a directory, so that the blobstor
+	// reports a read error which is not a sign of the data corruption.
+	require.NoError(t, os.Remove(objFile))
+	require.NoError(t, os.Mkdir(objFile, 0700))
+
+	require.NoError(t, sh.StartScrub())
+	require.Eventually(t, func() bool {
+		return !sh.ScrubStatus().Running
+	}, 5*time.Second, 10*time.Millisecond)
+
+	st := sh.ScrubStatus()
+	require.NoError(t, st.Error)
+	require.Equal(t, uint64(0), st.Checked)
+	require.Equal(t, uint64(0), st.Corrupted)
+	require.Equal(t, uint64(1), st.ReadErrors)
+	require.Equal(t, 0, mm.corrupted)
+
+	require.NoError(t, os.Remove(objFile))
+	require.NoError(t, os.WriteFile(objFile, data, 0644))
+
+	var headPrm shard.HeadPrm
+	headPrm.SetAddress(addr)
+
+	_, err = sh.Head(context.Background(), headPrm)
+	require.NoError(t, err)
+}
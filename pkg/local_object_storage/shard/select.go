@@ -13,11 +13,16 @@ import (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	limit  int
+	cursor *oid.ID
+	attrs  []string
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	attrList [][]*string
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -30,11 +35,36 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
-// AddressList returns list of addresses of the selected objects.
+// SetLimit is a Select option to limit the number of the selected objects.
+// Zero limit means no limit.
+func (p *SelectPrm) SetLimit(n int) {
+	p.limit = n
+}
+
+// SetCursor is a Select option to select only the objects
+// with identifiers greater than the cursor.
+func (p *SelectPrm) SetCursor(id oid.ID) {
+	p.cursor = &id
+}
+
+// SetAttributes is a Select option to return values
+// of the specified attributes of the selected objects.
+func (p *SelectPrm) SetAttributes(keys ...string) {
+	p.attrs = keys
+}
+
+// AddressList returns list of addresses of the selected objects
+// sorted by object identifiers.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// AttributeList returns values of the requested attributes of the selected objects.
+// See metabase.SelectRes.AttributeList.
+func (r SelectRes) AttributeList() [][]*string {
+	return r.attrList
+}
+
 // Select selects the objects from shard that match select parameters.
 //
 // Returns any error encountered that
@@ -50,6 +80,11 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 	var selectPrm meta.SelectPrm
 	selectPrm.SetFilters(prm.filters)
 	selectPrm.SetContainerID(prm.cnr)
+	selectPrm.SetLimit(prm.limit)
+	selectPrm.SetAttributes(prm.attrs...)
+	if prm.cursor != nil {
+		selectPrm.SetCursor(*prm.cursor)
+	}
 
 	mRes, err := s.metaBase.Select(selectPrm)
 	if err != nil {
@@ -58,5 +93,6 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 
 	return SelectRes{
 		addrList: mRes.AddressList(),
+		attrList: mRes.AttributeList(),
 	}, nil
 }
@@ -22,6 +22,8 @@ type Shard struct {
 
 	gc *gc
 
+	scrub *scrubber
+
 	writeCache writecache.Cache
 
 	blobStor *blobstor.BlobStor
@@ -70,6 +72,23 @@ type MetricsWriter interface {
 	SetShardID(id string)
 	// SetReadonly must set shard readonly state.
 	SetReadonly(readonly bool)
+	// AddMethodDuration must store the duration of the method call of
+	// the shard storage component (write-cache, metabase, pilorama or
+	// BlobStor sub-storage). Success is false if the call failed with
+	// non-logical error.
+	AddMethodDuration(component, method string, success bool, d time.Duration)
+	// SetWriteCacheObjectCounter must set the number of objects stored in
+	// the write-cache storage (database or file tree).
+	SetWriteCacheObjectCounter(storage string, v uint64)
+	// SetWriteCacheSize must set the total size of objects stored in
+	// the write-cache storage (database or file tree).
+	SetWriteCacheSize(storage string, v uint64)
+	// AddWriteCacheFlush must store the result of flushing an object
+	// of the given size from the write-cache.
+	AddWriteCacheFlush(success bool, size uint64)
+	// AddScrubbedObject must store the result of the object
+	// verification by the shard scrubbing.
+	AddScrubbedObject(corrupted bool)
 }
 
 type cfg struct {
@@ -95,6 +114,8 @@ type cfg struct {
 
 	gcCfg gcCfg
 
+	scrubCfg scrubCfg
+
 	expiredTombstonesCallback ExpiredTombstonesCallback
 
 	expiredLocksCallback ExpiredObjectsCallback
@@ -113,6 +134,7 @@ func defaultCfg() *cfg {
 		rmBatchSize:     100,
 		log:             &logger.Logger{Logger: zap.L()},
 		gcCfg:           defaultGCCfg(),
+		scrubCfg:        defaultScrubCfg(),
 		reportErrorFunc: func(string, string, error) {},
 	}
 }
@@ -125,6 +147,16 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
+	if c.metricsWriter != nil {
+		c.blobOpts = append(c.blobOpts, blobstor.WithMetrics(blobStorMetrics{mw: c.metricsWriter}))
+		c.metaOpts = append(c.metaOpts, meta.WithMetrics(componentMetrics{component: componentMetabase, mw: c.metricsWriter}))
+		c.writeCacheOpts = append(c.writeCacheOpts, writecache.WithMetrics(writeCacheMetrics{componentMetrics{component: componentWriteCache, mw: c.metricsWriter}}))
+
+		if c.piloramaOpts != nil {
+			c.piloramaOpts = append(c.piloramaOpts, pilorama.WithMetrics(componentMetrics{component: componentPilorama, mw: c.metricsWriter}))
+		}
+	}
+
 	bs := blobstor.New(c.blobOpts...)
 	mb := meta.New(c.metaOpts...)
 
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -41,7 +42,7 @@ func TestWriteCacheObjectLoss(t *testing.T) {
 
 	for i := range objects {
 		putPrm.SetObject(objects[i])
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 	}
 	require.NoError(t, sh.Close())
@@ -54,7 +55,7 @@ func TestWriteCacheObjectLoss(t *testing.T) {
 	for i := range objects {
 		getPrm.SetAddress(object.AddressOf(objects[i]))
 
-		_, err := sh.Get(getPrm)
+		_, err := sh.Get(context.Background(), getPrm)
 		require.NoError(t, err, i)
 	}
 }
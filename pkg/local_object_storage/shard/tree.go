@@ -41,6 +41,21 @@ func (s *Shard) TreeAddByPath(d pilorama.CIDDescriptor, treeID string, attr stri
 	return s.pilorama.TreeAddByPath(d, treeID, attr, path, meta)
 }
 
+// TreeBatch implements the pilorama.Forest interface.
+func (s *Shard) TreeBatch(d pilorama.CIDDescriptor, treeID string, ops []pilorama.BatchOperation) ([][]pilorama.Move, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	return s.pilorama.TreeBatch(d, treeID, ops)
+}
+
 // TreeApply implements the pilorama.Forest interface.
 func (s *Shard) TreeApply(d pilorama.CIDDescriptor, treeID string, m *pilorama.Move, backgroundSync bool) error {
 	if s.pilorama == nil {
@@ -80,6 +95,14 @@ func (s *Shard) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pilorama.No
 	return s.pilorama.TreeGetChildren(cid, treeID, nodeID)
 }
 
+// TreeGetSortedChildren implements the pilorama.Forest interface.
+func (s *Shard) TreeGetSortedChildren(cid cidSDK.ID, treeID string, nodeID pilorama.Node, attr string, after *pilorama.SortCursor, count int) ([]pilorama.Node, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeGetSortedChildren(cid, treeID, nodeID, attr, after, count)
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (s *Shard) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	if s.pilorama == nil {
@@ -111,3 +134,49 @@ func (s *Shard) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	}
 	return s.pilorama.TreeExists(cid, treeID)
 }
+
+// TreeCompact implements the pilorama.Forest interface.
+func (s *Shard) TreeCompact(cid cidSDK.ID, treeID string, height uint64) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeCompact(cid, treeID, height)
+}
+
+// TreeGetSnapshot implements the pilorama.Forest interface.
+func (s *Shard) TreeGetSnapshot(cid cidSDK.ID, treeID string, height uint64, cursor *pilorama.SnapshotCursor, count int) (uint64, []pilorama.SnapshotNode, error) {
+	if s.pilorama == nil {
+		return 0, nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeGetSnapshot(cid, treeID, height, cursor, count)
+}
+
+// TreeApplySnapshot implements the pilorama.Forest interface.
+func (s *Shard) TreeApplySnapshot(d pilorama.CIDDescriptor, treeID string, height uint64, nodes []pilorama.SnapshotNode, more bool) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeApplySnapshot(d, treeID, height, nodes, more)
+}
+
+// TreeListTrees returns identifiers of all trees stored in the shard.
+func (s *Shard) TreeListTrees() ([]pilorama.ContainerIDTreeID, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeListTrees()
+}
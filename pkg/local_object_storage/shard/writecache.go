@@ -2,6 +2,8 @@ package shard
 
 import (
 	"errors"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 )
 
 // FlushWriteCachePrm represents parameters of a `FlushWriteCache` operation.
@@ -37,3 +39,12 @@ func (s *Shard) FlushWriteCache(p FlushWriteCachePrm) error {
 
 	return s.writeCache.Flush(p.ignoreErrors)
 }
+
+// WriteCacheStats returns the statistics of the write-cache.
+func (s *Shard) WriteCacheStats() (writecache.Stats, error) {
+	if !s.hasWriteCache() {
+		return writecache.Stats{}, errWriteCacheDisabled
+	}
+
+	return s.writeCache.Stats(), nil
+}
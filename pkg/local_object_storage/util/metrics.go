@@ -0,0 +1,33 @@
+package util
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+)
+
+// MethodDurationWriter is an interface that must store
+// the duration of the storage component method calls.
+type MethodDurationWriter interface {
+	// AddMethodDuration must store the duration of the method call.
+	// Success is false if the call failed with non-logical error.
+	AddMethodDuration(method string, success bool, d time.Duration)
+}
+
+// NoopMethodDurationWriter is a MethodDurationWriter which does nothing.
+type NoopMethodDurationWriter struct{}
+
+// AddMethodDuration does nothing.
+func (NoopMethodDurationWriter) AddMethodDuration(string, bool, time.Duration) {}
+
+// Elapsed returns the function which reports the duration of the method
+// call started at the moment of Elapsed call to w. err must point to the
+// error result of the method.
+func Elapsed(w MethodDurationWriter, method string, err *error) func() {
+	t := time.Now()
+
+	return func() {
+		w.AddMethodDuration(method, *err == nil || errors.As(*err, new(logicerr.Logical)), time.Since(t))
+	}
+}
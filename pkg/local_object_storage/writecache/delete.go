@@ -3,6 +3,7 @@ package writecache
 import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
 )
@@ -10,7 +11,9 @@ import (
 // Delete removes object from write-cache.
 //
 // Returns an error of type apistatus.ObjectNotFound if object is missing in write-cache.
-func (c *cache) Delete(addr oid.Address) error {
+func (c *cache) Delete(addr oid.Address) (err error) {
+	defer util.Elapsed(c.metrics, "Delete", &err)()
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 	if c.readOnly() {
@@ -41,18 +44,20 @@ func (c *cache) Delete(addr oid.Address) error {
 			storagelog.StorageTypeField(wcStorageType),
 			storagelog.OpField("db DELETE"),
 		)
-		c.objCounters.DecDB()
+		c.objCounters.DecDB(uint64(has))
 		return nil
 	}
 
-	_, err := c.fsTree.Delete(common.DeletePrm{Address: addr})
+	sz, _ := c.fsTree.ObjectSize(addr)
+
+	_, err = c.fsTree.Delete(common.DeletePrm{Address: addr})
 	if err == nil {
 		storagelog.Write(c.log,
 			storagelog.AddressField(saddr),
 			storagelog.StorageTypeField(wcStorageType),
 			storagelog.OpField("fstree DELETE"),
 		)
-		c.objCounters.DecFS()
+		c.objCounters.DecFS(sz)
 	}
 
 	return err
@@ -2,6 +2,7 @@ package writecache
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/mr-tron/base58"
@@ -238,14 +240,28 @@ func (c *cache) flushWorker(_ int) {
 }
 
 // flushObject is used to write object directly to the main storage.
-func (c *cache) flushObject(obj *object.Object, data []byte) error {
+func (c *cache) flushObject(obj *object.Object, data []byte) (err error) {
 	addr := objectCore.AddressOf(obj)
 
+	if data == nil {
+		data, err = obj.Marshal()
+		if err != nil {
+			c.objCounters.AddFlush(false, 0)
+			return err
+		}
+	}
+
+	defer func() {
+		c.objCounters.AddFlush(err == nil, uint64(len(data)))
+	}()
+
 	var prm common.PutPrm
 	prm.Object = obj
 	prm.RawData = data
 
-	res, err := c.blobstor.Put(prm)
+	// flushing is a background process which
+	// does not belong to any request trace
+	res, err := c.blobstor.Put(context.Background(), prm)
 	if err != nil {
 		if !errors.Is(err, common.ErrNoSpace) && !errors.Is(err, common.ErrReadOnly) &&
 			!errors.Is(err, blobstor.ErrNoPlaceFound) {
@@ -270,7 +286,9 @@ func (c *cache) flushObject(obj *object.Object, data []byte) error {
 // Flush flushes all objects from the write-cache to the main storage.
 // Write-cache must be in readonly mode to ensure correctness of an operation and
 // to prevent interference with background flush workers.
-func (c *cache) Flush(ignoreErrors bool) error {
+func (c *cache) Flush(ignoreErrors bool) (err error) {
+	defer util.Elapsed(c.metrics, "Flush", &err)()
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 
@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -93,7 +94,7 @@ func TestFlush(t *testing.T) {
 			prm.Address = objects[i].addr
 			prm.StorageID = mRes.StorageID()
 
-			res, err := bs.Get(prm)
+			res, err := bs.Get(context.Background(), prm)
 			require.NoError(t, err)
 			require.Equal(t, objects[i].obj, res.Object)
 		}
@@ -114,10 +115,10 @@ func TestFlush(t *testing.T) {
 		for i := 0; i < 2; i++ {
 			var mPrm meta.GetPrm
 			mPrm.SetAddress(objects[i].addr)
-			_, err := mb.Get(mPrm)
+			_, err := mb.Get(context.Background(), mPrm)
 			require.Error(t, err)
 
-			_, err = bs.Get(common.GetPrm{Address: objects[i].addr})
+			_, err = bs.Get(context.Background(), common.GetPrm{Address: objects[i].addr})
 			require.Error(t, err)
 		}
 
@@ -144,10 +145,10 @@ func TestFlush(t *testing.T) {
 		for i := 0; i < 2; i++ {
 			var mPrm meta.GetPrm
 			mPrm.SetAddress(objects[i].addr)
-			_, err := mb.Get(mPrm)
+			_, err := mb.Get(context.Background(), mPrm)
 			require.Error(t, err)
 
-			_, err = bs.Get(common.GetPrm{Address: objects[i].addr})
+			_, err = bs.Get(context.Background(), common.GetPrm{Address: objects[i].addr})
 			require.Error(t, err)
 		}
 
@@ -199,7 +200,7 @@ func TestFlush(t *testing.T) {
 				prm.Address = objectCore.AddressOf(obj)
 				prm.RawData = data
 
-				_, err := c.fsTree.Put(prm)
+				_, err := c.fsTree.Put(context.Background(), prm)
 				require.NoError(t, err)
 
 				p := prm.Address.Object().EncodeToString() + "." + prm.Address.Container().EncodeToString()
@@ -215,7 +216,7 @@ func TestFlush(t *testing.T) {
 				var prm common.PutPrm
 				prm.Address = oidtest.Address()
 				prm.RawData = []byte{1, 2, 3}
-				_, err := c.fsTree.Put(prm)
+				_, err := c.fsTree.Put(context.Background(), prm)
 				require.NoError(t, err)
 			})
 		})
@@ -242,7 +243,7 @@ func TestFlush(t *testing.T) {
 		for i := range objects {
 			var prm meta.PutPrm
 			prm.SetObject(objects[i].obj)
-			_, err := mb.Put(prm)
+			_, err := mb.Put(context.Background(), prm)
 			require.NoError(t, err)
 		}
 
@@ -264,7 +265,7 @@ func TestFlush(t *testing.T) {
 		require.NoError(t, wc.Open(true))
 		require.NoError(t, wc.Init())
 		for i := range objects {
-			_, err := wc.Get(objects[i].addr)
+			_, err := wc.Get(context.Background(), objects[i].addr)
 			require.NoError(t, err, i)
 		}
 		require.NoError(t, wc.Close())
@@ -273,7 +274,7 @@ func TestFlush(t *testing.T) {
 		require.NoError(t, wc.Open(false))
 		require.NoError(t, wc.Init())
 		for i := range objects {
-			_, err := wc.Get(objects[i].addr)
+			_, err := wc.Get(context.Background(), objects[i].addr)
 			if i < 2 {
 				require.ErrorAs(t, err, new(apistatus.ObjectNotFound), i)
 			} else {
@@ -291,7 +292,7 @@ func putObject(t *testing.T, c Cache, size int) objectPair {
 	prm.Object = obj
 	prm.RawData = data
 
-	_, err := c.Put(prm)
+	_, err := c.Put(context.Background(), prm)
 	require.NoError(t, err)
 
 	return objectPair{prm.Address, prm.Object}
@@ -1,29 +1,43 @@
 package writecache
 
 import (
+	"context"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Get returns object from write-cache.
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
-func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
+func (c *cache) Get(ctx context.Context, addr oid.Address) (obj *objectSDK.Object, err error) {
+	defer util.Elapsed(c.metrics, "Get", &err)()
+
+	ctx, span := tracing.StartSpanFromContext(ctx, "writecache.Get",
+		trace.WithAttributes(
+			attribute.String("address", addr.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	saddr := addr.EncodeToString()
 
 	value, err := Get(c.db, []byte(saddr))
 	if err == nil {
-		obj := objectSDK.New()
+		obj = objectSDK.New()
 		c.flushed.Get(saddr)
 		return obj, obj.Unmarshal(value)
 	}
 
-	res, err := c.fsTree.Get(common.GetPrm{Address: addr})
+	res, err := c.fsTree.Get(ctx, common.GetPrm{Address: addr})
 	if err != nil {
 		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
@@ -35,8 +49,16 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 // Head returns object header from write-cache.
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
-func (c *cache) Head(addr oid.Address) (*objectSDK.Object, error) {
-	obj, err := c.Get(addr)
+func (c *cache) Head(ctx context.Context, addr oid.Address) (_ *objectSDK.Object, err error) {
+	defer util.Elapsed(c.metrics, "Head", &err)()
+
+	ctx, span := tracing.StartSpanFromContext(ctx, "writecache.Head",
+		trace.WithAttributes(
+			attribute.String("address", addr.EncodeToString()),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
+	obj, err := c.Get(ctx, addr)
 	if err != nil {
 		return nil, err
 	}
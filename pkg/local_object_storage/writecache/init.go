@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
@@ -24,6 +25,8 @@ func (c *cache) initFlushMarks() {
 				var prm common.DeletePrm
 				prm.Address = addr
 
+				sz, _ := c.fsTree.ObjectSize(addr)
+
 				_, err := c.fsTree.Delete(prm)
 				if err == nil {
 					storagelog.Write(c.log,
@@ -31,6 +34,7 @@ func (c *cache) initFlushMarks() {
 						storagelog.StorageTypeField(wcStorageType),
 						storagelog.OpField("fstree DELETE"),
 					)
+					c.objCounters.DecFS(sz)
 				}
 			}
 		}
@@ -46,6 +50,7 @@ func (c *cache) initFlushMarks() {
 	var batchSize = flushBatchSize
 	for {
 		m = m[:0]
+		indices = indices[:0]
 
 		// We put objects in batches of fixed size to not interfere with main put cycle a lot.
 		_ = c.db.View(func(tx *bbolt.Tx) error {
@@ -76,9 +81,11 @@ func (c *cache) initFlushMarks() {
 			break
 		}
 
+		sizes := make([]uint64, len(indices))
 		err := c.db.Batch(func(tx *bbolt.Tx) error {
 			b := tx.Bucket(defaultBucket)
-			for _, j := range indices {
+			for i, j := range indices {
+				sizes[i] = uint64(len(b.Get([]byte(m[j]))))
 				if err := b.Delete([]byte(m[j])); err != nil {
 					return err
 				}
@@ -86,12 +93,13 @@ func (c *cache) initFlushMarks() {
 			return nil
 		})
 		if err == nil {
-			for _, j := range indices {
+			for i, j := range indices {
 				storagelog.Write(c.log,
 					zap.String("address", m[j]),
 					storagelog.StorageTypeField(wcStorageType),
 					storagelog.OpField("db DELETE"),
 				)
+				c.objCounters.DecDB(sizes[i])
 			}
 		}
 		lastKey = append([]byte(m[len(m)-1]), 0)
@@ -107,7 +115,7 @@ func (c *cache) flushStatus(addr oid.Address) (bool, bool) {
 	var existsPrm meta.ExistsPrm
 	existsPrm.SetAddress(addr)
 
-	_, err := c.metabase.Exists(existsPrm)
+	_, err := c.metabase.Exists(context.Background(), existsPrm)
 	if err != nil {
 		needRemove := errors.Is(err, meta.ErrObjectIsExpired) || errors.As(err, new(apistatus.ObjectAlreadyRemoved))
 		return needRemove, needRemove
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
 )
@@ -31,14 +32,16 @@ func (p *IterationPrm) WithIgnoreErrors(ignore bool) {
 // Iterate iterates over all objects present in write cache.
 // This is very difficult to do correctly unless write-cache is put in read-only mode.
 // Thus we silently fail if shard is not in read-only mode to avoid reporting misleading results.
-func (c *cache) Iterate(prm IterationPrm) error {
+func (c *cache) Iterate(prm IterationPrm) (err error) {
+	defer util.Elapsed(c.metrics, "Iterate", &err)()
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 	if !c.readOnly() {
 		return nil
 	}
 
-	err := c.db.View(func(tx *bbolt.Tx) error {
+	err = c.db.View(func(tx *bbolt.Tx) error {
 		b := tx.Bucket(defaultBucket)
 		return b.ForEach(func(k, data []byte) error {
 			if _, ok := c.flushed.Peek(string(k)); ok {
@@ -0,0 +1,36 @@
+package writecache
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
+)
+
+// MetricsWriter is an interface that must store write-cache metrics.
+type MetricsWriter interface {
+	util.MethodDurationWriter
+
+	// SetObjectCounter must set the number of objects stored in
+	// the write-cache storage (StorageDB or StorageFSTree).
+	SetObjectCounter(storage string, v uint64)
+	// SetSize must set the total size in bytes of objects stored in
+	// the write-cache storage (StorageDB or StorageFSTree).
+	SetSize(storage string, v uint64)
+	// AddFlush must store the result of flushing an object of the given
+	// size to the main storage.
+	AddFlush(success bool, size uint64)
+}
+
+// Write-cache storages used as the metric labels.
+const (
+	// StorageDB is the database storing small objects.
+	StorageDB = "db"
+	// StorageFSTree is the file tree storing big objects.
+	StorageFSTree = "fstree"
+)
+
+type noopMetrics struct {
+	util.NoopMethodDurationWriter
+}
+
+func (noopMetrics) SetObjectCounter(string, uint64) {}
+func (noopMetrics) SetSize(string, uint64)          {}
+func (noopMetrics) AddFlush(bool, uint64)           {}
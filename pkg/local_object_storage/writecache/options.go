@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"context"
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
@@ -16,14 +17,14 @@ type Option func(*options)
 
 // meta is an interface for a metabase.
 type metabase interface {
-	Exists(meta.ExistsPrm) (meta.ExistsRes, error)
+	Exists(context.Context, meta.ExistsPrm) (meta.ExistsRes, error)
 	StorageID(meta.StorageIDPrm) (meta.StorageIDRes, error)
 	UpdateStorageID(meta.UpdateStorageIDPrm) (meta.UpdateStorageIDRes, error)
 }
 
 // blob is an interface for the blobstor.
 type blob interface {
-	Put(common.PutPrm) (common.PutRes, error)
+	Put(context.Context, common.PutPrm) (common.PutRes, error)
 	NeedsCompression(obj *objectSDK.Object) bool
 	Exists(res common.ExistsPrm) (common.ExistsRes, error)
 }
@@ -55,6 +56,8 @@ type options struct {
 	noSync bool
 	// reportError is the function called when encountering disk errors in background workers.
 	reportError func(string, error)
+	// metrics is the write-cache metrics writer.
+	metrics MetricsWriter
 }
 
 // WithLogger sets logger.
@@ -152,3 +155,10 @@ func WithReportErrorFunc(f func(string, error)) Option {
 		o.reportError = f
 	}
 }
+
+// WithMetrics returns option to specify write-cache metrics writer.
+func WithMetrics(m MetricsWriter) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
@@ -1,11 +1,16 @@
 package writecache
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -16,7 +21,16 @@ var (
 )
 
 // Put puts object to write-cache.
-func (c *cache) Put(prm common.PutPrm) (common.PutRes, error) {
+func (c *cache) Put(ctx context.Context, prm common.PutPrm) (_ common.PutRes, err error) {
+	defer util.Elapsed(c.metrics, "Put", &err)()
+
+	ctx, span := tracing.StartSpanFromContext(ctx, "writecache.Put",
+		trace.WithAttributes(
+			attribute.String("address", prm.Address.EncodeToString()),
+			attribute.Bool("dont_compress", prm.DontCompress),
+		))
+	defer func() { tracing.Finish(span, err) }()
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 	if c.readOnly() {
@@ -37,7 +51,7 @@ func (c *cache) Put(prm common.PutPrm) (common.PutRes, error) {
 	if sz <= c.smallObjectSize {
 		return common.PutRes{}, c.putSmall(oi)
 	}
-	return common.PutRes{}, c.putBig(oi.addr, prm)
+	return common.PutRes{}, c.putBig(ctx, oi.addr, prm)
 }
 
 // putSmall persists small objects to the write-cache database and
@@ -58,19 +72,19 @@ func (c *cache) putSmall(obj objectInfo) error {
 			storagelog.StorageTypeField(wcStorageType),
 			storagelog.OpField("db PUT"),
 		)
-		c.objCounters.IncDB()
+		c.objCounters.IncDB(uint64(len(obj.data)))
 	}
 	return nil
 }
 
 // putBig writes object to FSTree and pushes it to the flush workers queue.
-func (c *cache) putBig(addr string, prm common.PutPrm) error {
+func (c *cache) putBig(ctx context.Context, addr string, prm common.PutPrm) error {
 	cacheSz := c.estimateCacheSize()
 	if c.maxCacheSize < c.incSizeFS(cacheSz) {
 		return ErrOutOfSpace
 	}
 
-	_, err := c.fsTree.Put(prm)
+	_, err := c.fsTree.Put(ctx, prm)
 	if err != nil {
 		return err
 	}
@@ -80,7 +94,7 @@ func (c *cache) putBig(addr string, prm common.PutPrm) error {
 		c.compressFlags[addr] = struct{}{}
 		c.mtx.Unlock()
 	}
-	c.objCounters.IncFS()
+	c.objCounters.IncFS(uint64(len(prm.RawData)))
 	storagelog.Write(c.log,
 		storagelog.AddressField(addr),
 		storagelog.StorageTypeField(wcStorageType),
@@ -20,39 +20,62 @@ func (c *cache) incSizeFS(sz uint64) uint64 {
 }
 
 type counters struct {
-	cDB, cFS atomic.Uint64
+	cDB, cFS   atomic.Uint64
+	szDB, szFS atomic.Uint64
+
+	flushed, flushedSize, flushFails atomic.Uint64
+
+	metrics MetricsWriter
 }
 
-func (x *counters) IncDB() {
-	x.cDB.Inc()
+func (x *counters) IncDB(sz uint64) {
+	x.metrics.SetObjectCounter(StorageDB, x.cDB.Inc())
+	x.metrics.SetSize(StorageDB, x.szDB.Add(sz))
 }
 
-func (x *counters) DecDB() {
-	x.cDB.Dec()
+func (x *counters) DecDB(sz uint64) {
+	x.metrics.SetObjectCounter(StorageDB, x.cDB.Dec())
+	x.metrics.SetSize(StorageDB, x.szDB.Sub(sz))
 }
 
 func (x *counters) DB() uint64 {
 	return x.cDB.Load()
 }
 
-func (x *counters) IncFS() {
-	x.cFS.Inc()
+func (x *counters) IncFS(sz uint64) {
+	x.metrics.SetObjectCounter(StorageFSTree, x.cFS.Inc())
+	x.metrics.SetSize(StorageFSTree, x.szFS.Add(sz))
 }
 
-func (x *counters) DecFS() {
-	x.cFS.Dec()
+func (x *counters) DecFS(sz uint64) {
+	x.metrics.SetObjectCounter(StorageFSTree, x.cFS.Dec())
+	x.metrics.SetSize(StorageFSTree, x.szFS.Sub(sz))
 }
 
 func (x *counters) FS() uint64 {
 	return x.cFS.Load()
 }
 
+func (x *counters) AddFlush(success bool, sz uint64) {
+	if success {
+		x.flushed.Inc()
+		x.flushedSize.Add(sz)
+	} else {
+		x.flushFails.Inc()
+	}
+	x.metrics.AddFlush(success, sz)
+}
+
 func (c *cache) initCounters() error {
-	var inDB uint64
+	var inDB, szDB uint64
 	err := c.db.View(func(tx *bbolt.Tx) error {
 		b := tx.Bucket(defaultBucket)
 		if b != nil {
-			inDB = uint64(b.Stats().KeyN)
+			return b.ForEach(func(_, v []byte) error {
+				inDB++
+				szDB += uint64(len(v))
+				return nil
+			})
 		}
 		return nil
 	})
@@ -60,13 +83,20 @@ func (c *cache) initCounters() error {
 		return fmt.Errorf("could not read write-cache DB counter: %w", err)
 	}
 
-	inFS, err := c.fsTree.NumberOfObjects()
+	inFS, szFS, err := c.fsTree.Usage()
 	if err != nil {
 		return fmt.Errorf("could not read write-cache FS counter: %w", err)
 	}
 
 	c.objCounters.cDB.Store(inDB)
+	c.objCounters.szDB.Store(szDB)
 	c.objCounters.cFS.Store(inFS)
+	c.objCounters.szFS.Store(szFS)
+
+	c.metrics.SetObjectCounter(StorageDB, inDB)
+	c.metrics.SetSize(StorageDB, szDB)
+	c.metrics.SetObjectCounter(StorageFSTree, inFS)
+	c.metrics.SetSize(StorageFSTree, szFS)
 
 	return nil
 }
@@ -6,7 +6,6 @@ import (
 	"os"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
-	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
 	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
@@ -56,12 +55,7 @@ func (c *cache) openStore(readOnly bool) error {
 		}
 	}
 
-	c.fsTree = fstree.New(
-		fstree.WithPath(c.path),
-		fstree.WithPerm(os.ModePerm),
-		fstree.WithDepth(1),
-		fstree.WithDirNameLen(1),
-		fstree.WithNoSync(c.noSync))
+	c.fsTree = NewFSTree(c.path, c.noSync)
 	if err := c.fsTree.Open(readOnly); err != nil {
 		return fmt.Errorf("could not open FSTree: %w", err)
 	}
@@ -98,17 +92,20 @@ func (c *cache) deleteFromDB(keys []string) []string {
 	}
 
 	var errorIndex int
+	sizes := make([]uint64, len(keys))
 	err := c.db.Batch(func(tx *bbolt.Tx) error {
 		b := tx.Bucket(defaultBucket)
 		for errorIndex = range keys {
+			sizes[errorIndex] = uint64(len(b.Get([]byte(keys[errorIndex]))))
 			if err := b.Delete([]byte(keys[errorIndex])); err != nil {
 				return err
 			}
 		}
+		errorIndex = len(keys)
 		return nil
 	})
 	for i := 0; i < errorIndex; i++ {
-		c.objCounters.DecDB()
+		c.objCounters.DecDB(sizes[i])
 		storagelog.Write(c.log,
 			storagelog.AddressField(keys[i]),
 			storagelog.StorageTypeField(wcStorageType),
@@ -137,6 +134,8 @@ func (c *cache) deleteFromDisk(keys []string) []string {
 			continue
 		}
 
+		sz, _ := c.fsTree.ObjectSize(addr)
+
 		_, err := c.fsTree.Delete(common.DeletePrm{Address: addr})
 		if err != nil && !errors.As(err, new(apistatus.ObjectNotFound)) {
 			c.log.Error("can't remove object from write-cache", zap.Error(err))
@@ -151,7 +150,7 @@ func (c *cache) deleteFromDisk(keys []string) []string {
 				storagelog.StorageTypeField(wcStorageType),
 				storagelog.OpField("fstree DELETE"),
 			)
-			c.objCounters.DecFS()
+			c.objCounters.DecFS(sz)
 		}
 	}
 
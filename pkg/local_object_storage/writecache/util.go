@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
 	"go.etcd.io/bbolt"
 )
 
@@ -16,3 +17,13 @@ func OpenDB(p string, ro bool) (*bbolt.DB, error) {
 		Timeout:        100 * time.Millisecond,
 	})
 }
+
+// NewFSTree returns FSTree instance storing big objects of the write-cache located at p.
+func NewFSTree(p string, noSync bool) *fstree.FSTree {
+	return fstree.New(
+		fstree.WithPath(p),
+		fstree.WithPerm(os.ModePerm),
+		fstree.WithDepth(1),
+		fstree.WithDirNameLen(1),
+		fstree.WithNoSync(noSync))
+}
@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"context"
 	"sync"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
@@ -19,10 +20,29 @@ type Info struct {
 	Path string
 }
 
+// Stats groups the statistics of write-cache.
+type Stats struct {
+	// Number of objects stored in the database.
+	ObjectsDB uint64
+	// Total size of objects stored in the database.
+	SizeDB uint64
+	// Number of objects stored in the file tree.
+	ObjectsFS uint64
+	// Total size of objects stored in the file tree.
+	SizeFS uint64
+
+	// Number of objects flushed to the main storage since the start.
+	Flushed uint64
+	// Total size of objects flushed to the main storage since the start.
+	FlushedSize uint64
+	// Number of objects failed to be flushed since the start.
+	FlushErrors uint64
+}
+
 // Cache represents write-cache for objects.
 type Cache interface {
-	Get(address oid.Address) (*object.Object, error)
-	Head(oid.Address) (*object.Object, error)
+	Get(ctx context.Context, address oid.Address) (*object.Object, error)
+	Head(context.Context, oid.Address) (*object.Object, error)
 	// Delete removes object referenced by the given oid.Address from the
 	// Cache. Returns any error encountered that prevented the object to be
 	// removed.
@@ -31,10 +51,11 @@ type Cache interface {
 	// Returns ErrReadOnly if the Cache is currently in the read-only mode.
 	Delete(oid.Address) error
 	Iterate(IterationPrm) error
-	Put(common.PutPrm) (common.PutRes, error)
+	Put(context.Context, common.PutPrm) (common.PutRes, error)
 	SetMode(mode.Mode) error
 	SetLogger(*logger.Logger)
 	DumpInfo() Info
+	Stats() Stats
 	Flush(bool) error
 
 	Init() error
@@ -101,6 +122,7 @@ func New(opts ...Option) Cache {
 			maxCacheSize:    defaultMaxCacheSize,
 			maxBatchSize:    bbolt.DefaultMaxBatchSize,
 			maxBatchDelay:   bbolt.DefaultMaxBatchDelay,
+			metrics:         noopMetrics{},
 		},
 	}
 
@@ -108,6 +130,8 @@ func New(opts ...Option) Cache {
 		opts[i](&c.options)
 	}
 
+	c.objCounters.metrics = c.metrics
+
 	// Make the LRU cache contain which take approximately 3/4 of the maximum space.
 	// Assume small and big objects are stored in 50-50 proportion.
 	c.maxFlushedMarksCount = int(c.maxCacheSize/c.maxObjectSize+c.maxCacheSize/c.smallObjectSize) / 2 * 3 / 4
@@ -128,6 +152,19 @@ func (c *cache) DumpInfo() Info {
 	}
 }
 
+// Stats returns the current statistics of write-cache.
+func (c *cache) Stats() Stats {
+	return Stats{
+		ObjectsDB:   c.objCounters.DB(),
+		SizeDB:      c.objCounters.szDB.Load(),
+		ObjectsFS:   c.objCounters.FS(),
+		SizeFS:      c.objCounters.szFS.Load(),
+		Flushed:     c.objCounters.flushed.Load(),
+		FlushedSize: c.objCounters.flushedSize.Load(),
+		FlushErrors: c.objCounters.flushFails.Load(),
+	}
+}
+
 // Open opens and initializes database. Reads object counters from the ObjectCounters instance.
 func (c *cache) Open(readOnly bool) error {
 	err := c.openStore(readOnly)
@@ -21,11 +21,20 @@ type (
 		listObjectsDuration           prometheus.Counter
 		containerSize                 prometheus.GaugeVec
 		payloadSize                   prometheus.GaugeVec
+		shardMethodDuration           prometheus.HistogramVec
+		shardMethodErrors             prometheus.CounterVec
+		scrubbedObjects               prometheus.CounterVec
+		scrubCorruptedObjects         prometheus.CounterVec
 	}
 )
 
 const engineSubsystem = "engine"
 
+const (
+	componentLabelKey = "component"
+	methodLabelKey    = "method"
+)
+
 func newEngineMetrics() engineMetrics {
 	var (
 		listContainersDuration = prometheus.NewCounter(prometheus.CounterOpts{
@@ -118,6 +127,35 @@ func newEngineMetrics() engineMetrics {
 			Name:      "payload_size",
 			Help:      "Accumulated size of all objects in a shard",
 		}, []string{shardIDLabelKey})
+
+		shardMethodDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "shard_method_duration_seconds",
+			Help:      "Duration of shard storage component operations",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{shardIDLabelKey, componentLabelKey, methodLabelKey})
+
+		shardMethodErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "shard_method_errors_total",
+			Help:      "Number of shard storage component operations failed with non-logical error",
+		}, []string{shardIDLabelKey, componentLabelKey, methodLabelKey})
+
+		scrubbedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "scrub_checked_objects_total",
+			Help:      "Number of objects verified by the shard scrubbing",
+		}, []string{shardIDLabelKey})
+
+		scrubCorruptedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "scrub_corrupted_objects_total",
+			Help:      "Number of corrupted objects found by the shard scrubbing",
+		}, []string{shardIDLabelKey})
 	)
 
 	return engineMetrics{
@@ -134,6 +172,10 @@ func newEngineMetrics() engineMetrics {
 		listObjectsDuration:           listObjectsDuration,
 		containerSize:                 *containerSize,
 		payloadSize:                   *payloadSize,
+		shardMethodDuration:           *shardMethodDuration,
+		shardMethodErrors:             *shardMethodErrors,
+		scrubbedObjects:               *scrubbedObjects,
+		scrubCorruptedObjects:         *scrubCorruptedObjects,
 	}
 }
 
@@ -151,6 +193,10 @@ func (m engineMetrics) register() {
 	prometheus.MustRegister(m.listObjectsDuration)
 	prometheus.MustRegister(m.containerSize)
 	prometheus.MustRegister(m.payloadSize)
+	prometheus.MustRegister(m.shardMethodDuration)
+	prometheus.MustRegister(m.shardMethodErrors)
+	prometheus.MustRegister(m.scrubbedObjects)
+	prometheus.MustRegister(m.scrubCorruptedObjects)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
@@ -204,3 +250,25 @@ func (m engineMetrics) AddToContainerSize(cnrID string, size int64) {
 func (m engineMetrics) AddToPayloadCounter(shardID string, size int64) {
 	m.payloadSize.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(size))
 }
+
+func (m engineMetrics) AddShardMethodDuration(shardID, component, method string, success bool, d time.Duration) {
+	labels := prometheus.Labels{
+		shardIDLabelKey:   shardID,
+		componentLabelKey: component,
+		methodLabelKey:    method,
+	}
+
+	m.shardMethodDuration.With(labels).Observe(d.Seconds())
+	if !success {
+		m.shardMethodErrors.With(labels).Inc()
+	}
+}
+
+func (m engineMetrics) AddScrubbedObject(shardID string, corrupted bool) {
+	labels := prometheus.Labels{shardIDLabelKey: shardID}
+
+	m.scrubbedObjects.With(labels).Inc()
+	if corrupted {
+		m.scrubCorruptedObjects.With(labels).Inc()
+	}
+}
@@ -1,12 +1,44 @@
 package metrics
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 const innerRingSubsystem = "object"
 
+const (
+	irSubsystem = "ir"
+
+	irProcessorLabelKey = "processor"
+	irEventLabelKey     = "event"
+	irMethodLabelKey    = "method"
+	irResultLabelKey    = "result"
+	irTypeLabelKey      = "type"
+	irSuccessLabelKey   = "success"
+)
+
 // InnerRingServiceMetrics contains metrics collected by inner ring.
 type InnerRingServiceMetrics struct {
 	epoch prometheus.Gauge
+
+	eventsReceived prometheus.CounterVec
+	eventsDropped  prometheus.CounterVec
+	eventsHandled  prometheus.CounterVec
+	eventDuration  prometheus.HistogramVec
+
+	notarySigned prometheus.CounterVec
+
+	emissions  prometheus.Counter
+	emittedGAS prometheus.Counter
+
+	auditRoundDuration prometheus.Histogram
+	auditResults       prometheus.CounterVec
+
+	settlementTransfers   prometheus.CounterVec
+	settlementTransferred prometheus.CounterVec
 }
 
 // NewInnerRingMetrics returns new instance of metrics collectors for inner ring.
@@ -18,12 +50,113 @@ func NewInnerRingMetrics() InnerRingServiceMetrics {
 			Name:      "epoch",
 			Help:      "Current epoch as seen by inner-ring node.",
 		})
+
+		eventsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "events_received_total",
+			Help:      "Number of events received by the inner ring processors",
+		}, []string{irProcessorLabelKey, irEventLabelKey})
+
+		eventsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "events_dropped_total",
+			Help:      "Number of events dropped by the inner ring processors because of the drained worker pool",
+		}, []string{irProcessorLabelKey, irEventLabelKey})
+
+		eventsHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "events_handled_total",
+			Help:      "Number of events handled by the inner ring processors",
+		}, []string{irProcessorLabelKey, irEventLabelKey})
+
+		eventDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "event_handling_duration_seconds",
+			Help:      "Duration of the event handling by the inner ring processors",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{irProcessorLabelKey, irEventLabelKey})
+
+		notarySigned = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "notary_signed_requests_total",
+			Help:      "Number of notary requests signed by the inner ring node",
+		}, []string{irMethodLabelKey})
+
+		emissions = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "alphabet_emissions_total",
+			Help:      "Number of GAS emissions invoked in the alphabet contract",
+		})
+
+		emittedGAS = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "alphabet_emitted_gas_total",
+			Help:      "Amount of GAS transferred to the storage nodes during emission",
+		})
+
+		auditRoundDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "audit_round_duration_seconds",
+			Help:      "Duration of the audit round: container selection and audit task scheduling",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600},
+		})
+
+		auditResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "audit_results_total",
+			Help:      "Number of audit results written by the inner ring node",
+		}, []string{irResultLabelKey})
+
+		settlementTransfers = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "settlement_transfers_total",
+			Help:      "Number of settlement transfers sent by the inner ring node",
+		}, []string{irTypeLabelKey, irSuccessLabelKey})
+
+		settlementTransferred = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: irSubsystem,
+			Name:      "settlement_transferred_gas_total",
+			Help:      "Amount of GAS transferred by the successful settlement transfers",
+		}, []string{irTypeLabelKey})
 	)
 
 	prometheus.MustRegister(epoch)
+	prometheus.MustRegister(eventsReceived)
+	prometheus.MustRegister(eventsDropped)
+	prometheus.MustRegister(eventsHandled)
+	prometheus.MustRegister(eventDuration)
+	prometheus.MustRegister(notarySigned)
+	prometheus.MustRegister(emissions)
+	prometheus.MustRegister(emittedGAS)
+	prometheus.MustRegister(auditRoundDuration)
+	prometheus.MustRegister(auditResults)
+	prometheus.MustRegister(settlementTransfers)
+	prometheus.MustRegister(settlementTransferred)
 
 	return InnerRingServiceMetrics{
-		epoch: epoch,
+		epoch:                 epoch,
+		eventsReceived:        *eventsReceived,
+		eventsDropped:         *eventsDropped,
+		eventsHandled:         *eventsHandled,
+		eventDuration:         *eventDuration,
+		notarySigned:          *notarySigned,
+		emissions:             emissions,
+		emittedGAS:            emittedGAS,
+		auditRoundDuration:    auditRoundDuration,
+		auditResults:          *auditResults,
+		settlementTransfers:   *settlementTransfers,
+		settlementTransferred: *settlementTransferred,
 	}
 }
 
@@ -31,3 +164,73 @@ func NewInnerRingMetrics() InnerRingServiceMetrics {
 func (m InnerRingServiceMetrics) SetEpoch(epoch uint64) {
 	m.epoch.Set(float64(epoch))
 }
+
+// AddReceivedEvent increments the number of events received by the processor.
+func (m InnerRingServiceMetrics) AddReceivedEvent(processor, event string) {
+	m.eventsReceived.With(eventLabels(processor, event)).Inc()
+}
+
+// AddDroppedEvent increments the number of events dropped by the processor.
+func (m InnerRingServiceMetrics) AddDroppedEvent(processor, event string) {
+	m.eventsDropped.With(eventLabels(processor, event)).Inc()
+}
+
+// AddHandledEvent increments the number of events handled by the processor
+// and observes the handling duration.
+func (m InnerRingServiceMetrics) AddHandledEvent(processor, event string, d time.Duration) {
+	labels := eventLabels(processor, event)
+
+	m.eventsHandled.With(labels).Inc()
+	m.eventDuration.With(labels).Observe(d.Seconds())
+}
+
+// AddNotarySignedRequest increments the number of notary requests signed by the node.
+func (m InnerRingServiceMetrics) AddNotarySignedRequest(method string) {
+	m.notarySigned.With(prometheus.Labels{
+		irMethodLabelKey: method,
+	}).Inc()
+}
+
+// AddEmission increments the number of alphabet contract emissions.
+func (m InnerRingServiceMetrics) AddEmission() {
+	m.emissions.Inc()
+}
+
+// AddEmittedGAS adds GAS fractions (10^-8) transferred to a storage node.
+func (m InnerRingServiceMetrics) AddEmittedGAS(amount int64) {
+	m.emittedGAS.Add(float64(amount) / 1e8)
+}
+
+// AddAuditRound observes the audit round duration.
+func (m InnerRingServiceMetrics) AddAuditRound(d time.Duration) {
+	m.auditRoundDuration.Observe(d.Seconds())
+}
+
+// AddAuditResult increments the number of written audit results.
+func (m InnerRingServiceMetrics) AddAuditResult(result string) {
+	m.auditResults.With(prometheus.Labels{
+		irResultLabelKey: result,
+	}).Inc()
+}
+
+// AddSettlementTransfer increments the number of settlement transfers and adds
+// the amount in balance contract units (GAS 10^-12) of the successful ones.
+func (m InnerRingServiceMetrics) AddSettlementTransfer(typ string, amount int64, success bool) {
+	m.settlementTransfers.With(prometheus.Labels{
+		irTypeLabelKey:    typ,
+		irSuccessLabelKey: strconv.FormatBool(success),
+	}).Inc()
+
+	if success {
+		m.settlementTransferred.With(prometheus.Labels{
+			irTypeLabelKey: typ,
+		}).Add(float64(amount) / 1e12)
+	}
+}
+
+func eventLabels(processor, event string) prometheus.Labels {
+	return prometheus.Labels{
+		irProcessorLabelKey: processor,
+		irEventLabelKey:     event,
+	}
+}
@@ -7,6 +7,7 @@ const namespace = "frostfs_node"
 type NodeMetrics struct {
 	objectServiceMetrics
 	engineMetrics
+	writeCacheMetrics
 	stateMetrics
 	epoch prometheus.Gauge
 }
@@ -18,6 +19,9 @@ func NewNodeMetrics() *NodeMetrics {
 	engine := newEngineMetrics()
 	engine.register()
 
+	writeCache := newWriteCacheMetrics()
+	writeCache.register()
+
 	state := newStateMetrics()
 	state.register()
 
@@ -32,6 +36,7 @@ func NewNodeMetrics() *NodeMetrics {
 	return &NodeMetrics{
 		objectServiceMetrics: objectService,
 		engineMetrics:        engine,
+		writeCacheMetrics:    writeCache,
 		stateMetrics:         state,
 		epoch:                epoch,
 	}
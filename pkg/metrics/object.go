@@ -23,6 +23,7 @@ type (
 		deleteCounter    methodCount
 		rangeCounter     methodCount
 		rangeHashCounter methodCount
+		patchCounter     methodCount
 
 		getDuration       prometheus.Counter
 		putDuration       prometheus.Counter
@@ -31,9 +32,11 @@ type (
 		deleteDuration    prometheus.Counter
 		rangeDuration     prometheus.Counter
 		rangeHashDuration prometheus.Counter
+		patchDuration     prometheus.Counter
 
-		putPayload prometheus.Counter
-		getPayload prometheus.Counter
+		putPayload   prometheus.Counter
+		getPayload   prometheus.Counter
+		patchPayload prometheus.Counter
 
 		shardMetrics   *prometheus.GaugeVec
 		shardsReadonly *prometheus.GaugeVec
@@ -84,6 +87,7 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		deleteCounter    = newMethodCallCounter("delete")
 		rangeCounter     = newMethodCallCounter("range")
 		rangeHashCounter = newMethodCallCounter("range_hash")
+		patchCounter     = newMethodCallCounter("patch")
 	)
 
 	var ( // Request duration metrics.
@@ -135,6 +139,13 @@ func newObjectServiceMetrics() objectServiceMetrics {
 			Name:      "range_hash_req_duration",
 			Help:      "Accumulated range hash request process duration",
 		})
+
+		patchDuration = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "patch_req_duration",
+			Help:      "Accumulated patch request process duration",
+		})
 	)
 
 	var ( // Object payload metrics.
@@ -152,6 +163,13 @@ func newObjectServiceMetrics() objectServiceMetrics {
 			Help:      "Accumulated payload size at object get method",
 		})
 
+		patchPayload = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "patch_payload",
+			Help:      "Accumulated payload patch size at object patch method",
+		})
+
 		shardsMetrics = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: objectSubsystem,
@@ -179,6 +197,7 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		deleteCounter:     deleteCounter,
 		rangeCounter:      rangeCounter,
 		rangeHashCounter:  rangeHashCounter,
+		patchCounter:      patchCounter,
 		getDuration:       getDuration,
 		putDuration:       putDuration,
 		headDuration:      headDuration,
@@ -186,8 +205,10 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		deleteDuration:    deleteDuration,
 		rangeDuration:     rangeDuration,
 		rangeHashDuration: rangeHashDuration,
+		patchDuration:     patchDuration,
 		putPayload:        putPayload,
 		getPayload:        getPayload,
+		patchPayload:      patchPayload,
 		shardMetrics:      shardsMetrics,
 		shardsReadonly:    shardsReadonly,
 	}
@@ -201,6 +222,7 @@ func (m objectServiceMetrics) register() {
 	m.deleteCounter.mustRegister()
 	m.rangeCounter.mustRegister()
 	m.rangeHashCounter.mustRegister()
+	m.patchCounter.mustRegister()
 
 	prometheus.MustRegister(m.getDuration)
 	prometheus.MustRegister(m.putDuration)
@@ -209,9 +231,11 @@ func (m objectServiceMetrics) register() {
 	prometheus.MustRegister(m.deleteDuration)
 	prometheus.MustRegister(m.rangeDuration)
 	prometheus.MustRegister(m.rangeHashDuration)
+	prometheus.MustRegister(m.patchDuration)
 
 	prometheus.MustRegister(m.putPayload)
 	prometheus.MustRegister(m.getPayload)
+	prometheus.MustRegister(m.patchPayload)
 
 	prometheus.MustRegister(m.shardMetrics)
 	prometheus.MustRegister(m.shardsReadonly)
@@ -245,6 +269,10 @@ func (m objectServiceMetrics) IncRangeHashReqCounter(success bool) {
 	m.rangeHashCounter.Inc(success)
 }
 
+func (m objectServiceMetrics) IncPatchReqCounter(success bool) {
+	m.patchCounter.Inc(success)
+}
+
 func (m objectServiceMetrics) AddGetReqDuration(d time.Duration) {
 	m.getDuration.Add(float64(d))
 }
@@ -273,6 +301,10 @@ func (m objectServiceMetrics) AddRangeHashReqDuration(d time.Duration) {
 	m.rangeHashDuration.Add(float64(d))
 }
 
+func (m objectServiceMetrics) AddPatchReqDuration(d time.Duration) {
+	m.patchDuration.Add(float64(d))
+}
+
 func (m objectServiceMetrics) AddPutPayload(ln int) {
 	m.putPayload.Add(float64(ln))
 }
@@ -281,6 +313,10 @@ func (m objectServiceMetrics) AddGetPayload(ln int) {
 	m.getPayload.Add(float64(ln))
 }
 
+func (m objectServiceMetrics) AddPatchPayload(ln int) {
+	m.patchPayload.Add(float64(ln))
+}
+
 func (m objectServiceMetrics) AddToObjectCounter(shardID, objectType string, delta int) {
 	m.shardMetrics.With(
 		prometheus.Labels{
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const writeCacheSubsystem = "writecache"
+
+const storageLabelKey = "storage"
+
+type writeCacheMetrics struct {
+	objects      prometheus.GaugeVec
+	size         prometheus.GaugeVec
+	flushed      prometheus.CounterVec
+	flushedBytes prometheus.CounterVec
+	flushErrors  prometheus.CounterVec
+}
+
+func newWriteCacheMetrics() writeCacheMetrics {
+	var (
+		objects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: writeCacheSubsystem,
+			Name:      "objects",
+			Help:      "Number of objects stored in write-cache",
+		}, []string{shardIDLabelKey, storageLabelKey})
+
+		size = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: writeCacheSubsystem,
+			Name:      "size_bytes",
+			Help:      "Total size of objects stored in write-cache",
+		}, []string{shardIDLabelKey, storageLabelKey})
+
+		flushed = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: writeCacheSubsystem,
+			Name:      "flushed_objects_total",
+			Help:      "Number of objects flushed from write-cache to the main storage",
+		}, []string{shardIDLabelKey})
+
+		flushedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: writeCacheSubsystem,
+			Name:      "flushed_bytes_total",
+			Help:      "Total size of objects flushed from write-cache to the main storage",
+		}, []string{shardIDLabelKey})
+
+		flushErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: writeCacheSubsystem,
+			Name:      "flush_errors_total",
+			Help:      "Number of objects failed to be flushed from write-cache",
+		}, []string{shardIDLabelKey})
+	)
+
+	return writeCacheMetrics{
+		objects:      *objects,
+		size:         *size,
+		flushed:      *flushed,
+		flushedBytes: *flushedBytes,
+		flushErrors:  *flushErrors,
+	}
+}
+
+func (m writeCacheMetrics) register() {
+	prometheus.MustRegister(m.objects)
+	prometheus.MustRegister(m.size)
+	prometheus.MustRegister(m.flushed)
+	prometheus.MustRegister(m.flushedBytes)
+	prometheus.MustRegister(m.flushErrors)
+}
+
+func (m writeCacheMetrics) SetWriteCacheObjectCounter(shardID, storage string, v uint64) {
+	m.objects.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+		storageLabelKey: storage,
+	}).Set(float64(v))
+}
+
+func (m writeCacheMetrics) SetWriteCacheSize(shardID, storage string, v uint64) {
+	m.size.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+		storageLabelKey: storage,
+	}).Set(float64(v))
+}
+
+func (m writeCacheMetrics) AddWriteCacheFlush(shardID string, success bool, size uint64) {
+	labels := prometheus.Labels{shardIDLabelKey: shardID}
+	if !success {
+		m.flushErrors.With(labels).Inc()
+		return
+	}
+
+	m.flushed.With(labels).Inc()
+	m.flushedBytes.With(labels).Add(float64(size))
+}
@@ -44,6 +44,8 @@ type cfg struct {
 	inactiveModeCb Callback
 
 	switchInterval time.Duration
+
+	notarySignCb func(mainTx util.Uint256)
 }
 
 const (
@@ -287,3 +289,13 @@ func WithSwitchInterval(i time.Duration) Option {
 		c.switchInterval = i
 	}
 }
+
+// WithNotarySignCallback returns a client constructor option
+// that specifies a callback that is called with the main
+// transaction hash of each notary request successfully
+// signed by NotarySignAndInvokeTX.
+func WithNotarySignCallback(cb func(mainTx util.Uint256)) Option {
+	return func(c *cfg) {
+		c.notarySignCb = cb
+	}
+}
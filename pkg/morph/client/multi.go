@@ -1,6 +1,7 @@
 package client
 
 import (
+	"errors"
 	"sort"
 	"time"
 
@@ -27,6 +28,51 @@ func (e *endpoints) init(ee []Endpoint) {
 	e.list = ee
 }
 
+// SetEndpoints replaces the list of RPC endpoints the client switches between.
+//
+// The current connection is kept, so subscriptions are not interrupted. If the
+// current endpoint is missing in the new list, it is kept with the lowest
+// priority until the client switches to another one. If the switch interval is
+// configured and there are endpoints with a higher priority, the client
+// switches to them in the background.
+func (c *Client) SetEndpoints(ee ...Endpoint) error {
+	if len(ee) == 0 {
+		return errors.New("no endpoints were provided")
+	}
+
+	list := make([]Endpoint, len(ee))
+	copy(list, ee)
+
+	c.switchLock.Lock()
+	defer c.switchLock.Unlock()
+
+	curr := c.endpoints.list[c.endpoints.curr]
+
+	c.endpoints.init(list)
+
+	var found bool
+	for i := range c.endpoints.list {
+		if found = c.endpoints.list[i].Address == curr.Address; found {
+			c.endpoints.curr = i
+			break
+		}
+	}
+
+	if !found {
+		curr.Priority = c.endpoints.list[len(c.endpoints.list)-1].Priority + 1
+		c.endpoints.list = append(c.endpoints.list, curr)
+		c.endpoints.curr = len(c.endpoints.list) - 1
+	}
+
+	if !c.inactive && c.cfg.switchInterval != 0 && !c.switchIsActive.Load() &&
+		c.endpoints.list[c.endpoints.curr].Priority != c.endpoints.list[0].Priority {
+		c.switchIsActive.Store(true)
+		go c.switchToMostPrioritized()
+	}
+
+	return nil
+}
+
 func (c *Client) switchRPC() bool {
 	c.switchLock.Lock()
 	defer c.switchLock.Unlock()
@@ -2,6 +2,7 @@ package client
 
 import (
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,3 +28,39 @@ func TestInitEndpoints(t *testing.T) {
 		prevValue = e.Priority
 	}
 }
+
+func TestClient_SetEndpoints(t *testing.T) {
+	c := &Client{switchLock: &sync.RWMutex{}}
+	c.endpoints.init([]Endpoint{
+		{Address: "a", Priority: 1},
+		{Address: "b", Priority: 2},
+	})
+	c.endpoints.curr = 1
+
+	require.Error(t, c.SetEndpoints())
+
+	t.Run("current endpoint is kept", func(t *testing.T) {
+		require.NoError(t, c.SetEndpoints(
+			Endpoint{Address: "c", Priority: 3},
+			Endpoint{Address: "b", Priority: 1},
+		))
+		require.Equal(t, []Endpoint{
+			{Address: "b", Priority: 1},
+			{Address: "c", Priority: 3},
+		}, c.endpoints.list)
+		require.Equal(t, 0, c.endpoints.curr)
+	})
+
+	t.Run("current endpoint is removed", func(t *testing.T) {
+		require.NoError(t, c.SetEndpoints(
+			Endpoint{Address: "d", Priority: 2},
+			Endpoint{Address: "c", Priority: 1},
+		))
+		require.Equal(t, []Endpoint{
+			{Address: "c", Priority: 1},
+			{Address: "d", Priority: 2},
+			{Address: "b", Priority: 3},
+		}, c.endpoints.list)
+		require.Equal(t, 2, c.endpoints.curr)
+	})
+}
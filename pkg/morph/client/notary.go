@@ -437,6 +437,10 @@ func (c *Client) NotarySignAndInvokeTX(mainTx *transaction.Transaction) error {
 		zap.Uint32("fallback_valid_for", c.notary.fallbackTime),
 		zap.Stringer("tx_hash", resp.Hash().Reverse()))
 
+	if c.cfg.notarySignCb != nil {
+		c.cfg.notarySignCb(mainTx.Hash())
+	}
+
 	return nil
 }
 
@@ -113,11 +113,40 @@ func (t *BlockTimer) OnDelta(mul, div uint32, h BlockTickHandler, opts ...DeltaO
 	})
 }
 
+// SetDelta changes (mul / div) fraction of the i-th delta-interval handler
+// in the order of OnDelta calls. Does nothing if there is no such handler.
+//
+// New value is applied after the next reset of the handler interval.
+func (t *BlockTimer) SetDelta(i int, mul, div uint32) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if i < 0 || i >= len(t.ps) {
+		return
+	}
+
+	t.ps[i].mul = mul
+	t.ps[i].div = div
+}
+
+// SetBlockMeter changes the BlockMeter of the BlockTimer.
+//
+// New value is applied on the next Reset call.
+func (t *BlockTimer) SetBlockMeter(dur BlockMeter) {
+	t.mtx.Lock()
+	t.dur = dur
+	t.mtx.Unlock()
+}
+
 // Reset resets previous ticks of the BlockTimer.
 //
 // Returns BlockMeter's error upon occurrence.
 func (t *BlockTimer) Reset() error {
-	d, err := t.dur()
+	t.mtx.Lock()
+	dur := t.dur
+	t.mtx.Unlock()
+
+	d, err := dur()
 	if err != nil {
 		return err
 	}
@@ -247,3 +247,46 @@ func TestBlockTimer_TickSameHeight(t *testing.T) {
 		})
 	})
 }
+
+func TestBlockTimer_SetDelta(t *testing.T) {
+	var deltaCounter int
+
+	bt := timer.NewBlockTimer(timer.StaticBlockMeter(10), func() {})
+	bt.OnDelta(1, 2, func() {
+		deltaCounter++
+	})
+
+	require.NoError(t, bt.Reset())
+
+	bt.SetDelta(0, 9, 10)
+	bt.SetDelta(1, 1, 1) // no such handler
+
+	// current interval is not affected
+	tickN(bt, 5)
+	require.Equal(t, 1, deltaCounter)
+
+	require.NoError(t, bt.Reset())
+
+	tickN(bt, 5)
+	require.Equal(t, 1, deltaCounter)
+
+	tickN(bt, 4)
+	require.Equal(t, 2, deltaCounter)
+}
+
+func TestBlockTimer_SetBlockMeter(t *testing.T) {
+	var baseCounter int
+
+	bt := timer.NewBlockTimer(timer.StaticBlockMeter(10), func() {
+		baseCounter++
+	})
+
+	require.NoError(t, bt.Reset())
+
+	bt.SetBlockMeter(timer.StaticBlockMeter(3))
+
+	require.NoError(t, bt.Reset())
+
+	tickN(bt, 3)
+	require.Equal(t, 1, baseCounter)
+}
@@ -10,8 +10,11 @@ import (
 	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
 	clientcore "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/network"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"github.com/TrueCloudLab/frostfs-sdk-go/client"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type singleClient struct {
@@ -142,6 +145,9 @@ func (x *multiClient) iterateClients(ctx context.Context, f func(clientcore.Clie
 
 		var err error
 
+		trace.SpanFromContext(ctx).AddEvent("try address",
+			trace.WithAttributes(attribute.String("address", addr.String())))
+
 		c, err := x.client(addr)
 		if err == nil {
 			err = f(c)
@@ -201,6 +207,9 @@ func (s *singleClient) invalidate() {
 }
 
 func (x *multiClient) ObjectPutInit(ctx context.Context, p client.PrmObjectPutInit) (res *client.ObjectWriter, err error) {
+	ctx, span := startClientSpan(ctx, "ObjectPutInit")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectPutInit(ctx, p)
 		return err
@@ -210,6 +219,9 @@ func (x *multiClient) ObjectPutInit(ctx context.Context, p client.PrmObjectPutIn
 }
 
 func (x *multiClient) ContainerAnnounceUsedSpace(ctx context.Context, prm client.PrmAnnounceSpace) (res *client.ResAnnounceSpace, err error) {
+	ctx, span := startClientSpan(ctx, "ContainerAnnounceUsedSpace")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ContainerAnnounceUsedSpace(ctx, prm)
 		return err
@@ -219,6 +231,9 @@ func (x *multiClient) ContainerAnnounceUsedSpace(ctx context.Context, prm client
 }
 
 func (x *multiClient) ObjectDelete(ctx context.Context, p client.PrmObjectDelete) (res *client.ResObjectDelete, err error) {
+	ctx, span := startClientSpan(ctx, "ObjectDelete")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectDelete(ctx, p)
 		return err
@@ -228,6 +243,9 @@ func (x *multiClient) ObjectDelete(ctx context.Context, p client.PrmObjectDelete
 }
 
 func (x *multiClient) ObjectGetInit(ctx context.Context, p client.PrmObjectGet) (res *client.ObjectReader, err error) {
+	ctx, span := startClientSpan(ctx, "ObjectGetInit")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectGetInit(ctx, p)
 		return err
@@ -237,6 +255,9 @@ func (x *multiClient) ObjectGetInit(ctx context.Context, p client.PrmObjectGet)
 }
 
 func (x *multiClient) ObjectRangeInit(ctx context.Context, p client.PrmObjectRange) (res *client.ObjectRangeReader, err error) {
+	ctx, span := startClientSpan(ctx, "ObjectRangeInit")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectRangeInit(ctx, p)
 		return err
@@ -246,6 +267,9 @@ func (x *multiClient) ObjectRangeInit(ctx context.Context, p client.PrmObjectRan
 }
 
 func (x *multiClient) ObjectHead(ctx context.Context, p client.PrmObjectHead) (res *client.ResObjectHead, err error) {
+	ctx, span := startClientSpan(ctx, "ObjectHead")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectHead(ctx, p)
 		return err
@@ -255,6 +279,9 @@ func (x *multiClient) ObjectHead(ctx context.Context, p client.PrmObjectHead) (r
 }
 
 func (x *multiClient) ObjectHash(ctx context.Context, p client.PrmObjectHash) (res *client.ResObjectHash, err error) {
+	ctx, span := startClientSpan(ctx, "ObjectHash")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectHash(ctx, p)
 		return err
@@ -264,6 +291,9 @@ func (x *multiClient) ObjectHash(ctx context.Context, p client.PrmObjectHash) (r
 }
 
 func (x *multiClient) ObjectSearchInit(ctx context.Context, p client.PrmObjectSearch) (res *client.ObjectListReader, err error) {
+	ctx, span := startClientSpan(ctx, "ObjectSearchInit")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectSearchInit(ctx, p)
 		return err
@@ -273,6 +303,9 @@ func (x *multiClient) ObjectSearchInit(ctx context.Context, p client.PrmObjectSe
 }
 
 func (x *multiClient) AnnounceLocalTrust(ctx context.Context, prm client.PrmAnnounceLocalTrust) (res *client.ResAnnounceLocalTrust, err error) {
+	ctx, span := startClientSpan(ctx, "AnnounceLocalTrust")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.AnnounceLocalTrust(ctx, prm)
 		return err
@@ -282,6 +315,9 @@ func (x *multiClient) AnnounceLocalTrust(ctx context.Context, prm client.PrmAnno
 }
 
 func (x *multiClient) AnnounceIntermediateTrust(ctx context.Context, prm client.PrmAnnounceIntermediateTrust) (res *client.ResAnnounceIntermediateTrust, err error) {
+	ctx, span := startClientSpan(ctx, "AnnounceIntermediateTrust")
+	defer func() { tracing.Finish(span, err) }()
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.AnnounceIntermediateTrust(ctx, prm)
 		return err
@@ -290,6 +326,15 @@ func (x *multiClient) AnnounceIntermediateTrust(ctx context.Context, prm client.
 	return
 }
 
+// startClientSpan starts the span of the remote call and attaches trace
+// context to the outgoing request metadata.
+func startClientSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "multiClient."+method,
+		trace.WithSpanKind(trace.SpanKindClient))
+
+	return tracing.InjectIntoOutgoingContext(ctx), span
+}
+
 func (x *multiClient) ExecRaw(f func(client *rawclient.Client) error) error {
 	panic("multiClient.ExecRaw() must not be called")
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	objectGRPC "github.com/TrueCloudLab/frostfs-api-go/v2/object/grpc"
 	objectSvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/util"
 )
 
@@ -66,6 +67,39 @@ func (s *Server) Put(gStream objectGRPC.ObjectService_PutServer) error {
 	}
 }
 
+// Patch opens internal Object service Patch stream and overtakes data from gRPC stream to it.
+func (s *Server) Patch(gStream patchsvc.PatchService_PatchServer) error {
+	stream, err := s.srv.Patch(gStream.Context())
+	if err != nil {
+		return err
+	}
+
+	for {
+		req, err := gStream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				resp, err := stream.CloseAndRecv()
+				if err != nil {
+					return err
+				}
+
+				return gStream.SendAndClose(resp.ToGRPCMessage())
+			}
+
+			return err
+		}
+
+		patchReq := new(patchsvc.Request)
+		if err := patchReq.FromGRPCMessage(req); err != nil {
+			return err
+		}
+
+		if err := stream.Send(patchReq); err != nil {
+			return err
+		}
+	}
+}
+
 // Delete converts gRPC DeleteRequest message and passes it to internal Object service.
 func (s *Server) Delete(ctx context.Context, req *objectGRPC.DeleteRequest) (*objectGRPC.DeleteResponse, error) {
 	delReq := new(object.DeleteRequest)
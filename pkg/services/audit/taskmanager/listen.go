@@ -68,6 +68,10 @@ func (m *Manager) handleTask(task *audit.Task) {
 }
 
 func (m *Manager) generateContext(task *audit.Task) *auditor.Context {
-	return auditor.NewContext(m.ctxPrm).
+	m.ctxPrmMtx.RLock()
+	prm := m.ctxPrm
+	m.ctxPrmMtx.RUnlock()
+
+	return auditor.NewContext(prm).
 		WithTask(task)
 }
@@ -1,6 +1,7 @@
 package audittask
 
 import (
+	"sync"
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/audit"
@@ -25,7 +26,9 @@ type cfg struct {
 
 	log *logger.Logger
 
-	ctxPrm auditor.ContextPrm
+	// ctxPrmMtx protects ctxPrm from the runtime changes.
+	ctxPrmMtx sync.RWMutex
+	ctxPrm    auditor.ContextPrm
 
 	workerPool util.WorkerPool
 
@@ -105,3 +108,11 @@ func WithPoRWorkerPoolGenerator(f func() (util.WorkerPool, error)) Option {
 		c.porPoolGenerator = f
 	}
 }
+
+// SetMaxPDPSleepInterval changes maximum sleep interval between range hash
+// requests as part of PDP check. New value is applied to the subsequent tasks.
+func (m *Manager) SetMaxPDPSleepInterval(dur time.Duration) {
+	m.ctxPrmMtx.Lock()
+	m.ctxPrm.SetMaxPDPSleep(dur)
+	m.ctxPrmMtx.Unlock()
+}
@@ -200,3 +200,219 @@ func (w *flushCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.FlushCacheResponse = r
 	return nil
 }
+
+type getWriteCacheInfoResponseWrapper struct {
+	*GetWriteCacheInfoResponse
+}
+
+func (w *getWriteCacheInfoResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetWriteCacheInfoResponse
+}
+
+func (w *getWriteCacheInfoResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetWriteCacheInfoResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetWriteCacheInfoResponse)(nil))
+	}
+
+	w.GetWriteCacheInfoResponse = r
+	return nil
+}
+
+type startShardRebalanceResponseWrapper struct {
+	*StartShardRebalanceResponse
+}
+
+func (w *startShardRebalanceResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartShardRebalanceResponse
+}
+
+func (w *startShardRebalanceResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartShardRebalanceResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartShardRebalanceResponse)(nil))
+	}
+
+	w.StartShardRebalanceResponse = r
+	return nil
+}
+
+type pauseShardRebalanceResponseWrapper struct {
+	*PauseShardRebalanceResponse
+}
+
+func (w *pauseShardRebalanceResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.PauseShardRebalanceResponse
+}
+
+func (w *pauseShardRebalanceResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*PauseShardRebalanceResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*PauseShardRebalanceResponse)(nil))
+	}
+
+	w.PauseShardRebalanceResponse = r
+	return nil
+}
+
+type resumeShardRebalanceResponseWrapper struct {
+	*ResumeShardRebalanceResponse
+}
+
+func (w *resumeShardRebalanceResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ResumeShardRebalanceResponse
+}
+
+func (w *resumeShardRebalanceResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ResumeShardRebalanceResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ResumeShardRebalanceResponse)(nil))
+	}
+
+	w.ResumeShardRebalanceResponse = r
+	return nil
+}
+
+type getShardRebalanceStatusResponseWrapper struct {
+	*GetShardRebalanceStatusResponse
+}
+
+func (w *getShardRebalanceStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetShardRebalanceStatusResponse
+}
+
+func (w *getShardRebalanceStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetShardRebalanceStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetShardRebalanceStatusResponse)(nil))
+	}
+
+	w.GetShardRebalanceStatusResponse = r
+	return nil
+}
+
+type startShardEvacuationResponseWrapper struct {
+	*StartShardEvacuationResponse
+}
+
+func (w *startShardEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartShardEvacuationResponse
+}
+
+func (w *startShardEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartShardEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartShardEvacuationResponse)(nil))
+	}
+
+	w.StartShardEvacuationResponse = r
+	return nil
+}
+
+type getShardEvacuationStatusResponseWrapper struct {
+	*GetShardEvacuationStatusResponse
+}
+
+func (w *getShardEvacuationStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetShardEvacuationStatusResponse
+}
+
+func (w *getShardEvacuationStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetShardEvacuationStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetShardEvacuationStatusResponse)(nil))
+	}
+
+	w.GetShardEvacuationStatusResponse = r
+	return nil
+}
+
+type stopShardEvacuationResponseWrapper struct {
+	*StopShardEvacuationResponse
+}
+
+func (w *stopShardEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StopShardEvacuationResponse
+}
+
+func (w *stopShardEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StopShardEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StopShardEvacuationResponse)(nil))
+	}
+
+	w.StopShardEvacuationResponse = r
+	return nil
+}
+
+type startShardScrubResponseWrapper struct {
+	*StartShardScrubResponse
+}
+
+func (w *startShardScrubResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartShardScrubResponse
+}
+
+func (w *startShardScrubResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartShardScrubResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartShardScrubResponse)(nil))
+	}
+
+	w.StartShardScrubResponse = r
+	return nil
+}
+
+type getShardScrubStatusResponseWrapper struct {
+	*GetShardScrubStatusResponse
+}
+
+func (w *getShardScrubStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetShardScrubStatusResponse
+}
+
+func (w *getShardScrubStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetShardScrubStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetShardScrubStatusResponse)(nil))
+	}
+
+	w.GetShardScrubStatusResponse = r
+	return nil
+}
+
+type exportTreeResponseWrapper struct {
+	*ExportTreeResponse
+}
+
+func (w *exportTreeResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ExportTreeResponse
+}
+
+func (w *exportTreeResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ExportTreeResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ExportTreeResponse)(nil))
+	}
+
+	w.ExportTreeResponse = r
+	return nil
+}
+
+type importTreeResponseWrapper struct {
+	*ImportTreeResponse
+}
+
+func (w *importTreeResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ImportTreeResponse
+}
+
+func (w *importTreeResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ImportTreeResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ImportTreeResponse)(nil))
+	}
+
+	w.ImportTreeResponse = r
+	return nil
+}
@@ -8,7 +8,12 @@ import (
 const serviceName = "ircontrol.ControlService"
 
 const (
-	rpcHealthCheck = "HealthCheck"
+	rpcHealthCheck        = "HealthCheck"
+	rpcTickEpoch          = "TickEpoch"
+	rpcRemoveNode         = "RemoveNode"
+	rpcListNotaryRequests = "ListNotaryRequests"
+	rpcListProcessors     = "ListProcessors"
+	rpcSetProcessorStatus = "SetProcessorStatus"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -32,3 +37,113 @@ func HealthCheck(
 
 	return wResp.m, nil
 }
+
+// TickEpoch executes ControlService.TickEpoch RPC.
+func TickEpoch(
+	cli *client.Client,
+	req *TickEpochRequest,
+	opts ...client.CallOption,
+) (*TickEpochResponse, error) {
+	wResp := &tickEpochResponseWrapper{
+		m: new(TickEpochResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcTickEpoch), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// RemoveNode executes ControlService.RemoveNode RPC.
+func RemoveNode(
+	cli *client.Client,
+	req *RemoveNodeRequest,
+	opts ...client.CallOption,
+) (*RemoveNodeResponse, error) {
+	wResp := &removeNodeResponseWrapper{
+		m: new(RemoveNodeResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcRemoveNode), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// ListNotaryRequests executes ControlService.ListNotaryRequests RPC.
+func ListNotaryRequests(
+	cli *client.Client,
+	req *ListNotaryRequestsRequest,
+	opts ...client.CallOption,
+) (*ListNotaryRequestsResponse, error) {
+	wResp := &listNotaryRequestsResponseWrapper{
+		m: new(ListNotaryRequestsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListNotaryRequests), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// ListProcessors executes ControlService.ListProcessors RPC.
+func ListProcessors(
+	cli *client.Client,
+	req *ListProcessorsRequest,
+	opts ...client.CallOption,
+) (*ListProcessorsResponse, error) {
+	wResp := &listProcessorsResponseWrapper{
+		m: new(ListProcessorsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListProcessors), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// SetProcessorStatus executes ControlService.SetProcessorStatus RPC.
+func SetProcessorStatus(
+	cli *client.Client,
+	req *SetProcessorStatusRequest,
+	opts ...client.CallOption,
+) (*SetProcessorStatusResponse, error) {
+	wResp := &setProcessorStatusResponseWrapper{
+		m: new(SetProcessorStatusResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcSetProcessorStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
@@ -32,3 +32,140 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 
 	return resp, nil
 }
+
+// TickEpoch votes for a new epoch.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) TickEpoch(_ context.Context, req *control.TickEpochRequest) (*control.TickEpochResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	epoch, err := s.prm.netmapManager.TickEpoch()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.TickEpochResponse)
+
+	body := new(control.TickEpochResponse_Body)
+	resp.SetBody(body)
+
+	body.SetEpoch(epoch)
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// RemoveNode votes for the storage node removal from the network map.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) RemoveNode(_ context.Context, req *control.RemoveNodeRequest) (*control.RemoveNodeResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	key := req.GetBody().GetKey()
+	if len(key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing node key")
+	}
+
+	if err := s.prm.netmapManager.RemoveNode(key); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.RemoveNodeResponse)
+	resp.SetBody(new(control.RemoveNodeResponse_Body))
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ListNotaryRequests returns pending notary requests seen by the IR node.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ListNotaryRequests(_ context.Context, req *control.ListNotaryRequestsRequest) (*control.ListNotaryRequestsResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.ListNotaryRequestsResponse)
+
+	body := new(control.ListNotaryRequestsResponse_Body)
+	resp.SetBody(body)
+
+	body.SetRequests(s.prm.notaryRequests.NotaryRequests())
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ListProcessors returns event processors of the IR node.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ListProcessors(_ context.Context, req *control.ListProcessorsRequest) (*control.ListProcessorsResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.ListProcessorsResponse)
+
+	body := new(control.ListProcessorsResponse_Body)
+	resp.SetBody(body)
+
+	body.SetProcessors(s.prm.processors.Processors())
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// SetProcessorStatus enables or disables the event processor.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) SetProcessorStatus(_ context.Context, req *control.SetProcessorStatusRequest) (*control.SetProcessorStatusResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+
+	if err := s.prm.processors.SetProcessorEnabled(body.GetName(), body.GetEnabled()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.SetProcessorStatusResponse)
+	resp.SetBody(new(control.SetProcessorStatusResponse_Body))
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -11,3 +11,31 @@ type HealthChecker interface {
 	// control.HealthStatus_HEALTH_STATUS_UNDEFINED should be returned.
 	HealthStatus() control.HealthStatus
 }
+
+// NetmapManager is component interface for voting
+// for the network map changes.
+type NetmapManager interface {
+	// Must vote for the next epoch and return its number.
+	TickEpoch() (uint64, error)
+
+	// Must vote for the storage node with the given
+	// public key to be removed from the network map.
+	RemoveNode(key []byte) error
+}
+
+// NotaryRequestSource is component interface for listing
+// notary requests seen by the IR node.
+type NotaryRequestSource interface {
+	// Must return notary requests which are still valid.
+	NotaryRequests() []*control.NotaryRequestInfo
+}
+
+// ProcessorManager is component interface for inspecting
+// and switching event processors of the IR node.
+type ProcessorManager interface {
+	// Must return all event processors of the IR node.
+	Processors() []*control.ProcessorInfo
+
+	// Must enable or disable the processor with the given name.
+	SetProcessorEnabled(name string, enabled bool) error
+}
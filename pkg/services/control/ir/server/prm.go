@@ -10,6 +10,12 @@ type Prm struct {
 	key keys.PrivateKey
 
 	healthChecker HealthChecker
+
+	netmapManager NetmapManager
+
+	notaryRequests NotaryRequestSource
+
+	processors ProcessorManager
 }
 
 // SetPrivateKey sets private key to sign responses.
@@ -22,3 +28,21 @@ func (x *Prm) SetPrivateKey(key keys.PrivateKey) {
 func (x *Prm) SetHealthChecker(hc HealthChecker) {
 	x.healthChecker = hc
 }
+
+// SetNetmapManager sets NetmapManager to vote
+// for the network map changes.
+func (x *Prm) SetNetmapManager(nm NetmapManager) {
+	x.netmapManager = nm
+}
+
+// SetNotaryRequestSource sets NotaryRequestSource
+// to list notary requests.
+func (x *Prm) SetNotaryRequestSource(src NotaryRequestSource) {
+	x.notaryRequests = src
+}
+
+// SetProcessorManager sets ProcessorManager to inspect
+// and switch event processors.
+func (x *Prm) SetProcessorManager(pm ProcessorManager) {
+	x.processors = pm
+}
@@ -24,7 +24,10 @@ func panicOnPrmValue(n string, v interface{}) {
 //
 // Panics if:
 //   - parameterized private key is nil;
-//   - parameterized HealthChecker is nil.
+//   - parameterized HealthChecker is nil;
+//   - parameterized NetmapManager is nil;
+//   - parameterized NotaryRequestSource is nil;
+//   - parameterized ProcessorManager is nil.
 //
 // Forms white list from all keys specified via
 // WithAllowedKeys option and a public key of
@@ -34,6 +37,12 @@ func New(prm Prm, opts ...Option) *Server {
 	switch {
 	case prm.healthChecker == nil:
 		panicOnPrmValue("health checker", prm.healthChecker)
+	case prm.netmapManager == nil:
+		panicOnPrmValue("netmap manager", prm.netmapManager)
+	case prm.notaryRequests == nil:
+		panicOnPrmValue("notary request source", prm.notaryRequests)
+	case prm.processors == nil:
+		panicOnPrmValue("processor manager", prm.processors)
 	}
 
 	// compute optional parameters
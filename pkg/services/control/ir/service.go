@@ -20,3 +20,115 @@ func (x *HealthCheckResponse) SetBody(v *HealthCheckResponse_Body) {
 		x.Body = v
 	}
 }
+
+// SetBody sets tick epoch request body.
+func (x *TickEpochRequest) SetBody(v *TickEpochRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets tick epoch response body.
+func (x *TickEpochResponse) SetBody(v *TickEpochResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetEpoch sets number of the epoch voted for.
+func (x *TickEpochResponse_Body) SetEpoch(v uint64) {
+	if x != nil {
+		x.Epoch = v
+	}
+}
+
+// SetBody sets remove node request body.
+func (x *RemoveNodeRequest) SetBody(v *RemoveNodeRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets remove node response body.
+func (x *RemoveNodeResponse) SetBody(v *RemoveNodeResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetKey sets public key of the storage node to remove.
+func (x *RemoveNodeRequest_Body) SetKey(v []byte) {
+	if x != nil {
+		x.Key = v
+	}
+}
+
+// SetBody sets list notary requests request body.
+func (x *ListNotaryRequestsRequest) SetBody(v *ListNotaryRequestsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets list notary requests response body.
+func (x *ListNotaryRequestsResponse) SetBody(v *ListNotaryRequestsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetRequests sets pending notary requests.
+func (x *ListNotaryRequestsResponse_Body) SetRequests(v []*NotaryRequestInfo) {
+	if x != nil {
+		x.Requests = v
+	}
+}
+
+// SetBody sets list processors request body.
+func (x *ListProcessorsRequest) SetBody(v *ListProcessorsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets list processors response body.
+func (x *ListProcessorsResponse) SetBody(v *ListProcessorsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetProcessors sets event processors of the IR node.
+func (x *ListProcessorsResponse_Body) SetProcessors(v []*ProcessorInfo) {
+	if x != nil {
+		x.Processors = v
+	}
+}
+
+// SetBody sets set processor status request body.
+func (x *SetProcessorStatusRequest) SetBody(v *SetProcessorStatusRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets set processor status response body.
+func (x *SetProcessorStatusResponse) SetBody(v *SetProcessorStatusResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetName sets name of the processor.
+func (x *SetProcessorStatusRequest_Body) SetName(v string) {
+	if x != nil {
+		x.Name = v
+	}
+}
+
+// SetEnabled sets flag to enable or disable the processor.
+func (x *SetProcessorStatusRequest_Body) SetEnabled(v bool) {
+	if x != nil {
+		x.Enabled = v
+	}
+}
@@ -42,3 +42,58 @@ func generateHealthCheckResponseBody() *control.HealthCheckResponse_Body {
 func equalHealthCheckResponseBodies(b1, b2 *control.HealthCheckResponse_Body) bool {
 	return b1.GetHealthStatus() == b2.GetHealthStatus()
 }
+
+func TestListNotaryRequestsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListNotaryRequestsResponseBody(),
+		new(control.ListNotaryRequestsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func generateListNotaryRequestsResponseBody() *control.ListNotaryRequestsResponse_Body {
+	r1 := new(control.NotaryRequestInfo)
+	r1.SetHash([]byte{1, 2, 3})
+	r1.SetContract([]byte{4, 5, 6})
+	r1.SetMethod("addPeer")
+	r1.SetValidUntilBlock(100)
+	r1.SetSigned(true)
+
+	r2 := new(control.NotaryRequestInfo)
+	r2.SetHash([]byte{7, 8, 9})
+	r2.SetMethod("put")
+
+	body := new(control.ListNotaryRequestsResponse_Body)
+	body.SetRequests([]*control.NotaryRequestInfo{r1, r2})
+
+	return body
+}
+
+func TestListProcessorsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListProcessorsResponseBody(),
+		new(control.ListProcessorsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func generateListProcessorsResponseBody() *control.ListProcessorsResponse_Body {
+	p1 := new(control.ProcessorInfo)
+	p1.SetName("audit")
+	p1.SetEnabled(true)
+	p1.SetSwitchable(true)
+	p1.SetPoolCapacity(10)
+	p1.SetPoolRunning(3)
+
+	p2 := new(control.ProcessorInfo)
+	p2.SetName("netmap")
+
+	body := new(control.ListProcessorsResponse_Body)
+	body.SetProcessors([]*control.ProcessorInfo{p1, p2})
+
+	return body
+}
@@ -13,3 +13,80 @@ func (x *Signature) SetSign(v []byte) {
 		x.Sign = v
 	}
 }
+
+// SetHash sets hash of the main transaction.
+func (x *NotaryRequestInfo) SetHash(v []byte) {
+	if x != nil {
+		x.Hash = v
+	}
+}
+
+// SetContract sets script hash of the invoked contract.
+func (x *NotaryRequestInfo) SetContract(v []byte) {
+	if x != nil {
+		x.Contract = v
+	}
+}
+
+// SetMethod sets name of the invoked contract method.
+func (x *NotaryRequestInfo) SetMethod(v string) {
+	if x != nil {
+		x.Method = v
+	}
+}
+
+// SetValidUntilBlock sets height of the last block the main transaction is valid in.
+func (x *NotaryRequestInfo) SetValidUntilBlock(v uint32) {
+	if x != nil {
+		x.ValidUntilBlock = v
+	}
+}
+
+// SetSigned sets flag indicating that the request has been signed by the IR node.
+func (x *NotaryRequestInfo) SetSigned(v bool) {
+	if x != nil {
+		x.Signed = v
+	}
+}
+
+// SetName sets name of the processor.
+func (x *ProcessorInfo) SetName(v string) {
+	if x != nil {
+		x.Name = v
+	}
+}
+
+// SetEnabled sets flag indicating that the processor handles events.
+func (x *ProcessorInfo) SetEnabled(v bool) {
+	if x != nil {
+		x.Enabled = v
+	}
+}
+
+// SetSwitchable sets flag indicating that the processor can be disabled.
+func (x *ProcessorInfo) SetSwitchable(v bool) {
+	if x != nil {
+		x.Switchable = v
+	}
+}
+
+// SetPoolCapacity sets capacity of the processor worker pool.
+func (x *ProcessorInfo) SetPoolCapacity(v uint32) {
+	if x != nil {
+		x.PoolCapacity = v
+	}
+}
+
+// SetPoolRunning sets number of the events being handled by the worker pool.
+func (x *ProcessorInfo) SetPoolRunning(v uint32) {
+	if x != nil {
+		x.PoolRunning = v
+	}
+}
+
+// SetPoolQueue sets number of the events waiting for a free worker of the pool.
+func (x *ProcessorInfo) SetPoolQueue(v uint32) {
+	if x != nil {
+		x.PoolQueue = v
+	}
+}
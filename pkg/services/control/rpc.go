@@ -8,16 +8,32 @@ import (
 const serviceName = "control.ControlService"
 
 const (
-	rpcHealthCheck     = "HealthCheck"
-	rpcSetNetmapStatus = "SetNetmapStatus"
-	rpcDropObjects     = "DropObjects"
-	rpcListShards      = "ListShards"
-	rpcSetShardMode    = "SetShardMode"
-	rpcDumpShard       = "DumpShard"
-	rpcRestoreShard    = "RestoreShard"
-	rpcSynchronizeTree = "SynchronizeTree"
-	rpcEvacuateShard   = "EvacuateShard"
-	rpcFlushCache      = "FlushCache"
+	rpcHealthCheck       = "HealthCheck"
+	rpcSetNetmapStatus   = "SetNetmapStatus"
+	rpcDropObjects       = "DropObjects"
+	rpcListShards        = "ListShards"
+	rpcSetShardMode      = "SetShardMode"
+	rpcDumpShard         = "DumpShard"
+	rpcRestoreShard      = "RestoreShard"
+	rpcSynchronizeTree   = "SynchronizeTree"
+	rpcEvacuateShard     = "EvacuateShard"
+	rpcFlushCache        = "FlushCache"
+	rpcGetWriteCacheInfo = "GetWriteCacheInfo"
+
+	rpcStartShardRebalance     = "StartShardRebalance"
+	rpcPauseShardRebalance     = "PauseShardRebalance"
+	rpcResumeShardRebalance    = "ResumeShardRebalance"
+	rpcGetShardRebalanceStatus = "GetShardRebalanceStatus"
+
+	rpcStartShardEvacuation     = "StartShardEvacuation"
+	rpcGetShardEvacuationStatus = "GetShardEvacuationStatus"
+	rpcStopShardEvacuation      = "StopShardEvacuation"
+
+	rpcStartShardScrub     = "StartShardScrub"
+	rpcGetShardScrubStatus = "GetShardScrubStatus"
+
+	rpcExportTree = "ExportTree"
+	rpcImportTree = "ImportTree"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -191,3 +207,159 @@ func FlushCache(cli *client.Client, req *FlushCacheRequest, opts ...client.CallO
 
 	return wResp.FlushCacheResponse, nil
 }
+
+// GetWriteCacheInfo executes ControlService.GetWriteCacheInfo RPC.
+func GetWriteCacheInfo(cli *client.Client, req *GetWriteCacheInfoRequest, opts ...client.CallOption) (*GetWriteCacheInfoResponse, error) {
+	wResp := &getWriteCacheInfoResponseWrapper{new(GetWriteCacheInfoResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetWriteCacheInfo), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetWriteCacheInfoResponse, nil
+}
+
+// StartShardRebalance executes ControlService.StartShardRebalance RPC.
+func StartShardRebalance(cli *client.Client, req *StartShardRebalanceRequest, opts ...client.CallOption) (*StartShardRebalanceResponse, error) {
+	wResp := &startShardRebalanceResponseWrapper{new(StartShardRebalanceResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartShardRebalance), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartShardRebalanceResponse, nil
+}
+
+// PauseShardRebalance executes ControlService.PauseShardRebalance RPC.
+func PauseShardRebalance(cli *client.Client, req *PauseShardRebalanceRequest, opts ...client.CallOption) (*PauseShardRebalanceResponse, error) {
+	wResp := &pauseShardRebalanceResponseWrapper{new(PauseShardRebalanceResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcPauseShardRebalance), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.PauseShardRebalanceResponse, nil
+}
+
+// ResumeShardRebalance executes ControlService.ResumeShardRebalance RPC.
+func ResumeShardRebalance(cli *client.Client, req *ResumeShardRebalanceRequest, opts ...client.CallOption) (*ResumeShardRebalanceResponse, error) {
+	wResp := &resumeShardRebalanceResponseWrapper{new(ResumeShardRebalanceResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcResumeShardRebalance), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ResumeShardRebalanceResponse, nil
+}
+
+// GetShardRebalanceStatus executes ControlService.GetShardRebalanceStatus RPC.
+func GetShardRebalanceStatus(cli *client.Client, req *GetShardRebalanceStatusRequest, opts ...client.CallOption) (*GetShardRebalanceStatusResponse, error) {
+	wResp := &getShardRebalanceStatusResponseWrapper{new(GetShardRebalanceStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetShardRebalanceStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetShardRebalanceStatusResponse, nil
+}
+
+// StartShardEvacuation executes ControlService.StartShardEvacuation RPC.
+func StartShardEvacuation(cli *client.Client, req *StartShardEvacuationRequest, opts ...client.CallOption) (*StartShardEvacuationResponse, error) {
+	wResp := &startShardEvacuationResponseWrapper{new(StartShardEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartShardEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartShardEvacuationResponse, nil
+}
+
+// GetShardEvacuationStatus executes ControlService.GetShardEvacuationStatus RPC.
+func GetShardEvacuationStatus(cli *client.Client, req *GetShardEvacuationStatusRequest, opts ...client.CallOption) (*GetShardEvacuationStatusResponse, error) {
+	wResp := &getShardEvacuationStatusResponseWrapper{new(GetShardEvacuationStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetShardEvacuationStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetShardEvacuationStatusResponse, nil
+}
+
+// StopShardEvacuation executes ControlService.StopShardEvacuation RPC.
+func StopShardEvacuation(cli *client.Client, req *StopShardEvacuationRequest, opts ...client.CallOption) (*StopShardEvacuationResponse, error) {
+	wResp := &stopShardEvacuationResponseWrapper{new(StopShardEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStopShardEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StopShardEvacuationResponse, nil
+}
+
+// StartShardScrub executes ControlService.StartShardScrub RPC.
+func StartShardScrub(cli *client.Client, req *StartShardScrubRequest, opts ...client.CallOption) (*StartShardScrubResponse, error) {
+	wResp := &startShardScrubResponseWrapper{new(StartShardScrubResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartShardScrub), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartShardScrubResponse, nil
+}
+
+// GetShardScrubStatus executes ControlService.GetShardScrubStatus RPC.
+func GetShardScrubStatus(cli *client.Client, req *GetShardScrubStatusRequest, opts ...client.CallOption) (*GetShardScrubStatusResponse, error) {
+	wResp := &getShardScrubStatusResponseWrapper{new(GetShardScrubStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetShardScrubStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetShardScrubStatusResponse, nil
+}
+
+// ExportTree executes ControlService.ExportTree RPC.
+func ExportTree(cli *client.Client, req *ExportTreeRequest, opts ...client.CallOption) (*ExportTreeResponse, error) {
+	wResp := &exportTreeResponseWrapper{new(ExportTreeResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcExportTree), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ExportTreeResponse, nil
+}
+
+// ImportTree executes ControlService.ImportTree RPC.
+func ImportTree(cli *client.Client, req *ImportTreeRequest, opts ...client.CallOption) (*ImportTreeResponse, error) {
+	wResp := &importTreeResponseWrapper{new(ImportTreeResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcImportTree), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ImportTreeResponse, nil
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -19,7 +21,7 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequest) (*control.EvacuateShardResponse, error) {
+func (s *Server) EvacuateShard(ctx context.Context, req *control.EvacuateShardRequest) (*control.EvacuateShardResponse, error) {
 	err := s.isValidRequest(req)
 	if err != nil {
 		return nil, status.Error(codes.PermissionDenied, err.Error())
@@ -29,15 +31,17 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 	prm.WithShardIDList(s.getShardIDList(req.GetBody().GetShard_ID()))
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
 	prm.WithFaultHandler(s.replicate)
+	prm.WithTreeHandler(s.replicateTree)
 
-	res, err := s.s.Evacuate(prm)
+	res, err := s.s.Evacuate(ctx, prm)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	resp := &control.EvacuateShardResponse{
 		Body: &control.EvacuateShardResponse_Body{
-			Count: uint32(res.Count()),
+			Count:     uint32(res.Count()),
+			TreeCount: uint32(res.TreeCount()),
 		},
 	}
 
@@ -105,3 +109,10 @@ type replicatorResult struct {
 func (r *replicatorResult) SubmitSuccessfulReplication(_ netmap.NodeInfo) {
 	r.count++
 }
+
+func (s *Server) replicateTree(ctx context.Context, cnr cidSDK.ID, treeID string, forest pilorama.Forest) error {
+	if s.treeService == nil {
+		return errors.New("tree service is disabled")
+	}
+	return s.treeService.ReplicateTree(ctx, cnr, treeID, forest)
+}
@@ -0,0 +1,129 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) StartShardEvacuation(_ context.Context, req *control.StartShardEvacuationRequest) (*control.StartShardEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var prm engine.EvacuateShardPrm
+	prm.WithShardIDList(s.getShardIDList(req.GetBody().GetShard_ID()))
+	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	prm.WithFaultHandler(s.replicate)
+	prm.WithTreeHandler(s.replicateTree)
+
+	id, err := s.s.StartEvacuation(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StartShardEvacuationResponse{
+		Body: &control.StartShardEvacuationResponse_Body{
+			JobId: id,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetShardEvacuationStatus(_ context.Context, req *control.GetShardEvacuationStatusRequest) (*control.GetShardEvacuationStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	st, err := s.s.EvacuationStatus(req.GetBody().GetJobId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := &control.GetShardEvacuationStatusResponse_Body{
+		JobId:          st.ID,
+		Total:          st.Total,
+		Evacuated:      st.Evacuated,
+		Failed:         st.Failed,
+		EtaSeconds:     uint64(st.ETA.Seconds()),
+		TreesEvacuated: st.TreesEvacuated,
+	}
+
+	for _, sid := range st.ShardIDs {
+		id, err := base58.Decode(sid)
+		if err == nil {
+			body.Shard_ID = append(body.Shard_ID, id)
+		}
+	}
+
+	switch st.State {
+	case engine.EvacuationStateRunning:
+		body.State = control.ShardEvacuationState_EVACUATION_RUNNING
+	case engine.EvacuationStateCompleted:
+		body.State = control.ShardEvacuationState_EVACUATION_COMPLETED
+	case engine.EvacuationStateFailed:
+		body.State = control.ShardEvacuationState_EVACUATION_FAILED
+	case engine.EvacuationStateCancelled:
+		body.State = control.ShardEvacuationState_EVACUATION_CANCELLED
+	case engine.EvacuationStateInterrupted:
+		body.State = control.ShardEvacuationState_EVACUATION_INTERRUPTED
+	}
+
+	if !st.StartedAt.IsZero() {
+		body.StartedAt = st.StartedAt.Unix()
+	}
+	if !st.FinishedAt.IsZero() {
+		body.FinishedAt = st.FinishedAt.Unix()
+	}
+	if st.Error != nil {
+		body.Error = st.Error.Error()
+	}
+
+	resp := &control.GetShardEvacuationStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) StopShardEvacuation(_ context.Context, req *control.StopShardEvacuationRequest) (*control.StopShardEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.CancelEvacuation(req.GetBody().GetJobId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StopShardEvacuationResponse{Body: &control.StopShardEvacuationResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// ResumeEvacuation continues the shard evacuation interrupted by the previous node shutdown.
+func (s *Server) ResumeEvacuation() error {
+	var prm engine.EvacuateShardPrm
+	prm.WithFaultHandler(s.replicate)
+	prm.WithTreeHandler(s.replicateTree)
+
+	return s.s.ResumeEvacuation(prm)
+}
@@ -0,0 +1,114 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) StartShardRebalance(_ context.Context, req *control.StartShardRebalanceRequest) (*control.StartShardRebalanceResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.StartRebalance()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StartShardRebalanceResponse{Body: &control.StartShardRebalanceResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) PauseShardRebalance(_ context.Context, req *control.PauseShardRebalanceRequest) (*control.PauseShardRebalanceResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.PauseRebalance()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.PauseShardRebalanceResponse{Body: &control.PauseShardRebalanceResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) ResumeShardRebalance(_ context.Context, req *control.ResumeShardRebalanceRequest) (*control.ResumeShardRebalanceResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.ResumeRebalance()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.ResumeShardRebalanceResponse{Body: &control.ResumeShardRebalanceResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetShardRebalanceStatus(_ context.Context, req *control.GetShardRebalanceStatusRequest) (*control.GetShardRebalanceStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	st := s.s.RebalanceStatus()
+
+	body := &control.GetShardRebalanceStatusResponse_Body{
+		ShardsTotal: uint32(st.ShardsTotal),
+		ShardsDone:  uint32(st.ShardsDone),
+		Processed:   st.Processed,
+		Moved:       st.Moved,
+		Failed:      st.Failed,
+	}
+
+	switch st.State {
+	case engine.RebalanceStateRunning:
+		body.State = control.ShardRebalanceState_REBALANCE_RUNNING
+	case engine.RebalanceStatePaused:
+		body.State = control.ShardRebalanceState_REBALANCE_PAUSED
+	default:
+		body.State = control.ShardRebalanceState_REBALANCE_IDLE
+	}
+
+	if !st.StartedAt.IsZero() {
+		body.StartedAt = st.StartedAt.Unix()
+	}
+	if !st.FinishedAt.IsZero() {
+		body.FinishedAt = st.FinishedAt.Unix()
+	}
+	if st.Error != nil {
+		body.Error = st.Error.Error()
+	}
+
+	resp := &control.GetShardRebalanceStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
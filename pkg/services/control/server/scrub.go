@@ -0,0 +1,75 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) StartShardScrub(_ context.Context, req *control.StartShardScrubRequest) (*control.StartShardScrubResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.StartScrub(s.getShardIDList(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StartShardScrubResponse{Body: &control.StartShardScrubResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetShardScrubStatus(_ context.Context, req *control.GetShardScrubStatusRequest) (*control.GetShardScrubStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	list, err := s.s.ScrubStatus(s.getShardIDList(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	shards := make([]*control.ShardScrubStatus, 0, len(list))
+	for _, st := range list {
+		info := &control.ShardScrubStatus{
+			Shard_ID:   *st.ID,
+			Running:    st.Running,
+			Checked:    st.Checked,
+			Corrupted:  st.Corrupted,
+			ReadErrors: st.ReadErrors,
+		}
+		if !st.StartedAt.IsZero() {
+			info.StartedAt = st.StartedAt.Unix()
+		}
+		if !st.FinishedAt.IsZero() {
+			info.FinishedAt = st.FinishedAt.Unix()
+		}
+		if st.Error != nil {
+			info.Error = st.Error.Error()
+		}
+
+		shards = append(shards, info)
+	}
+
+	resp := &control.GetShardScrubStatusResponse{
+		Body: &control.GetShardScrubStatusResponse_Body{
+			Shards: shards,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -2,7 +2,9 @@ package control
 
 import (
 	"context"
+	"io"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"google.golang.org/grpc/codes"
@@ -12,6 +14,10 @@ import (
 // TreeService represents a tree service instance.
 type TreeService interface {
 	Synchronize(ctx context.Context, cnr cid.ID, treeID string) error
+	// ReplicateTree sends all operations of the tree stored in the forest to other container nodes.
+	ReplicateTree(ctx context.Context, cnr cid.ID, treeID string, forest pilorama.Forest) error
+	// ImportTree applies the exported tree from r to the forest notifying the tree watchers.
+	ImportTree(r io.Reader, forest pilorama.Forest) (pilorama.ExportInfo, error)
 }
 
 func (s *Server) SynchronizeTree(ctx context.Context, req *control.SynchronizeTreeRequest) (*control.SynchronizeTreeResponse, error) {
@@ -0,0 +1,108 @@
+package control
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ExportTree(_ context.Context, req *control.ExportTreeRequest) (*control.ExportTreeResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	b := req.GetBody()
+
+	var cnr cid.ID
+	if err := cnr.Decode(b.GetContainerId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	f, err := os.OpenFile(b.GetFilepath(), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	info, err := s.s.ExportTree(f, cnr, b.GetTreeId(), b.GetSnapshot())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.ExportTreeResponse{
+		Body: &control.ExportTreeResponse_Body{
+			Height:     info.Height,
+			Nodes:      uint64(info.Nodes),
+			Operations: uint64(info.Operations),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) ImportTree(_ context.Context, req *control.ImportTreeRequest) (*control.ImportTreeResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	b := req.GetBody()
+
+	var shardID *shard.ID
+	if len(b.GetShard_ID()) != 0 {
+		shardID = shard.NewIDFromBytes(b.GetShard_ID())
+	}
+
+	f, err := os.Open(b.GetFilepath())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer f.Close()
+
+	var info pilorama.ExportInfo
+	if s.treeService != nil {
+		var forest pilorama.Forest
+		forest, err = s.s.TreeImportTarget(shardID)
+		if err == nil {
+			info, err = s.treeService.ImportTree(f, forest)
+		}
+	} else {
+		info, err = s.s.ImportTree(f, shardID)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rawCID := make([]byte, sha256.Size)
+	info.CID.Encode(rawCID)
+
+	resp := &control.ImportTreeResponse{
+		Body: &control.ImportTreeResponse_Body{
+			ContainerId: rawCID,
+			TreeId:      info.TreeID,
+			Height:      info.Height,
+			Nodes:       uint64(info.Nodes),
+			Operations:  uint64(info.Operations),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetWriteCacheInfo(_ context.Context, req *control.GetWriteCacheInfoRequest) (*control.GetWriteCacheInfoResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var shardIDs []*shard.ID
+	if rawIDs := req.GetBody().GetShard_ID(); len(rawIDs) != 0 {
+		shardIDs = s.getShardIDList(rawIDs)
+	} else {
+		// skip shards without write-cache when all shards are requested
+		for _, sh := range s.s.DumpInfo().Shards {
+			if sh.WriteCacheInfo.Path != "" {
+				shardIDs = append(shardIDs, sh.ID)
+			}
+		}
+	}
+
+	infos := make([]*control.WriteCacheInfo, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		info, err := s.writeCacheInfo(shardID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		infos = append(infos, info)
+	}
+
+	resp := &control.GetWriteCacheInfoResponse{
+		Body: &control.GetWriteCacheInfoResponse_Body{
+			Shards: infos,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) writeCacheInfo(shardID *shard.ID) (*control.WriteCacheInfo, error) {
+	var prm engine.WriteCacheStatsPrm
+	prm.SetShardID(shardID)
+
+	res, err := s.s.WriteCacheStats(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	st := res.Stats()
+
+	return &control.WriteCacheInfo{
+		Shard_ID:       *shardID,
+		ObjectsDb:      st.ObjectsDB,
+		SizeDb:         st.SizeDB,
+		ObjectsFs:      st.ObjectsFS,
+		SizeFs:         st.SizeFS,
+		FlushedObjects: st.Flushed,
+		FlushedSize:    st.FlushedSize,
+		FlushErrors:    st.FlushErrors,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestHealthCheckResponse_Body_StableMarshal(t *testing.T) {
@@ -176,3 +177,140 @@ func TestSynchronizeTreeRequest_Body_StableMarshal(t *testing.T) {
 		},
 	)
 }
+
+func TestGetWriteCacheInfoResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetWriteCacheInfoResponse_Body{
+			Shards: []*control.WriteCacheInfo{
+				{
+					Shard_ID:       []byte{1, 2, 3},
+					ObjectsDb:      1,
+					SizeDb:         2,
+					ObjectsFs:      3,
+					SizeFs:         4,
+					FlushedObjects: 5,
+					FlushedSize:    6,
+					FlushErrors:    7,
+				},
+				{
+					Shard_ID: []byte{4, 5, 6},
+				},
+			},
+		},
+		new(control.GetWriteCacheInfoResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestGetShardRebalanceStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetShardRebalanceStatusResponse_Body{
+			State:       control.ShardRebalanceState_REBALANCE_PAUSED,
+			StartedAt:   1,
+			FinishedAt:  2,
+			ShardsTotal: 3,
+			ShardsDone:  4,
+			Processed:   5,
+			Moved:       6,
+			Failed:      7,
+			Error:       "error",
+		},
+		new(control.GetShardRebalanceStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestStartShardEvacuationRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.StartShardEvacuationRequest_Body{
+			Shard_ID:     [][]byte{{1, 2, 3}, {4, 5}},
+			IgnoreErrors: true,
+		},
+		new(control.StartShardEvacuationRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestGetShardEvacuationStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetShardEvacuationStatusResponse_Body{
+			JobId:          "job",
+			State:          control.ShardEvacuationState_EVACUATION_RUNNING,
+			Shard_ID:       [][]byte{{1, 2, 3}},
+			StartedAt:      1,
+			FinishedAt:     2,
+			Total:          3,
+			Evacuated:      4,
+			Failed:         5,
+			EtaSeconds:     6,
+			Error:          "error",
+			TreesEvacuated: 7,
+		},
+		new(control.GetShardEvacuationStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestGetShardScrubStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetShardScrubStatusResponse_Body{
+			Shards: []*control.ShardScrubStatus{
+				{
+					Shard_ID:   []byte{1, 2, 3},
+					Running:    true,
+					StartedAt:  1,
+					FinishedAt: 2,
+					Checked:    3,
+					Corrupted:  4,
+					Error:      "error",
+				},
+				{
+					Shard_ID: []byte{4, 5},
+				},
+			},
+		},
+		new(control.GetShardScrubStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestExportTreeRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.ExportTreeRequest_Body{
+			ContainerId: []byte{1, 2, 3},
+			TreeId:      "version",
+			Filepath:    "/tmp/tree",
+			Snapshot:    true,
+		},
+		new(control.ExportTreeRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestImportTreeResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.ImportTreeResponse_Body{
+			ContainerId: []byte{1, 2, 3},
+			TreeId:      "version",
+			Height:      1,
+			Nodes:       2,
+			Operations:  3,
+		},
+		new(control.ImportTreeResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
@@ -8,6 +8,7 @@ import (
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	refsV2 "github.com/TrueCloudLab/frostfs-api-go/v2/refs"
 	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	eaclSDK "github.com/TrueCloudLab/frostfs-sdk-go/eacl"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -109,7 +110,8 @@ func (h *cfg) readObjectHeaders(dst *headerSource) error {
 		switch req := m.req.(type) {
 		case
 			*objectV2.GetRequest,
-			*objectV2.HeadRequest:
+			*objectV2.HeadRequest,
+			*patchsvc.Request:
 			if h.obj == nil {
 				return errMissingOID
 			}
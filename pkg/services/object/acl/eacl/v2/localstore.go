@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"context"
 	"io"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
@@ -17,5 +18,5 @@ func (s *localStorage) Head(addr oid.Address) (*objectSDK.Object, error) {
 		return nil, io.ErrUnexpectedEOF
 	}
 
-	return engine.Head(s.ls, addr)
+	return engine.Head(context.Background(), s.ls, addr)
 }
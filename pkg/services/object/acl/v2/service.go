@@ -9,6 +9,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
@@ -31,6 +32,13 @@ type putStreamBasicChecker struct {
 	next   object.PutObjectStream
 }
 
+type patchStreamBasicChecker struct {
+	source *Service
+	next   object.PatchObjectStream
+
+	checked bool // set after the first request is checked
+}
+
 type getStreamBasicChecker struct {
 	checker ACLChecker
 
@@ -174,6 +182,15 @@ func (b Service) Put(ctx context.Context) (object.PutObjectStream, error) {
 	}, err
 }
 
+func (b Service) Patch(ctx context.Context) (object.PatchObjectStream, error) {
+	streamer, err := b.next.Patch(ctx)
+
+	return &patchStreamBasicChecker{
+		source: &b,
+		next:   streamer,
+	}, err
+}
+
 func (b Service) Head(
 	ctx context.Context,
 	request *objectV2.HeadRequest) (*objectV2.HeadResponse, error) {
@@ -537,6 +554,109 @@ func (p putStreamBasicChecker) CloseAndRecv() (*objectV2.PutResponse, error) {
 	return p.next.CloseAndRecv()
 }
 
+// Send checks the access on the first request of the stream: the request
+// signer must be allowed to GET the original object and to PUT the new object
+// into the container.
+func (p *patchStreamBasicChecker) Send(request *patchsvc.Request) error {
+	if !p.checked {
+		if err := p.check(request); err != nil {
+			return err
+		}
+
+		p.checked = true
+	}
+
+	return p.next.Send(request)
+}
+
+func (p *patchStreamBasicChecker) check(request *patchsvc.Request) error {
+	body := request.GetBody()
+	if body == nil {
+		return errEmptyBody
+	}
+
+	cnr, err := getContainerIDFromRequest(request)
+	if err != nil {
+		return err
+	}
+
+	obj := new(oid.ID)
+	if err := obj.Decode(body.GetObjectId()); err != nil {
+		return fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	sTok, err := originalSessionToken(request.GetMetaHeader())
+	if err != nil {
+		return err
+	}
+
+	if sTok != nil {
+		// the session relates to the new object which ID is unknown
+		err = assertSessionRelation(*sTok, cnr, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	bTok, err := originalBearerToken(request.GetMetaHeader())
+	if err != nil {
+		return err
+	}
+
+	vheader := patchVerificationHeader(request)
+
+	// the session token is issued for PUT, so the original object
+	// is read with the rights of the request signer
+	reqInfo, err := p.source.findRequestInfo(MetaWithToken{
+		vheader: vheader,
+		bearer:  bTok,
+		src:     request,
+	}, cnr, acl.OpObjectGet)
+	if err != nil {
+		return err
+	}
+
+	reqInfo.obj = obj
+
+	if !p.source.checker.CheckBasicACL(reqInfo) {
+		return basicACLErr(reqInfo)
+	} else if err := p.source.checker.CheckEACL(request, reqInfo); err != nil {
+		return eACLErr(reqInfo, err)
+	}
+
+	req := MetaWithToken{
+		vheader: vheader,
+		token:   sTok,
+		bearer:  bTok,
+		src:     request,
+	}
+
+	// the new object is owned by the session issuer
+	idOwner, _, err := req.RequestOwner()
+	if err != nil {
+		return err
+	}
+
+	reqInfo, err = p.source.findRequestInfo(req, cnr, acl.OpObjectPut)
+	if err != nil {
+		return err
+	}
+
+	reqInfo.obj = obj
+
+	if !p.source.checker.CheckBasicACL(reqInfo) || !p.source.checker.StickyBitCheck(reqInfo, *idOwner) {
+		return basicACLErr(reqInfo)
+	} else if err := p.source.checker.CheckEACL(request, reqInfo); err != nil {
+		return eACLErr(reqInfo, err)
+	}
+
+	return nil
+}
+
+func (p *patchStreamBasicChecker) CloseAndRecv() (*patchsvc.Response, error) {
+	return p.next.CloseAndRecv()
+}
+
 func (g *getStreamBasicChecker) Send(resp *objectV2.GetResponse) error {
 	if _, ok := resp.GetBody().GetObjectPart().(*objectV2.GetObjectPartInit); ok {
 		if err := g.checker.CheckEACL(resp, g.info); err != nil {
@@ -9,6 +9,7 @@ import (
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	refsV2 "github.com/TrueCloudLab/frostfs-api-go/v2/refs"
 	sessionV2 "github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	"github.com/TrueCloudLab/frostfs-sdk-go/bearer"
 	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
@@ -44,6 +45,13 @@ func getContainerIDFromRequest(req interface{}) (cid.ID, error) {
 		idV2 = v.GetBody().GetAddress().GetContainerID()
 	case *objectV2.GetRangeHashRequest:
 		idV2 = v.GetBody().GetAddress().GetContainerID()
+	case *patchsvc.Request:
+		raw := v.GetBody().GetContainerId()
+		if len(raw) == 0 {
+			return cid.ID{}, errMissingContainerID
+		}
+
+		return id, id.Decode(raw)
 	default:
 		return cid.ID{}, errors.New("unknown request type")
 	}
@@ -55,6 +63,21 @@ func getContainerIDFromRequest(req interface{}) (cid.ID, error) {
 	return id, id.ReadFromV2(*idV2)
 }
 
+// patchVerificationHeader returns the verification header with the signature
+// of the Patch request: the request is signed in the Patch service format
+// which has no verification header.
+func patchVerificationHeader(req *patchsvc.Request) *sessionV2.RequestVerificationHeader {
+	var sig refsV2.Signature
+	sig.SetKey(req.GetSignature().GetKey())
+	sig.SetSign(req.GetSignature().GetSign())
+	sig.SetScheme(refsV2.ECDSA_SHA512)
+
+	res := new(sessionV2.RequestVerificationHeader)
+	res.SetBodySignature(&sig)
+
+	return res
+}
+
 // originalBearerToken goes down to original request meta header and fetches
 // bearer token from there.
 func originalBearerToken(header *sessionV2.RequestMetaHeader) (*bearer.Token, error) {
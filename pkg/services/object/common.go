@@ -89,3 +89,11 @@ func (x *Common) GetRangeHash(ctx context.Context, req *objectV2.GetRangeHashReq
 
 	return x.nextHandler.GetRangeHash(ctx, req)
 }
+
+func (x *Common) Patch(ctx context.Context) (PatchObjectStream, error) {
+	if x.state.IsMaintenance() {
+		return nil, errMaintenance
+	}
+
+	return x.nextHandler.Patch(ctx)
+}
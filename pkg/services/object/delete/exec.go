@@ -6,6 +6,7 @@ import (
 
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -211,6 +212,59 @@ func (exec *execCtx) supplementBySplitID() bool {
 	}
 }
 
+// collectChunks supplements tombstone members with the erasure-coded chunks
+// of the already collected members if erasure coding is enabled in the container.
+func (exec *execCtx) collectChunks() bool {
+	if !exec.erasureCoded() {
+		return true
+	}
+
+	exec.log.Debug("collecting erasure-coded chunks...")
+
+	members := exec.tombstone.Members()
+
+	for i := range members {
+		chunks, err := exec.svc.searcher.ecChunks(exec, members[i])
+		if err != nil {
+			exec.status = statusUndefined
+			exec.err = err
+
+			exec.log.Debug("could not search for erasure-coded chunks",
+				zap.Stringer("id", members[i]),
+				zap.String("error", err.Error()),
+			)
+
+			return false
+		}
+
+		exec.addMembers(chunks)
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+
+	return true
+}
+
+func (exec *execCtx) erasureCoded() bool {
+	if exec.svc.containerSource == nil {
+		return false
+	}
+
+	cnr, err := exec.svc.containerSource.Get(exec.containerID())
+	if err != nil {
+		exec.log.Debug("could not get container to check erasure coding",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	_, ok, err := erasurecode.SchemeFromContainer(cnr.Value)
+
+	return ok && err == nil
+}
+
 func (exec *execCtx) addMembers(incoming []oid.ID) {
 	members := exec.tombstone.Members()
 
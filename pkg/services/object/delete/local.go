@@ -56,6 +56,11 @@ func (exec *execCtx) formTombstone() (ok bool) {
 
 	exec.log.Debug("members successfully collected")
 
+	ok = exec.collectChunks()
+	if !ok {
+		return
+	}
+
 	ok = exec.initTombstoneObject()
 	if !ok {
 		return
@@ -1,6 +1,7 @@
 package deletesvc
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
@@ -49,6 +50,8 @@ type cfg struct {
 
 	searcher interface {
 		splitMembers(*execCtx) ([]oid.ID, error)
+
+		ecChunks(*execCtx, oid.ID) ([]oid.ID, error)
 	}
 
 	placer interface {
@@ -58,6 +61,8 @@ type cfg struct {
 	netInfo NetworkInfo
 
 	keyStorage *util.KeyStorage
+
+	containerSource container.Source
 }
 
 func defaultCfg() *cfg {
@@ -122,3 +127,11 @@ func WithKeyStorage(ks *util.KeyStorage) Option {
 		c.keyStorage = ks
 	}
 }
+
+// WithContainerSource returns option to set container source
+// used to check whether objects of the container are erasure-coded.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.containerSource = src
+	}
+}
@@ -6,6 +6,7 @@ import (
 	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
@@ -101,6 +102,23 @@ func (w *searchSvcWrapper) splitMembers(exec *execCtx) ([]oid.ID, error) {
 	return wr.ids, nil
 }
 
+func (w *searchSvcWrapper) ecChunks(exec *execCtx, parent oid.ID) ([]oid.ID, error) {
+	wr := new(simpleIDWriter)
+
+	p := searchsvc.Prm{}
+	p.SetWriter(wr)
+	p.SetCommonParameters(exec.commonParameters())
+	p.WithContainerID(exec.containerID())
+	p.WithSearchFilters(erasurecode.SearchFilters(parent, -1))
+
+	err := (*searchsvc.Service)(w).Search(exec.context(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.ids, nil
+}
+
 func (s *simpleIDWriter) WriteIDs(ids []oid.ID) error {
 	s.ids = append(s.ids, ids...)
 
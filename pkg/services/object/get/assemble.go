@@ -52,12 +52,10 @@ func (exec *execCtx) assemble() {
 				exec.overtakePayloadDirectly(children, nil, true)
 			}
 		} else {
-			// TODO: #1155 choose one-by-one restoring algorithm according to size
-			//  * if size > MAX => go right-to-left with HEAD and back with GET
-			//  * else go right-to-left with GET and compose in single object before writing
-
-			if ok := exec.overtakePayloadInReverse(children[len(children)-1]); ok {
-				// payload of all children except the last are written, write last payload
+			// linking object lists all the children, so the range is
+			// located by their sizes without walking the split chain
+			if ok := exec.overtakePayloadByChildren(children); ok {
+				// payload of the linking object is empty, it finalizes the stream
 				exec.writeObjectPayload(exec.collectedObject)
 			}
 		}
@@ -168,6 +166,64 @@ func (exec *execCtx) overtakePayloadDirectly(children []oid.ID, rngs []objectSDK
 	exec.err = nil
 }
 
+func (exec *execCtx) overtakePayloadByChildren(children []oid.ID) bool {
+	chain, rngs, ok := exec.buildChainByChildren(children)
+	if !ok {
+		return false
+	}
+
+	exec.overtakePayloadDirectly(chain, rngs, false)
+
+	return exec.status == statusOK
+}
+
+// buildChainByChildren returns the children containing the requested range
+// and the ranges of their payload. Headers of all the children are requested
+// since the object can not be assembled if any of them is missing.
+func (exec *execCtx) buildChainByChildren(children []oid.ID) ([]oid.ID, []objectSDK.Range, bool) {
+	var (
+		chain   = make([]oid.ID, 0)
+		rngs    = make([]objectSDK.Range, 0)
+		seekRng = exec.ctxRange()
+		from    = seekRng.GetOffset()
+		to      = from + seekRng.GetLength()
+
+		off uint64
+	)
+
+	for i := range children {
+		head, ok := exec.headChild(children[i])
+		if !ok {
+			return nil, nil, false
+		}
+
+		sz := head.PayloadSize()
+
+		if off+sz > from && off < to {
+			start := uint64(0)
+			if from > off {
+				start = from - off
+			}
+
+			end := sz
+			if to < off+sz {
+				end = to - off
+			}
+
+			index := len(rngs)
+			rngs = append(rngs, objectSDK.Range{})
+			rngs[index].SetOffset(start)
+			rngs[index].SetLength(end - start)
+
+			chain = append(chain, children[i])
+		}
+
+		off += sz
+	}
+
+	return chain, rngs, true
+}
+
 func (exec *execCtx) overtakePayloadInReverse(prev oid.ID) bool {
 	chain, rngs, ok := exec.buildChainInReverse(prev)
 	if !ok {
@@ -0,0 +1,214 @@
+package getsvc
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+// ecScheme returns erasure coding scheme of the requested object's container.
+// Returns false if erasure coding is disabled or its status is unknown.
+func (exec *execCtx) ecScheme() (erasurecode.Scheme, bool) {
+	if exec.svc.containerSource == nil {
+		return erasurecode.Scheme{}, false
+	}
+
+	cnr, err := exec.svc.containerSource.Get(exec.containerID())
+	if err != nil {
+		exec.log.Debug("could not get container to check erasure coding",
+			zap.String("error", err.Error()),
+		)
+
+		return erasurecode.Scheme{}, false
+	}
+
+	scheme, ok, err := erasurecode.SchemeFromContainer(cnr.Value)
+	if err != nil {
+		exec.log.Debug("invalid container erasure coding scheme",
+			zap.String("error", err.Error()),
+		)
+
+		return erasurecode.Scheme{}, false
+	}
+
+	return scheme, ok
+}
+
+// assembleEC restores the requested object from its erasure-coded chunks
+// stored on the container nodes. Chunks of the object are placed according
+// to the object placement, so nodes are polled in the placement order until
+// enough chunks are collected.
+func (exec *execCtx) assembleEC() {
+	if exec.isLocal() || !exec.svc.assembly {
+		return
+	}
+
+	scheme, ok := exec.ecScheme()
+	if !ok {
+		return
+	}
+
+	exec.log.Debug("trying to restore erasure-coded object...",
+		zap.Stringer("scheme", scheme),
+	)
+
+	if !exec.initEpoch() {
+		return
+	}
+
+	traverser, ok := exec.generateTraverser(exec.address())
+	if !ok {
+		return
+	}
+
+	need := scheme.DataCount()
+	if exec.headOnly() {
+		// any chunk contains the original header
+		need = 1
+	}
+
+	var (
+		found  int
+		chunks = make([]*objectSDK.Object, scheme.Total())
+		fs     = erasurecode.SearchFilters(exec.address().Object(), -1)
+	)
+
+	for found < need {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			break
+		}
+
+		for i := 0; i < len(addrs) && found < need; i++ {
+			select {
+			case <-exec.context().Done():
+				exec.log.Debug("interrupt erasure-coded object restoring by context",
+					zap.String("error", exec.context().Err().Error()),
+				)
+
+				return
+			default:
+			}
+
+			var info client.NodeInfo
+
+			client.NodeInfoFromNetmapElement(&info, addrs[i])
+
+			found += exec.collectChunks(info, fs, chunks)
+		}
+	}
+
+	if found < need {
+		exec.log.Debug("not enough erasure-coded chunks to restore object",
+			zap.Int("found", found),
+			zap.Int("required", need),
+		)
+
+		return
+	}
+
+	if exec.headOnly() {
+		for i := range chunks {
+			if chunks[i] == nil {
+				continue
+			}
+
+			hdr, err := erasurecode.ParentHeader(chunks[i])
+			if err != nil {
+				exec.log.Debug("could not read original header from chunk",
+					zap.String("error", err.Error()),
+				)
+
+				return
+			}
+
+			exec.collectedObject = hdr
+
+			break
+		}
+	} else {
+		obj, err := erasurecode.Decode(scheme, chunks)
+		if err != nil {
+			exec.log.Debug("could not restore erasure-coded object",
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		if rng := exec.ctxRange(); rng != nil {
+			payload := obj.Payload()
+			from := rng.GetOffset()
+			to := from + rng.GetLength()
+
+			if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+				exec.status = statusOutOfRange
+				exec.err = new(apistatus.ObjectOutOfRange)
+
+				return
+			}
+
+			obj = payloadOnlyObject(payload[from:to])
+		}
+
+		exec.collectedObject = obj
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+	exec.writeCollectedObject()
+}
+
+// collectChunks reads chunks of the requested object stored on the node and
+// returns number of the new chunks.
+func (exec *execCtx) collectChunks(info client.NodeInfo, fs objectSDK.SearchFilters, chunks []*objectSDK.Object) int {
+	c, err := exec.svc.clientCache.get(info)
+	if err != nil {
+		exec.log.Debug("could not construct remote node client",
+			zap.String("error", err.Error()),
+		)
+
+		return 0
+	}
+
+	ids, err := c.searchChunks(exec, info, fs)
+	if err != nil {
+		exec.log.Debug("could not search for erasure-coded chunks",
+			zap.String("error", err.Error()),
+		)
+
+		return 0
+	}
+
+	var n int
+
+	for i := range ids {
+		chunk, err := c.getChunk(exec, info, ids[i])
+		if err != nil {
+			exec.log.Debug("could not read erasure-coded chunk",
+				zap.Stringer("chunk", ids[i]),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		ecInfo, err := erasurecode.InfoFromObject(chunk)
+		if err != nil || ecInfo.Index() >= len(chunks) {
+			exec.log.Debug("invalid erasure-coded chunk",
+				zap.Stringer("chunk", ids[i]),
+			)
+
+			continue
+		}
+
+		if chunks[ecInfo.Index()] == nil {
+			chunks[ecInfo.Index()] = chunk
+			n++
+		}
+	}
+
+	return n
+}
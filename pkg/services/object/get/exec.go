@@ -127,6 +127,13 @@ func (exec execCtx) key() (*ecdsa.PrivateKey, error) {
 	return exec.svc.keyStore.GetKey(sessionInfo)
 }
 
+// nodeKey returns the private key of the local node. It is used
+// for the node-internal requests that must not depend on the
+// permissions of the original request sender.
+func (exec execCtx) nodeKey() (*ecdsa.PrivateKey, error) {
+	return exec.svc.keyStore.GetKey(nil)
+}
+
 func (exec *execCtx) canAssemble() bool {
 	return exec.svc.assembly && !exec.isRaw() && !exec.headOnly()
 }
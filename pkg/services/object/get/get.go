@@ -3,18 +3,27 @@ package getsvc
 import (
 	"context"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // Get serves a request to get an object by address, and returns Streamer instance.
-func (s *Service) Get(ctx context.Context, prm Prm) error {
+func (s *Service) Get(ctx context.Context, prm Prm) (err error) {
+	ctx, span := startSpan(ctx, "getService.Get", prm.commonPrm)
+	defer func() { tracing.Finish(span, err) }()
+
 	return s.get(ctx, prm.commonPrm).err
 }
 
 // GetRange serves a request to get an object by address, and returns Streamer instance.
-func (s *Service) GetRange(ctx context.Context, prm RangePrm) error {
+func (s *Service) GetRange(ctx context.Context, prm RangePrm) (err error) {
+	ctx, span := startSpan(ctx, "getService.GetRange", prm.commonPrm)
+	defer func() { tracing.Finish(span, err) }()
+
 	return s.getRange(ctx, prm)
 }
 
@@ -22,7 +31,10 @@ func (s *Service) getRange(ctx context.Context, prm RangePrm, opts ...execOption
 	return s.get(ctx, prm.commonPrm, append(opts, withPayloadRange(prm.rng))...).err
 }
 
-func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHashRes, error) {
+func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (_ *RangeHashRes, err error) {
+	ctx, span := startSpan(ctx, "getService.GetRangeHash", prm.commonPrm)
+	defer func() { tracing.Finish(span, err) }()
+
 	hashes := make([][]byte, 0, len(prm.rngs))
 
 	for _, rng := range prm.rngs {
@@ -58,10 +70,21 @@ func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHas
 //
 // Returns ErrNotFound if the header was not received for the call.
 // Returns SplitInfoError if object is virtual and raw flag is set.
-func (s *Service) Head(ctx context.Context, prm HeadPrm) error {
+func (s *Service) Head(ctx context.Context, prm HeadPrm) (err error) {
+	ctx, span := startSpan(ctx, "getService.Head", prm.commonPrm)
+	defer func() { tracing.Finish(span, err) }()
+
 	return s.get(ctx, prm.commonPrm, headOnly()).err
 }
 
+func startSpan(ctx context.Context, name string, prm commonPrm) (context.Context, trace.Span) {
+	return tracing.StartSpanFromContext(ctx, name, trace.WithAttributes(
+		attribute.String("address", prm.addr.EncodeToString()),
+		attribute.Bool("raw", prm.raw),
+		attribute.Bool("local", prm.common.LocalOnly()),
+	))
+}
+
 func (s *Service) get(ctx context.Context, prm commonPrm, opts ...execOption) statusError {
 	exec := &execCtx{
 		svc: s,
@@ -111,6 +134,11 @@ func (exec *execCtx) analyzeStatus(execCnr bool) {
 
 		if execCnr {
 			exec.executeOnContainer()
+
+			if exec.status == statusUndefined {
+				exec.assembleEC()
+			}
+
 			exec.analyzeStatus(false)
 		}
 	}
@@ -9,10 +9,12 @@ import (
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	containercore "github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	netmapcore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/network"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
@@ -23,6 +25,7 @@ import (
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/stretchr/testify/require"
 )
 
@@ -132,6 +135,39 @@ func (c *testClient) getObject(exec *execCtx, _ client.NodeInfo) (*objectSDK.Obj
 	return cutToRange(v.obj, exec.ctxRange()), nil
 }
 
+func (c *testClient) searchChunks(exec *execCtx, _ client.NodeInfo, _ objectSDK.SearchFilters) ([]oid.ID, error) {
+	var res []oid.ID
+
+	for _, v := range c.results {
+		if v.obj == nil {
+			continue
+		}
+
+		info, err := erasurecode.InfoFromObject(v.obj)
+		if err == nil && info.Parent().Equals(exec.address().Object()) {
+			id, _ := v.obj.ID()
+			res = append(res, id)
+		}
+	}
+
+	return res, nil
+}
+
+func (c *testClient) getChunk(exec *execCtx, _ client.NodeInfo, id oid.ID) (*objectSDK.Object, error) {
+	var addr oid.Address
+	addr.SetContainer(exec.containerID())
+	addr.SetObject(id)
+
+	v, ok := c.results[addr.EncodeToString()]
+	if !ok {
+		var errNotFound apistatus.ObjectNotFound
+
+		return nil, errNotFound
+	}
+
+	return v.obj, v.err
+}
+
 func (c *testClient) addResult(addr oid.Address, obj *objectSDK.Object, err error) {
 	c.results[addr.EncodeToString()] = struct {
 		obj *objectSDK.Object
@@ -901,6 +937,118 @@ func TestGetRemoteSmall(t *testing.T) {
 				err = svc.GetRange(ctx, rngPrm)
 				require.NoError(t, err)
 				require.Equal(t, payload[off:off+ln], w.Object().Payload())
+				for _, rng := range [][2]uint64{
+					{0, payloadSz},
+					{0, 10},
+					{10, 10},
+					{9, 2},
+					{15, 1},
+				} {
+					w = NewSimpleObjectWriter()
+
+					rngPrm = newRngPrm(false, w, rng[0], rng[1])
+					rngPrm.WithAddress(addr)
+
+					err = svc.GetRange(ctx, rngPrm)
+					require.NoError(t, err)
+					require.Equal(t, payload[rng[0]:rng[0]+rng[1]], w.Object().Payload())
+				}
+			})
+
+			t.Run("children out of split chain", func(t *testing.T) {
+				addr := oidtest.Address()
+				addr.SetContainer(idCnr)
+				addr.SetObject(oidtest.ID())
+
+				srcObj := generateObject(addr, nil, nil)
+
+				ns, as := testNodeMatrix(t, []int{2})
+
+				splitInfo := objectSDK.NewSplitInfo()
+				splitInfo.SetLink(oidtest.ID())
+
+				// children do not refer to each other, so they can be
+				// located by the linking object only
+				var (
+					children []*objectSDK.Object
+					childIDs []oid.ID
+					payload  []byte
+				)
+
+				for i := 0; i < 3; i++ {
+					objs, ids, data := generateChain(1, idCnr)
+					children = append(children, objs...)
+					childIDs = append(childIDs, ids...)
+					payload = append(payload, data...)
+				}
+
+				srcObj.SetPayload(payload)
+				srcObj.SetPayloadSize(uint64(len(payload)))
+
+				var linkAddr oid.Address
+				linkAddr.SetContainer(idCnr)
+				idLink, _ := splitInfo.Link()
+				linkAddr.SetObject(idLink)
+
+				linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+				linkingObj.SetParentID(addr.Object())
+				linkingObj.SetParent(srcObj)
+
+				c1 := newTestClient()
+				c1.addResult(addr, nil, errors.New("any error"))
+				c1.addResult(linkAddr, nil, errors.New("any error"))
+
+				c2 := newTestClient()
+				c2.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+				c2.addResult(linkAddr, linkingObj, nil)
+
+				builder := &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString():     ns,
+						linkAddr.EncodeToString(): ns,
+					},
+				}
+
+				for i := range children {
+					c1.addResult(object.AddressOf(children[i]), nil, errors.New("any error"))
+					c2.addResult(object.AddressOf(children[i]), children[i], nil)
+					builder.vectors[object.AddressOf(children[i]).EncodeToString()] = ns
+				}
+
+				svc := newSvc(builder, &testClientCache{
+					clients: map[string]*testClient{
+						as[0][0]: c1,
+						as[0][1]: c2,
+					},
+				})
+
+				testHeadVirtual(svc, addr, splitInfo)
+
+				for _, rng := range [][2]uint64{
+					{0, 30},
+					{5, 20},
+					{12, 3},
+					{20, 10},
+				} {
+					w := NewSimpleObjectWriter()
+
+					rngPrm := newRngPrm(false, w, rng[0], rng[1])
+					rngPrm.WithAddress(addr)
+
+					err := svc.GetRange(ctx, rngPrm)
+					require.NoError(t, err)
+					require.Equal(t, payload[rng[0]:rng[0]+rng[1]], w.Object().Payload())
+				}
+
+				t.Run("missing child", func(t *testing.T) {
+					c2.addResult(object.AddressOf(children[2]), nil, apistatus.ObjectNotFound{})
+
+					rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 0, 1)
+					rngPrm.WithAddress(addr)
+
+					err := svc.GetRange(ctx, rngPrm)
+					require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+				})
 			})
 		})
 
@@ -1244,3 +1392,155 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, obj.CutPayload(), w.Object())
 }
+
+type testContainerSource struct {
+	cnr container.Container
+}
+
+func (s testContainerSource) Get(cid.ID) (*containercore.Container, error) {
+	return &containercore.Container{Value: s.cnr}, nil
+}
+
+func TestGetErasureCoded(t *testing.T) {
+	ctx := context.Background()
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+	cnr.SetAttribute(erasurecode.ContainerAttribute, "2.1")
+
+	var idCnr cid.ID
+	container.CalculateID(&idCnr, cnr)
+
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	payload := make([]byte, 1001)
+	rand.Read(payload)
+
+	parent := objectSDK.New()
+	parent.SetContainerID(idCnr)
+	parent.SetPayload(payload)
+	parent.SetPayloadSize(uint64(len(payload)))
+	objectSDK.CalculateAndSetPayloadChecksum(parent)
+	require.NoError(t, objectSDK.SetIDWithSignature(pk.PrivateKey, parent))
+
+	scheme, err := erasurecode.NewScheme(2, 1)
+	require.NoError(t, err)
+
+	chunks, err := erasurecode.Encode(scheme, parent, erasurecode.ChunkPrm{Key: &pk.PrivateKey})
+	require.NoError(t, err)
+
+	addr := object.AddressOf(parent)
+	ns, as := testNodeMatrix(t, []int{3})
+
+	const curEpoch = 13
+
+	newSvc := func(clients []*testClient) *Service {
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = newTestStorage()
+		svc.assembly = true
+		svc.traverserGenerator = &testTraverserGenerator{
+			c: cnr,
+			b: map[uint64]placement.Builder{
+				curEpoch: &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString(): ns,
+					},
+				},
+			},
+		}
+		svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+		svc.containerSource = testContainerSource{cnr: cnr}
+
+		cache := &testClientCache{clients: make(map[string]*testClient)}
+		for i := range clients {
+			cache.clients[as[0][i]] = clients[i]
+		}
+
+		svc.clientCache = cache
+
+		return svc
+	}
+
+	newClients := func(missing int) []*testClient {
+		res := make([]*testClient, len(chunks))
+		for i := range chunks {
+			res[i] = newTestClient()
+			if i != missing {
+				res[i].addResult(object.AddressOf(chunks[i]), chunks[i], nil)
+			}
+		}
+
+		return res
+	}
+
+	for missing := -1; missing < len(chunks); missing++ {
+		svc := newSvc(newClients(missing))
+
+		w := NewSimpleObjectWriter()
+
+		p := Prm{}
+		p.SetObjectWriter(w)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+		p.WithAddress(addr)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, payload, w.Object().Payload())
+
+		id, _ := w.Object().ID()
+		require.Equal(t, addr.Object(), id)
+	}
+
+	t.Run("head", func(t *testing.T) {
+		svc := newSvc(newClients(0))
+
+		w := NewSimpleObjectWriter()
+
+		p := HeadPrm{}
+		p.SetHeaderWriter(w)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+		p.WithAddress(addr)
+
+		require.NoError(t, svc.Head(ctx, p))
+		require.Equal(t, parent.CutPayload(), w.Object())
+	})
+
+	t.Run("range", func(t *testing.T) {
+		svc := newSvc(newClients(1))
+
+		w := NewSimpleObjectWriter()
+
+		p := RangePrm{}
+		p.SetChunkWriter(w)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+		p.WithAddress(addr)
+
+		r := objectSDK.NewRange()
+		r.SetOffset(100)
+		r.SetLength(500)
+		p.SetRange(r)
+
+		require.NoError(t, svc.GetRange(ctx, p))
+		require.Equal(t, payload[100:600], w.Object().Payload())
+
+		r.SetLength(1000)
+
+		var errOutOfRange *apistatus.ObjectOutOfRange
+		require.ErrorAs(t, svc.GetRange(ctx, p), &errOutOfRange)
+	})
+
+	t.Run("not enough chunks", func(t *testing.T) {
+		clients := newClients(0)
+		clients[1] = newTestClient()
+
+		svc := newSvc(clients)
+
+		p := Prm{}
+		p.SetObjectWriter(NewSimpleObjectWriter())
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+		p.WithAddress(addr)
+
+		require.ErrorAs(t, svc.Get(ctx, p), new(apistatus.ObjectNotFound))
+	})
+}
@@ -2,6 +2,7 @@ package getsvc
 
 import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
@@ -23,6 +24,13 @@ type Option func(*cfg)
 
 type getClient interface {
 	getObject(*execCtx, client.NodeInfo) (*object.Object, error)
+
+	// searchChunks must return IDs of the erasure-coded chunks stored on the node.
+	searchChunks(*execCtx, client.NodeInfo, object.SearchFilters) ([]oid.ID, error)
+
+	// getChunk must return the chunk stored on the node. Only header
+	// is required for HEAD requests.
+	getChunk(*execCtx, client.NodeInfo, oid.ID) (*object.Object, error)
 }
 
 type cfg struct {
@@ -47,6 +55,8 @@ type cfg struct {
 	}
 
 	keyStore *util.KeyStorage
+
+	containerSource container.Source
 }
 
 func defaultCfg() *cfg {
@@ -130,3 +140,11 @@ func WithKeyStorage(store *util.KeyStorage) Option {
 		c.keyStore = store
 	}
 }
+
+// WithContainerSource returns option to set container source
+// used to restore erasure-coded objects.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.containerSource = src
+	}
+}
@@ -12,8 +12,17 @@ import (
 	internalclient "github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal/client"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
+// ecChunkTTL is a TTL of the requests for the erasure-coded chunks:
+// chunks are looked up on the particular nodes only.
+//
+// Chunk requests are node-internal: they are signed with the node key
+// and carry neither session nor bearer tokens, so reading an
+// erasure-coded object requires GET rights only.
+const ecChunkTTL = 1
+
 type SimpleObjectWriter struct {
 	obj *object.Object
 
@@ -195,13 +204,84 @@ func (c *clientWrapper) get(exec *execCtx, key *ecdsa.PrivateKey) (*object.Objec
 	return res.Object(), nil
 }
 
+func (c *clientWrapper) searchChunks(exec *execCtx, _ coreclient.NodeInfo, fs object.SearchFilters) ([]oid.ID, error) {
+	key, err := exec.nodeKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.SearchObjectsPrm
+
+	prm.SetContext(exec.context())
+	prm.SetClient(c.client)
+	prm.SetTTL(ecChunkTTL)
+	prm.SetNetmapEpoch(exec.curProcEpoch)
+	prm.SetPrivateKey(key)
+	prm.SetContainerID(exec.containerID())
+	prm.SetFilters(fs)
+
+	res, err := internalclient.SearchObjects(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.IDList(), nil
+}
+
+func (c *clientWrapper) getChunk(exec *execCtx, _ coreclient.NodeInfo, id oid.ID) (*object.Object, error) {
+	key, err := exec.nodeKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var addr oid.Address
+	addr.SetContainer(exec.containerID())
+	addr.SetObject(id)
+
+	if exec.headOnly() {
+		var prm internalclient.HeadObjectPrm
+
+		prm.SetContext(exec.context())
+		prm.SetClient(c.client)
+		prm.SetTTL(ecChunkTTL)
+		prm.SetNetmapEpoch(exec.curProcEpoch)
+		prm.SetAddress(addr)
+		prm.SetPrivateKey(key)
+		prm.SetRawFlag()
+
+		res, err := internalclient.HeadObject(prm)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Header(), nil
+	}
+
+	var prm internalclient.GetObjectPrm
+
+	prm.SetContext(exec.context())
+	prm.SetClient(c.client)
+	prm.SetTTL(ecChunkTTL)
+	prm.SetNetmapEpoch(exec.curProcEpoch)
+	prm.SetAddress(addr)
+	prm.SetPrivateKey(key)
+	prm.SetRawFlag()
+
+	res, err := internal.GetObject(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Object(), nil
+}
+
 func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	if exec.headOnly() {
 		var headPrm engine.HeadPrm
 		headPrm.WithAddress(exec.address())
 		headPrm.WithRaw(exec.isRaw())
 
-		r, err := e.engine.Head(headPrm)
+		r, err := e.engine.Head(exec.context(), headPrm)
 		if err != nil {
 			return nil, err
 		}
@@ -212,7 +292,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		getRange.WithAddress(exec.address())
 		getRange.WithPayloadRange(rng)
 
-		r, err := e.engine.GetRange(getRange)
+		r, err := e.engine.GetRange(exec.context(), getRange)
 		if err != nil {
 			return nil, err
 		}
@@ -222,7 +302,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		var getPrm engine.GetPrm
 		getPrm.WithAddress(exec.address())
 
-		r, err := e.engine.Get(getPrm)
+		r, err := e.engine.Get(exec.context(), getPrm)
 		if err != nil {
 			return nil, err
 		}
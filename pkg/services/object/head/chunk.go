@@ -0,0 +1,102 @@
+package headsvc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+
+	clientcore "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	netmapCore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	internalclient "github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// RemoteChunkReader represents utility for reading
+// erasure-coded chunks from a remote host.
+type RemoteChunkReader struct {
+	keyStorage *util.KeyStorage
+
+	clientCache ClientConstructor
+}
+
+// NewRemoteChunkReader creates, initializes and returns new RemoteChunkReader instance.
+func NewRemoteChunkReader(keyStorage *util.KeyStorage, cache ClientConstructor) *RemoteChunkReader {
+	return &RemoteChunkReader{
+		keyStorage:  keyStorage,
+		clientCache: cache,
+	}
+}
+
+// Search returns identifiers of the chunks with the given index of the parent
+// object stored on the remote node. Negative index means any chunk.
+func (r *RemoteChunkReader) Search(ctx context.Context, node netmap.NodeInfo, parent oid.Address, index int) ([]oid.ID, error) {
+	c, key, err := r.client(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.SearchObjectsPrm
+
+	prm.SetContext(ctx)
+	prm.SetClient(c)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(remoteOpTTL)
+	prm.SetContainerID(parent.Container())
+	prm.SetFilters(erasurecode.SearchFilters(parent.Object(), index))
+
+	res, err := internalclient.SearchObjects(prm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not search chunks: %w", r, err)
+	}
+
+	return res.IDList(), nil
+}
+
+// Get reads the chunk object from the remote node.
+func (r *RemoteChunkReader) Get(ctx context.Context, node netmap.NodeInfo, addr oid.Address) (*object.Object, error) {
+	c, key, err := r.client(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.GetObjectPrm
+
+	prm.SetContext(ctx)
+	prm.SetClient(c)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(remoteOpTTL)
+	prm.SetAddress(addr)
+	prm.SetRawFlag()
+
+	res, err := internalclient.GetObject(prm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not get chunk: %w", r, err)
+	}
+
+	return res.Object(), nil
+}
+
+func (r *RemoteChunkReader) client(node netmap.NodeInfo) (clientcore.Client, *ecdsa.PrivateKey, error) {
+	key, err := r.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("(%T) could not receive private key: %w", r, err)
+	}
+
+	var info clientcore.NodeInfo
+
+	err = clientcore.NodeInfoFromRawNetmapElement(&info, netmapCore.Node(node))
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse client node info: %w", err)
+	}
+
+	c, err := r.clientCache.Get(info)
+	if err != nil {
+		return nil, nil, fmt.Errorf("(%T) could not create SDK client %s: %w", r, info.AddressGroup(), err)
+	}
+
+	return c, key, nil
+}
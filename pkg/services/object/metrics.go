@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/util"
 )
 
@@ -26,6 +27,12 @@ type (
 		start   time.Time
 	}
 
+	patchStreamMetric struct {
+		stream  PatchObjectStream
+		metrics MetricRegister
+		start   time.Time
+	}
+
 	MetricRegister interface {
 		IncGetReqCounter(success bool)
 		IncPutReqCounter(success bool)
@@ -34,6 +41,7 @@ type (
 		IncDeleteReqCounter(success bool)
 		IncRangeReqCounter(success bool)
 		IncRangeHashReqCounter(success bool)
+		IncPatchReqCounter(success bool)
 
 		AddGetReqDuration(time.Duration)
 		AddPutReqDuration(time.Duration)
@@ -42,9 +50,11 @@ type (
 		AddDeleteReqDuration(time.Duration)
 		AddRangeReqDuration(time.Duration)
 		AddRangeHashReqDuration(time.Duration)
+		AddPatchReqDuration(time.Duration)
 
 		AddPutPayload(int)
 		AddGetPayload(int)
+		AddPatchPayload(int)
 	}
 )
 
@@ -140,6 +150,21 @@ func (m MetricCollector) GetRangeHash(ctx context.Context, request *object.GetRa
 	return res, err
 }
 
+func (m MetricCollector) Patch(ctx context.Context) (PatchObjectStream, error) {
+	t := time.Now()
+
+	stream, err := m.next.Patch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &patchStreamMetric{
+		stream:  stream,
+		metrics: m.metrics,
+		start:   t,
+	}, nil
+}
+
 func (s getStreamMetric) Send(resp *object.GetResponse) error {
 	chunk, ok := resp.GetBody().GetObjectPart().(*object.GetObjectPartChunk)
 	if ok {
@@ -166,3 +191,20 @@ func (s putStreamMetric) CloseAndRecv() (*object.PutResponse, error) {
 
 	return res, err
 }
+
+func (s patchStreamMetric) Send(req *patchsvc.Request) error {
+	for _, p := range req.GetBody().GetPatches() {
+		s.metrics.AddPatchPayload(len(p.GetChunk()))
+	}
+
+	return s.stream.Send(req)
+}
+
+func (s patchStreamMetric) CloseAndRecv() (*patchsvc.Response, error) {
+	res, err := s.stream.CloseAndRecv()
+
+	s.metrics.IncPatchReqCounter(err == nil)
+	s.metrics.AddPatchReqDuration(time.Since(s.start))
+
+	return res, err
+}
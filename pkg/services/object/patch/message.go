@@ -0,0 +1,120 @@
+package patchsvc
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Request is the Patch request passed through the Object service pipeline.
+// Unlike the gRPC message, it carries the decoded meta header.
+type Request struct {
+	m *PatchRequest
+
+	meta *session.RequestMetaHeader
+}
+
+// Response is the Patch response passed through the Object service pipeline.
+type Response struct {
+	m *PatchResponse
+
+	meta *session.ResponseMetaHeader
+}
+
+// FromGRPCMessage fills the request from the gRPC message.
+func (r *Request) FromGRPCMessage(m *PatchRequest) error {
+	meta := new(session.RequestMetaHeader)
+	if raw := m.GetMetaHeader(); len(raw) != 0 {
+		if err := meta.Unmarshal(raw); err != nil {
+			return fmt.Errorf("invalid meta header: %w", err)
+		}
+	}
+
+	r.m = m
+	r.meta = meta
+
+	return nil
+}
+
+// ToGRPCMessage returns the gRPC message of the request.
+func (r *Request) ToGRPCMessage() *PatchRequest {
+	return r.m
+}
+
+// GetBody returns the request body.
+func (r *Request) GetBody() *PatchRequest_Body {
+	return r.m.GetBody()
+}
+
+// GetMetaHeader returns the request meta header.
+func (r *Request) GetMetaHeader() *session.RequestMetaHeader {
+	return r.meta
+}
+
+// GetSignature returns the signature of the request body and meta header.
+func (r *Request) GetSignature() *Signature {
+	return r.m.GetSignature()
+}
+
+// Verify checks the request signature.
+func (r *Request) Verify() error {
+	return verifyMessage(r.m)
+}
+
+// NewResponse returns the response with the identifier of the new object.
+func NewResponse(id oid.ID) *Response {
+	rawID := make([]byte, 32)
+	id.Encode(rawID)
+
+	return &Response{
+		m: &PatchResponse{
+			Body: &PatchResponse_Body{
+				ObjectId: rawID,
+			},
+		},
+	}
+}
+
+// FromGRPCMessage fills the response from the gRPC message.
+func (r *Response) FromGRPCMessage(m *PatchResponse) error {
+	var meta *session.ResponseMetaHeader
+	if raw := m.GetMetaHeader(); len(raw) != 0 {
+		meta = new(session.ResponseMetaHeader)
+		if err := meta.Unmarshal(raw); err != nil {
+			return fmt.Errorf("invalid meta header: %w", err)
+		}
+	}
+
+	r.m = m
+	r.meta = meta
+
+	return nil
+}
+
+// ToGRPCMessage returns the gRPC message of the response.
+func (r *Response) ToGRPCMessage() *PatchResponse {
+	return r.m
+}
+
+// GetBody returns the response body.
+func (r *Response) GetBody() *PatchResponse_Body {
+	return r.m.GetBody()
+}
+
+// GetMetaHeader returns the response meta header.
+func (r *Response) GetMetaHeader() *session.ResponseMetaHeader {
+	return r.meta
+}
+
+// SetMetaHeader sets the response meta header.
+func (r *Response) SetMetaHeader(meta *session.ResponseMetaHeader) {
+	r.meta = meta
+	r.m.MetaHeader = meta.StableMarshal(nil)
+}
+
+// Sign signs the response body and meta header with the key.
+func (r *Response) Sign(key *ecdsa.PrivateKey) error {
+	return SignMessage(r.m, key)
+}
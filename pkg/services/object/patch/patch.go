@@ -0,0 +1,227 @@
+package patchsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+var (
+	errNotInit         = errors.New("patch stream is not initialized")
+	errInitialized     = errors.New("patch stream is already initialized")
+	errEmptyPatch      = errors.New("patch contains neither payload patches nor attributes")
+	errUnsortedPatches = errors.New("payload patches must be sorted and must not overlap")
+	errRangeOutOfBound = errors.New("payload patch range is out of bounds")
+	errChunkTooBig     = errors.New("payload patch chunk exceeds the limit")
+	errPatchTooBig     = errors.New("payload patches exceed the limit")
+)
+
+// Streamer applies the payload patches to the original object
+// while they are received.
+type Streamer struct {
+	*Service
+
+	ctx context.Context
+
+	// common contains the parameters to read the original object.
+	common *util.CommonPrm
+
+	addr oid.Address
+
+	// size is the payload size of the original object.
+	size uint64
+
+	w objectWriter
+
+	// pos is the offset of the original payload written
+	// to the new object or replaced so far.
+	pos uint64
+
+	// patchSize is the size of the received patch chunks.
+	patchSize uint64
+
+	// patched is set if the attributes or the payload are patched.
+	patched bool
+}
+
+// Patch opens the stream of the new object created from the original one
+// with the payload ranges and the attributes replaced.
+func (s *Service) Patch(ctx context.Context) (*Streamer, error) {
+	return &Streamer{
+		Service: s,
+		ctx:     ctx,
+	}, nil
+}
+
+// Init starts the new object from the header of the original one. The new
+// object is owned by the session token issuer if the token is set.
+func (p *Streamer) Init(prm *Prm) error {
+	if p.w != nil {
+		return errInitialized
+	}
+
+	readPrm := readParameters(prm.common)
+
+	hdr, err := p.reader.head(p.ctx, readPrm, prm.addr)
+	if err != nil {
+		return fmt.Errorf("could not receive the original object header: %w", err)
+	}
+
+	owner := hdr.OwnerID()
+	if tok := prm.common.SessionToken(); tok != nil {
+		issuer := tok.Issuer()
+		owner = &issuer
+	}
+
+	newHdr := newHeader(hdr, prm.attrs)
+	newHdr.SetOwnerID(owner)
+
+	w, err := p.placer.init(p.ctx, prm.common, newHdr)
+	if err != nil {
+		return fmt.Errorf("could not initialize the new object: %w", err)
+	}
+
+	p.common = readPrm
+	p.addr = prm.addr
+	p.size = hdr.PayloadSize()
+	p.w = w
+	p.patched = len(prm.attrs) != 0
+
+	return nil
+}
+
+// Apply copies the original payload up to the patch range and writes the
+// patch chunk. Patches must be applied in the range offset order and must
+// not overlap.
+func (p *Streamer) Apply(patch PayloadPatch) error {
+	if p.w == nil {
+		return errNotInit
+	}
+
+	if ln := uint64(len(patch.Chunk)); ln > p.maxChunkSize {
+		return fmt.Errorf("%w: %d > %d", errChunkTooBig, ln, p.maxChunkSize)
+	}
+
+	p.patchSize += uint64(len(patch.Chunk))
+	if p.patchSize > p.maxPatchSize {
+		return fmt.Errorf("%w: %d > %d", errPatchTooBig, p.patchSize, p.maxPatchSize)
+	}
+
+	off, ln := patch.Range.GetOffset(), patch.Range.GetLength()
+	if off < p.pos {
+		return errUnsortedPatches
+	}
+
+	end := off + ln
+	if end < off || end > p.size {
+		return fmt.Errorf("%w: offset %d, length %d, payload size %d", errRangeOutOfBound, off, ln, p.size)
+	}
+
+	if err := p.copy(p.pos, off); err != nil {
+		return err
+	}
+
+	if len(patch.Chunk) != 0 {
+		if err := p.w.WriteChunk(patch.Chunk); err != nil {
+			return fmt.Errorf("could not write the payload patch: %w", err)
+		}
+	}
+
+	p.pos = end
+	p.patched = true
+
+	return nil
+}
+
+// Close copies the rest of the original payload and stores the new object.
+func (p *Streamer) Close() (*Res, error) {
+	if p.w == nil {
+		return nil, errNotInit
+	}
+
+	if !p.patched {
+		return nil, errEmptyPatch
+	}
+
+	if err := p.copy(p.pos, p.size); err != nil {
+		return nil, err
+	}
+
+	id, err := p.w.close()
+	if err != nil {
+		return nil, fmt.Errorf("could not close the new object: %w", err)
+	}
+
+	p.log.Debug("object patched",
+		zap.Stringer("original", p.addr),
+		zap.Stringer("new", id),
+		zap.Uint64("patch size", p.patchSize))
+
+	return &Res{id: id}, nil
+}
+
+// copy writes the [from, to) range of the original payload to the new object.
+func (p *Streamer) copy(from, to uint64) error {
+	if from >= to {
+		return nil
+	}
+
+	var rng objectSDK.Range
+	rng.SetOffset(from)
+	rng.SetLength(to - from)
+
+	err := p.reader.payloadRange(p.ctx, p.common, p.addr, &rng, p.w)
+	if err != nil {
+		return fmt.Errorf("could not copy the original payload: %w", err)
+	}
+
+	return nil
+}
+
+// readParameters returns the parameters to read the original object: it is
+// read on behalf of the node since the session token is issued for PUT and
+// the access to the original object is checked before the operation.
+func readParameters(common *util.CommonPrm) *util.CommonPrm {
+	if common == nil {
+		return nil
+	}
+
+	res := *common
+	res.ForgetTokens()
+
+	return &res
+}
+
+// newHeader returns the header of the new object with the attributes
+// of the original object replaced or extended.
+func newHeader(orig *objectSDK.Object, attrs []objectSDK.Attribute) *objectSDK.Object {
+	hdr := objectSDK.New()
+
+	cnr, _ := orig.ContainerID()
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(orig.OwnerID())
+	hdr.SetType(orig.Type())
+
+	res := make([]objectSDK.Attribute, 0, len(orig.Attributes())+len(attrs))
+	index := make(map[string]int, len(orig.Attributes()))
+	for _, a := range orig.Attributes() {
+		index[a.Key()] = len(res)
+		res = append(res, a)
+	}
+	for _, a := range attrs {
+		if i, ok := index[a.Key()]; ok {
+			res[i] = a
+			continue
+		}
+		index[a.Key()] = len(res)
+		res = append(res, a)
+	}
+	hdr.SetAttributes(res...)
+
+	return hdr
+}
@@ -0,0 +1,262 @@
+package patchsvc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testStorage struct {
+	objs map[oid.Address]*objectSDK.Object
+
+	// ranges contains the read ranges of the objects.
+	ranges map[oid.Address][]objectSDK.Range
+}
+
+type testWriter struct {
+	hdr *objectSDK.Object
+	id  oid.ID
+
+	payload []byte
+
+	closed bool
+}
+
+type testPlacer struct {
+	w *testWriter
+}
+
+func (s *testStorage) head(_ context.Context, _ *util.CommonPrm, addr oid.Address) (*objectSDK.Object, error) {
+	obj, ok := s.objs[addr]
+	if !ok {
+		return nil, apistatus.ObjectNotFound{}
+	}
+	return obj.CutPayload(), nil
+}
+
+func (s *testStorage) payloadRange(_ context.Context, _ *util.CommonPrm, addr oid.Address, rng *objectSDK.Range, w getsvc.ChunkWriter) error {
+	obj, ok := s.objs[addr]
+	if !ok {
+		return apistatus.ObjectNotFound{}
+	}
+	s.ranges[addr] = append(s.ranges[addr], *rng)
+	return w.WriteChunk(obj.Payload()[rng.GetOffset() : rng.GetOffset()+rng.GetLength()])
+}
+
+func (p *testPlacer) init(_ context.Context, _ *util.CommonPrm, hdr *objectSDK.Object) (objectWriter, error) {
+	p.w = &testWriter{hdr: hdr, id: oidtest.ID()}
+	return p.w, nil
+}
+
+func (w *testWriter) WriteChunk(p []byte) error {
+	w.payload = append(w.payload, p...)
+	return nil
+}
+
+func (w *testWriter) close() (oid.ID, error) {
+	w.closed = true
+	return w.id, nil
+}
+
+func newTestStorage() *testStorage {
+	return &testStorage{
+		objs:   make(map[oid.Address]*objectSDK.Object),
+		ranges: make(map[oid.Address][]objectSDK.Range),
+	}
+}
+
+func newTestObject(addr oid.Address, payload []byte, attrs ...objectSDK.Attribute) *objectSDK.Object {
+	obj := objectSDK.New()
+	obj.SetID(addr.Object())
+	obj.SetContainerID(addr.Container())
+	obj.SetType(objectSDK.TypeRegular)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetAttributes(attrs...)
+	return obj
+}
+
+func newPatch(off, ln uint64, chunk string) PayloadPatch {
+	var p PayloadPatch
+	p.Range.SetOffset(off)
+	p.Range.SetLength(ln)
+	p.Chunk = []byte(chunk)
+	return p
+}
+
+func newAttribute(key, value string) objectSDK.Attribute {
+	var a objectSDK.Attribute
+	a.SetKey(key)
+	a.SetValue(value)
+	return a
+}
+
+func newTestStreamer(t *testing.T, s *testStorage, addr oid.Address, attrs []objectSDK.Attribute, opts ...Option) (*Streamer, *testPlacer) {
+	p := new(testPlacer)
+	svc := New(opts...)
+	svc.reader = s
+	svc.placer = p
+
+	stream, err := svc.Patch(context.Background())
+	require.NoError(t, err)
+
+	var prm Prm
+	prm.WithAddress(addr)
+	prm.SetAttributes(attrs)
+
+	require.NoError(t, stream.Init(&prm))
+	return stream, p
+}
+
+func testPatch(t *testing.T, s *testStorage, addr oid.Address, patches []PayloadPatch, attrs []objectSDK.Attribute) (*testWriter, error) {
+	stream, p := newTestStreamer(t, s, addr, attrs)
+
+	for i := range patches {
+		if err := stream.Apply(patches[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := stream.Close()
+	if err != nil {
+		return nil, err
+	}
+	require.Equal(t, p.w.id, res.ObjectID())
+	require.True(t, p.w.closed)
+	return p.w, nil
+}
+
+func TestPatch(t *testing.T) {
+	t.Run("payload and attributes", func(t *testing.T) {
+		s := newTestStorage()
+		addr := oidtest.Address()
+		s.objs[addr] = newTestObject(addr, []byte("0123456789"),
+			newAttribute("FileName", "a.txt"), newAttribute("Type", "text"))
+
+		w, err := testPatch(t, s, addr,
+			[]PayloadPatch{newPatch(2, 3, "ab"), newPatch(7, 0, "XYZ"), newPatch(10, 0, "end")},
+			[]objectSDK.Attribute{newAttribute("FileName", "b.txt"), newAttribute("Version", "2")})
+		require.NoError(t, err)
+		require.Equal(t, "01ab56XYZ789end", string(w.payload))
+
+		cnr, _ := w.hdr.ContainerID()
+		require.Equal(t, addr.Container(), cnr)
+		require.Equal(t, []objectSDK.Attribute{
+			newAttribute("FileName", "b.txt"),
+			newAttribute("Type", "text"),
+			newAttribute("Version", "2"),
+		}, w.hdr.Attributes())
+
+		// only the untouched ranges are read
+		require.Len(t, s.ranges[addr], 3)
+	})
+
+	t.Run("attributes only", func(t *testing.T) {
+		s := newTestStorage()
+		addr := oidtest.Address()
+		s.objs[addr] = newTestObject(addr, []byte("0123456789"))
+
+		w, err := testPatch(t, s, addr, nil, []objectSDK.Attribute{newAttribute("FileName", "a.txt")})
+		require.NoError(t, err)
+		require.Equal(t, "0123456789", string(w.payload))
+		require.Equal(t, []objectSDK.Attribute{newAttribute("FileName", "a.txt")}, w.hdr.Attributes())
+	})
+
+	t.Run("adjacent patches", func(t *testing.T) {
+		s := newTestStorage()
+		addr := oidtest.Address()
+		s.objs[addr] = newTestObject(addr, []byte("0123456789"))
+
+		w, err := testPatch(t, s, addr,
+			[]PayloadPatch{newPatch(0, 2, "a"), newPatch(2, 0, "b"), newPatch(2, 8, "c")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "abc", string(w.payload))
+		require.Empty(t, s.ranges[addr])
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		s := newTestStorage()
+		addr := oidtest.Address()
+		s.objs[addr] = newTestObject(addr, []byte("0123456789"))
+
+		_, err := testPatch(t, s, addr, nil, nil)
+		require.ErrorIs(t, err, errEmptyPatch)
+
+		_, err = testPatch(t, s, addr, []PayloadPatch{newPatch(5, 1, ""), newPatch(2, 1, "")}, nil)
+		require.ErrorIs(t, err, errUnsortedPatches)
+
+		_, err = testPatch(t, s, addr, []PayloadPatch{newPatch(2, 4, ""), newPatch(5, 1, "")}, nil)
+		require.ErrorIs(t, err, errUnsortedPatches)
+
+		_, err = testPatch(t, s, addr, []PayloadPatch{newPatch(8, 3, "")}, nil)
+		require.ErrorIs(t, err, errRangeOutOfBound)
+
+		_, err = testPatch(t, s, addr, []PayloadPatch{newPatch(1, ^uint64(0), "")}, nil)
+		require.ErrorIs(t, err, errRangeOutOfBound)
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		svc := New()
+		svc.reader = newTestStorage()
+		svc.placer = new(testPlacer)
+
+		stream, err := svc.Patch(context.Background())
+		require.NoError(t, err)
+
+		require.ErrorIs(t, stream.Apply(newPatch(0, 0, "")), errNotInit)
+
+		var prm Prm
+		prm.WithAddress(oidtest.Address())
+
+		err = stream.Init(&prm)
+		require.True(t, errors.As(err, new(apistatus.ObjectNotFound)))
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		s := newTestStorage()
+		addr := oidtest.Address()
+		s.objs[addr] = newTestObject(addr, []byte("0123456789"))
+
+		stream, _ := newTestStreamer(t, s, addr, nil, WithMaxChunkSize(2), WithMaxPatchSize(3))
+		require.ErrorIs(t, stream.Apply(newPatch(0, 1, "abc")), errChunkTooBig)
+
+		stream, _ = newTestStreamer(t, s, addr, nil, WithMaxChunkSize(2), WithMaxPatchSize(3))
+		require.NoError(t, stream.Apply(newPatch(0, 1, "ab")))
+		require.ErrorIs(t, stream.Apply(newPatch(1, 1, "cd")), errPatchTooBig)
+	})
+}
+
+func TestSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	m := &PatchRequest{
+		Body: &PatchRequest_Body{
+			ContainerId: []byte{1},
+			ObjectId:    []byte{2},
+		},
+	}
+	require.NoError(t, SignMessage(m, key))
+
+	var req Request
+	require.NoError(t, req.FromGRPCMessage(m))
+	require.NoError(t, req.Verify())
+
+	m.MetaHeader = []byte{1}
+	require.Error(t, req.Verify())
+
+	resp := NewResponse(oidtest.ID())
+	require.NoError(t, resp.Sign(key))
+	require.NoError(t, verifyMessage(resp.ToGRPCMessage()))
+}
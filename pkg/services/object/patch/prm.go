@@ -0,0 +1,53 @@
+package patchsvc
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Prm groups parameters of Patch stream initialization.
+type Prm struct {
+	common *util.CommonPrm
+
+	addr oid.Address
+
+	attrs []objectSDK.Attribute
+}
+
+// PayloadPatch is a replacement of the original object payload range.
+type PayloadPatch struct {
+	// Range is the replaced range of the original payload. The range
+	// with zero length inserts the chunk at the offset, the range at
+	// the end of the payload appends the chunk.
+	Range objectSDK.Range
+
+	// Chunk replaces the range, it can be shorter or longer than the range.
+	Chunk []byte
+}
+
+// Res groups the resulting values of Patch stream.
+type Res struct {
+	id oid.ID
+}
+
+// SetCommonParameters sets common parameters of the operation.
+func (p *Prm) SetCommonParameters(common *util.CommonPrm) {
+	p.common = common
+}
+
+// WithAddress sets the address of the original object.
+func (p *Prm) WithAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// SetAttributes sets the attributes of the new object. Attributes replace the
+// original ones with the same key, other attributes are added.
+func (p *Prm) SetAttributes(attrs []objectSDK.Attribute) {
+	p.attrs = attrs
+}
+
+// ObjectID returns the identifier of the new object.
+func (r *Res) ObjectID() oid.ID {
+	return r.id
+}
@@ -0,0 +1,124 @@
+package patchsvc
+
+import (
+	"context"
+
+	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
+	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// Service utility serving requests of Object.Patch service.
+//
+// The patched payload is assembled on the node: the client sends only the
+// changed ranges, the untouched ranges are read from the original object and
+// written to the new object together with the patches while they are received.
+type Service struct {
+	*cfg
+}
+
+// Option is a Service's constructor option.
+type Option func(*cfg)
+
+type cfg struct {
+	log *logger.Logger
+
+	maxChunkSize uint64
+
+	maxPatchSize uint64
+
+	reader interface {
+		// head returns the object header.
+		head(ctx context.Context, common *util.CommonPrm, addr oid.Address) (*objectSDK.Object, error)
+
+		// payloadRange writes the payload range of the object to w.
+		payloadRange(ctx context.Context, common *util.CommonPrm, addr oid.Address, rng *objectSDK.Range, w getsvc.ChunkWriter) error
+	}
+
+	placer interface {
+		// init starts the stream of the new object with the header.
+		init(ctx context.Context, common *util.CommonPrm, hdr *objectSDK.Object) (objectWriter, error)
+	}
+}
+
+// objectWriter writes the payload of the new object.
+type objectWriter interface {
+	getsvc.ChunkWriter
+
+	// close finishes the object and returns its identifier.
+	close() (oid.ID, error)
+}
+
+const (
+	// defaultMaxChunkSize is the default limit of a single patch chunk.
+	defaultMaxChunkSize = 3 << 20
+
+	// defaultMaxPatchSize is the default limit of all the patch chunks
+	// of the stream.
+	defaultMaxPatchSize = 64 << 20
+)
+
+func defaultCfg() *cfg {
+	return &cfg{
+		log:          &logger.Logger{Logger: zap.L()},
+		maxChunkSize: defaultMaxChunkSize,
+		maxPatchSize: defaultMaxPatchSize,
+	}
+}
+
+// New creates, initializes and returns utility serving
+// Object.Patch service requests.
+func New(opts ...Option) *Service {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return &Service{
+		cfg: c,
+	}
+}
+
+// WithLogger returns option to specify Patch service's logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = &logger.Logger{Logger: l.With(zap.String("component", "Object.Patch service"))}
+	}
+}
+
+// WithGetService returns option to set Get service
+// to read the original object.
+func WithGetService(g *getsvc.Service) Option {
+	return func(c *cfg) {
+		c.reader = (*getSvcWrapper)(g)
+	}
+}
+
+// WithPutService returns option to set Put service
+// to store the new object.
+func WithPutService(p *putsvc.Service) Option {
+	return func(c *cfg) {
+		c.placer = (*putSvcWrapper)(p)
+	}
+}
+
+// WithMaxChunkSize returns option to set the size limit of a single
+// patch chunk.
+func WithMaxChunkSize(sz uint64) Option {
+	return func(c *cfg) {
+		c.maxChunkSize = sz
+	}
+}
+
+// WithMaxPatchSize returns option to set the size limit of all the
+// patch chunks of the stream.
+func WithMaxPatchSize(sz uint64) Option {
+	return func(c *cfg) {
+		c.maxPatchSize = sz
+	}
+}
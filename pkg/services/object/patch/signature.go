@@ -0,0 +1,98 @@
+package patchsvc
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	frostfscrypto "github.com/TrueCloudLab/frostfs-sdk-go/crypto"
+	frostfsecdsa "github.com/TrueCloudLab/frostfs-sdk-go/crypto/ecdsa"
+	"google.golang.org/protobuf/proto"
+)
+
+type message interface {
+	ReadSignedData([]byte) ([]byte, error)
+	GetSignature() *Signature
+}
+
+// ReadSignedData returns the signed part of the request: the body and the meta
+// header serialized in the protobuf binary format with the fields in the
+// number order.
+func (x *PatchRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.MarshalAppend(buf[:0], &PatchRequest{
+		Body:       x.GetBody(),
+		MetaHeader: x.GetMetaHeader(),
+	})
+}
+
+// SetSignature sets the request signature.
+func (x *PatchRequest) SetSignature(sig *Signature) {
+	x.Signature = sig
+}
+
+// ReadSignedData returns the signed part of the response: the body and the
+// meta header serialized in the protobuf binary format with the fields in the
+// number order.
+func (x *PatchResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.MarshalAppend(buf[:0], &PatchResponse{
+		Body:       x.GetBody(),
+		MetaHeader: x.GetMetaHeader(),
+	})
+}
+
+// SetSignature sets the response signature.
+func (x *PatchResponse) SetSignature(sig *Signature) {
+	x.Signature = sig
+}
+
+func verifyMessage(m message) error {
+	binBody, err := m.ReadSignedData(nil)
+	if err != nil {
+		return fmt.Errorf("marshal request body: %w", err)
+	}
+
+	sig := m.GetSignature()
+
+	var sigV2 refs.Signature
+	sigV2.SetKey(sig.GetKey())
+	sigV2.SetSign(sig.GetSign())
+	sigV2.SetScheme(refs.ECDSA_SHA512)
+
+	var sigSDK frostfscrypto.Signature
+	if err := sigSDK.ReadFromV2(sigV2); err != nil {
+		return fmt.Errorf("can't read signature: %w", err)
+	}
+
+	if !sigSDK.Verify(binBody) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// SignMessage signs the request or the response of the Patch service
+// with the provided key.
+func SignMessage(m interface {
+	message
+	SetSignature(*Signature)
+}, key *ecdsa.PrivateKey) error {
+	binBody, err := m.ReadSignedData(nil)
+	if err != nil {
+		return err
+	}
+
+	keySDK := frostfsecdsa.Signer(*key)
+	data, err := keySDK.Sign(binBody)
+	if err != nil {
+		return err
+	}
+
+	rawPub := make([]byte, keySDK.Public().MaxEncodedSize())
+	rawPub = rawPub[:keySDK.Public().Encode(rawPub)]
+	m.SetSignature(&Signature{
+		Key:  rawPub,
+		Sign: data,
+	})
+
+	return nil
+}
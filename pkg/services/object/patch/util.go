@@ -0,0 +1,74 @@
+package patchsvc
+
+import (
+	"context"
+
+	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
+	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+type getSvcWrapper getsvc.Service
+
+type putSvcWrapper putsvc.Service
+
+type streamWriter struct {
+	stream *putsvc.Streamer
+}
+
+func (w *getSvcWrapper) head(ctx context.Context, common *util.CommonPrm, addr oid.Address) (*objectSDK.Object, error) {
+	wr := getsvc.NewSimpleObjectWriter()
+
+	p := getsvc.HeadPrm{}
+	p.SetCommonParameters(common)
+	p.SetHeaderWriter(wr)
+	p.WithAddress(addr)
+
+	err := (*getsvc.Service)(w).Head(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.Object(), nil
+}
+
+func (w *getSvcWrapper) payloadRange(ctx context.Context, common *util.CommonPrm, addr oid.Address, rng *objectSDK.Range, cw getsvc.ChunkWriter) error {
+	p := getsvc.RangePrm{}
+	p.SetCommonParameters(common)
+	p.SetChunkWriter(cw)
+	p.SetRange(rng)
+	p.WithAddress(addr)
+
+	return (*getsvc.Service)(w).GetRange(ctx, p)
+}
+
+func (w *putSvcWrapper) init(ctx context.Context, common *util.CommonPrm, hdr *objectSDK.Object) (objectWriter, error) {
+	stream, err := (*putsvc.Service)(w).Put(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(putsvc.PutInitPrm).
+		WithCommonPrm(common).
+		WithObject(hdr)
+
+	if err := stream.Init(p); err != nil {
+		return nil, err
+	}
+
+	return &streamWriter{stream: stream}, nil
+}
+
+func (w *streamWriter) WriteChunk(p []byte) error {
+	return w.stream.SendChunk(new(putsvc.PutChunkPrm).WithChunk(p))
+}
+
+func (w *streamWriter) close() (oid.ID, error) {
+	resp, err := w.stream.Close()
+	if err != nil {
+		return oid.ID{}, err
+	}
+	return resp.ObjectID(), nil
+}
@@ -0,0 +1,52 @@
+package patchsvc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
+)
+
+// Service implements Patch operation of Object service v2.
+type Service struct {
+	*cfg
+}
+
+// Option represents Service constructor option.
+type Option func(*cfg)
+
+type cfg struct {
+	svc *patchsvc.Service
+}
+
+// NewService constructs Service instance from provided options.
+func NewService(opts ...Option) *Service {
+	c := new(cfg)
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return &Service{
+		cfg: c,
+	}
+}
+
+// Patch calls internal service and returns v2 object patch streamer.
+func (s *Service) Patch(ctx context.Context) (object.PatchObjectStream, error) {
+	stream, err := s.svc.Patch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not open object patch stream: %w", s, err)
+	}
+
+	return &streamer{
+		stream: stream,
+	}, nil
+}
+
+func WithInternalService(v *patchsvc.Service) Option {
+	return func(c *cfg) {
+		c.svc = v
+	}
+}
@@ -0,0 +1,45 @@
+package patchsvc
+
+import (
+	"fmt"
+
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
+)
+
+type streamer struct {
+	stream *patchsvc.Streamer
+
+	initialized bool // set on the first Send call
+}
+
+func (s *streamer) Send(req *patchsvc.Request) error {
+	if !s.initialized {
+		prm, err := toInitPrm(req)
+		if err != nil {
+			return err
+		}
+
+		if err := s.stream.Init(prm); err != nil {
+			return fmt.Errorf("(%T) could not init object patch stream: %w", s, err)
+		}
+
+		s.initialized = true
+	}
+
+	for _, p := range req.GetBody().GetPatches() {
+		if err := s.stream.Apply(toPayloadPatch(p)); err != nil {
+			return fmt.Errorf("(%T) could not apply payload patch: %w", s, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *streamer) CloseAndRecv() (*patchsvc.Response, error) {
+	res, err := s.stream.Close()
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not close object patch stream: %w", s, err)
+	}
+
+	return patchsvc.NewResponse(res.ObjectID()), nil
+}
@@ -0,0 +1,65 @@
+package patchsvc
+
+import (
+	"errors"
+	"fmt"
+
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// errMissingSession is returned when the request has no session token:
+// the new object is signed by the node with the session key.
+var errMissingSession = errors.New("missing session token")
+
+func toInitPrm(req *patchsvc.Request) (*patchsvc.Prm, error) {
+	body := req.GetBody()
+
+	var cnr cid.ID
+	if err := cnr.Decode(body.GetContainerId()); err != nil {
+		return nil, fmt.Errorf("invalid container ID: %w", err)
+	}
+
+	var obj oid.ID
+	if err := obj.Decode(body.GetObjectId()); err != nil {
+		return nil, fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+
+	commonPrm, err := util.CommonPrmFromV2(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if commonPrm.SessionToken() == nil {
+		return nil, errMissingSession
+	}
+
+	attrs := make([]object.Attribute, len(body.GetAttributes()))
+	for i, kv := range body.GetAttributes() {
+		attrs[i].SetKey(kv.GetKey())
+		attrs[i].SetValue(kv.GetValue())
+	}
+
+	prm := new(patchsvc.Prm)
+	prm.SetCommonParameters(commonPrm)
+	prm.WithAddress(addr)
+	prm.SetAttributes(attrs)
+
+	return prm, nil
+}
+
+func toPayloadPatch(p *patchsvc.PatchRequest_Body_Patch) patchsvc.PayloadPatch {
+	var res patchsvc.PayloadPatch
+	res.Range.SetOffset(p.GetOffset())
+	res.Range.SetLength(p.GetLength())
+	res.Chunk = p.GetChunk()
+
+	return res
+}
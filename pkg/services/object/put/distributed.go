@@ -7,6 +7,7 @@ import (
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	svcutil "github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
@@ -36,6 +37,8 @@ type distributedTarget struct {
 
 	relay func(nodeDesc) error
 
+	ec *erasureCoding
+
 	fmt *object.FormatValidator
 
 	log *logger.Logger
@@ -141,6 +144,10 @@ func (t *distributedTarget) Close() (*transformer.AccessIdentifiers, error) {
 		return nil, fmt.Errorf("(%T) could not validate payload content: %w", t, err)
 	}
 
+	if t.ec != nil && erasurecode.Applicable(t.obj) {
+		return t.distributeChunks()
+	}
+
 	return t.iteratePlacement(t.sendObject)
 }
 
@@ -0,0 +1,173 @@
+package putsvc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	svcutil "github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+)
+
+// parameters of the erasure coding applied to the container objects.
+type erasureCoding struct {
+	ctx context.Context
+
+	scheme erasurecode.Scheme
+
+	keyStorage *svcutil.KeyStorage
+
+	netState netmap.State
+
+	clientConstructor ClientConstructor
+
+	withoutHomomorphicHash bool
+}
+
+// distributeChunks splits the object into erasure-coded chunks and saves
+// i-th chunk on the i-th node of the object placement. Chunks failed to be
+// saved are moved to the next unused container nodes.
+func (t *distributedTarget) distributeChunks() (*transformer.AccessIdentifiers, error) {
+	// chunks are formed by the storage node, so they are signed with its key
+	key, err := t.ec.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not receive node key: %w", t, err)
+	}
+
+	chunks, err := erasurecode.Encode(t.ec.scheme, t.obj, erasurecode.ChunkPrm{
+		Key:                    key,
+		Epoch:                  t.ec.netState.CurrentEpoch(),
+		WithoutHomomorphicHash: t.ec.withoutHomomorphicHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not encode object: %w", t, err)
+	}
+
+	id, _ := t.obj.ID()
+
+	traverser, err := placement.NewTraverser(
+		append(t.traversal.opts,
+			placement.ForObject(id),
+			placement.SuccessAfter(uint32(len(chunks))),
+		)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not create object placement traverser: %w", t, err)
+	}
+
+	var resErr atomic.Value
+
+	pending := make([]int, len(chunks))
+	for i := range pending {
+		pending[i] = i
+	}
+
+loop:
+	for len(pending) > 0 {
+		// traverser returns exactly as many nodes as chunks are still
+		// unsaved since every saved chunk is submitted as a success
+		nodes := traverser.Next()
+		if len(nodes) == 0 {
+			break
+		}
+
+		var (
+			wg     sync.WaitGroup
+			mtx    sync.Mutex
+			failed []int
+		)
+
+		for i := range nodes {
+			idx := pending[i]
+			node := nodes[i]
+			isLocal := t.isLocalKey(node.PublicKey())
+
+			var workerPool util.WorkerPool
+
+			if isLocal {
+				workerPool = t.localPool
+			} else {
+				workerPool = t.remotePool
+			}
+
+			wg.Add(1)
+
+			if err := workerPool.Submit(func() {
+				defer wg.Done()
+
+				err := t.sendChunk(nodeDesc{local: isLocal, info: node}, chunks[idx])
+				if err != nil {
+					resErr.Store(err)
+					svcutil.LogServiceError(t.log, "PUT", node.Addresses(), err)
+
+					mtx.Lock()
+					failed = append(failed, idx)
+					mtx.Unlock()
+
+					return
+				}
+
+				traverser.SubmitSuccess()
+			}); err != nil {
+				wg.Done()
+
+				svcutil.LogWorkerPoolError(t.log, "PUT", err)
+
+				wg.Wait()
+
+				break loop
+			}
+		}
+
+		wg.Wait()
+
+		pending = failed
+	}
+
+	if !traverser.Success() {
+		var err errIncompletePut
+
+		err.singleErr, _ = resErr.Load().(error)
+
+		return nil, err
+	}
+
+	return new(transformer.AccessIdentifiers).
+		WithSelfID(id), nil
+}
+
+func (t *distributedTarget) sendChunk(node nodeDesc, chunk *objectSDK.Object) error {
+	var target preparedObjectTarget
+
+	if node.local {
+		target = t.nodeTargetInitializer(node)
+	} else {
+		// chunk owner is the local node, so the chunk is sent on behalf of
+		// the node without the tokens attached to the original request
+		rt := &remoteTarget{
+			ctx:               t.ec.ctx,
+			keyStorage:        t.ec.keyStorage,
+			clientConstructor: t.ec.clientConstructor,
+		}
+
+		client.NodeInfoFromNetmapElement(&rt.nodeInfo, node.info)
+
+		target = rt
+	}
+
+	if err := target.WriteObject(chunk, object.ContentMeta{}); err != nil {
+		return fmt.Errorf("could not write chunk header: %w", err)
+	} else if _, err := target.Close(); err != nil {
+		return fmt.Errorf("could not close chunk stream: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package putsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	svcutil "github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	netmaptest "github.com/TrueCloudLab/frostfs-sdk-go/netmap/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+type testEpochState uint64
+
+func (s testEpochState) CurrentEpoch() uint64 {
+	return uint64(s)
+}
+
+type testPlacementBuilder struct {
+	nodes []netmap.NodeInfo
+}
+
+func (b testPlacementBuilder) BuildPlacement(cid.ID, *oid.ID, netmap.PlacementPolicy) ([][]netmap.NodeInfo, error) {
+	// traverser modifies the vectors, so a copy is returned
+	return [][]netmap.NodeInfo{append([]netmap.NodeInfo(nil), b.nodes...)}, nil
+}
+
+// testChunkStorage stores the chunks written to the nodes and
+// fails writing to the nodes with the given public keys.
+type testChunkStorage struct {
+	saved map[string]*objectSDK.Object
+
+	failed map[string]struct{}
+}
+
+type testChunkTarget struct {
+	storage *testChunkStorage
+
+	key []byte
+
+	obj *objectSDK.Object
+}
+
+func (t *testChunkTarget) WriteObject(obj *objectSDK.Object, _ object.ContentMeta) error {
+	t.obj = obj
+	return nil
+}
+
+func (t *testChunkTarget) Close() (*transformer.AccessIdentifiers, error) {
+	if _, ok := t.storage.failed[string(t.key)]; ok {
+		return nil, errors.New("any error")
+	}
+
+	t.storage.saved[string(t.key)] = t.obj
+
+	return new(transformer.AccessIdentifiers), nil
+}
+
+func TestDistributedTarget_DistributeChunks(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	scheme, err := erasurecode.NewScheme(2, 1)
+	require.NoError(t, err)
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+
+	var idCnr cid.ID
+	container.CalculateID(&idCnr, cnr)
+
+	payload := make([]byte, 1001)
+	_, _ = rand.Read(payload)
+
+	obj := objectSDK.New()
+	obj.SetContainerID(idCnr)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	objectSDK.CalculateAndSetPayloadChecksum(obj)
+	require.NoError(t, objectSDK.SetIDWithSignature(pk.PrivateKey, obj))
+
+	nodes := make([]netmap.NodeInfo, scheme.Total()+2)
+	for i := range nodes {
+		nodes[i].SetPublicKey([]byte{byte(i)})
+		nodes[i].SetNetworkEndpoints("/ip4/0.0.0.0/tcp/" + strconv.Itoa(i+1))
+	}
+
+	distribute := func(failed ...int) (*testChunkStorage, error) {
+		storage := &testChunkStorage{
+			saved:  make(map[string]*objectSDK.Object),
+			failed: make(map[string]struct{}),
+		}
+
+		for _, i := range failed {
+			storage.failed[string(nodes[i].PublicKey())] = struct{}{}
+		}
+
+		target := &distributedTarget{
+			traversal: traversal{
+				opts: []placement.Option{
+					placement.ForContainer(cnr),
+					placement.UseBuilder(testPlacementBuilder{nodes: nodes}),
+				},
+			},
+			remotePool: util.NewPseudoWorkerPool(),
+			localPool:  util.NewPseudoWorkerPool(),
+			obj:        obj,
+			nodeTargetInitializer: func(node nodeDesc) preparedObjectTarget {
+				return &testChunkTarget{storage: storage, key: node.info.PublicKey()}
+			},
+			isLocalKey: func([]byte) bool { return true },
+			ec: &erasureCoding{
+				ctx:        context.Background(),
+				scheme:     scheme,
+				keyStorage: svcutil.NewKeyStorage(&pk.PrivateKey, nil, nil),
+				netState:   testEpochState(10),
+			},
+			log: test.NewLogger(false),
+		}
+
+		_, err := target.distributeChunks()
+
+		return storage, err
+	}
+
+	// requireChunk checks that the chunk with the given index is stored on the node.
+	requireChunk := func(t *testing.T, storage *testChunkStorage, node, index int) {
+		chunk, ok := storage.saved[string(nodes[node].PublicKey())]
+		require.True(t, ok, "node %d must store the chunk", node)
+
+		info, err := erasurecode.InfoFromObject(chunk)
+		require.NoError(t, err)
+		require.Equal(t, index, info.Index())
+		require.Equal(t, object.AddressOf(obj).Object(), info.Parent())
+	}
+
+	t.Run("placement", func(t *testing.T) {
+		storage, err := distribute()
+		require.NoError(t, err)
+
+		require.Len(t, storage.saved, scheme.Total())
+
+		for i := 0; i < scheme.Total(); i++ {
+			requireChunk(t, storage, i, i)
+		}
+	})
+
+	t.Run("failed node", func(t *testing.T) {
+		storage, err := distribute(1)
+		require.NoError(t, err)
+
+		require.Len(t, storage.saved, scheme.Total())
+
+		requireChunk(t, storage, 0, 0)
+		requireChunk(t, storage, 2, 2)
+		requireChunk(t, storage, scheme.Total(), 1)
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		_, err := distribute(1, scheme.Total(), scheme.Total()+1)
+
+		var errIncomplete errIncompletePut
+		require.ErrorAs(t, err, &errIncomplete)
+	})
+}
@@ -1,6 +1,7 @@
 package putsvc
 
 import (
+	"context"
 	"fmt"
 
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -13,7 +14,7 @@ import (
 type ObjectStorage interface {
 	// Put must save passed object
 	// and return any appeared error.
-	Put(*object.Object) error
+	Put(context.Context, *object.Object) error
 	// Delete must delete passed objects
 	// and return any appeared error.
 	Delete(tombstone oid.Address, toDelete []oid.ID) error
@@ -23,6 +24,8 @@ type ObjectStorage interface {
 }
 
 type localTarget struct {
+	ctx context.Context
+
 	storage ObjectStorage
 
 	obj  *object.Object
@@ -52,7 +55,7 @@ func (t *localTarget) Close() (*transformer.AccessIdentifiers, error) {
 		// objects that do not change meta storage
 	}
 
-	if err := t.storage.Put(t.obj); err != nil {
+	if err := t.storage.Put(t.ctx, t.obj); err != nil {
 		return nil, fmt.Errorf("(%T) could not put object to local storage: %w", t, err)
 	}
 
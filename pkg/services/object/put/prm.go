@@ -3,6 +3,7 @@ package putsvc
 import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -17,6 +18,9 @@ type PutInitPrm struct {
 
 	traverseOpts []placement.Option
 
+	// ecScheme is set if objects must be erasure-coded.
+	ecScheme *erasurecode.Scheme
+
 	relay func(client.NodeInfo, client.MultiAddressClient) error
 }
 
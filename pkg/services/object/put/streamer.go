@@ -8,11 +8,14 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
+	"github.com/TrueCloudLab/frostfs-node/pkg/tracing"
 	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Streamer struct {
@@ -20,6 +23,9 @@ type Streamer struct {
 
 	ctx context.Context
 
+	// span lasts from the stream initialization till its closing
+	span trace.Span
+
 	target transformer.ObjectTarget
 
 	relay func(client.NodeInfo, client.MultiAddressClient) error
@@ -31,7 +37,16 @@ var errNotInit = errors.New("stream not initialized")
 
 var errInitRecall = errors.New("init recall")
 
-func (p *Streamer) Init(prm *PutInitPrm) error {
+func (p *Streamer) Init(prm *PutInitPrm) (err error) {
+	if p.span == nil {
+		p.ctx, p.span = tracing.StartSpanFromContext(p.ctx, "putService.Put")
+		defer func() {
+			if err != nil {
+				tracing.Finish(p.span, err)
+			}
+		}()
+	}
+
 	// initialize destination target
 	if err := p.initTarget(prm); err != nil {
 		return fmt.Errorf("(%T) could not initialize object target: %w", p, err)
@@ -179,6 +194,15 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 
 		// use local-only placement builder
 		builder = util.NewLocalPlacement(builder, p.netmapKeys)
+	} else {
+		scheme, ok, err := erasurecode.SchemeFromContainer(prm.cnr)
+		if err != nil {
+			return fmt.Errorf("(%T) could not read container erasure coding scheme: %w", p, err)
+		}
+
+		if ok {
+			prm.ecScheme = &scheme
+		}
 	}
 
 	// set placement builder
@@ -209,6 +233,18 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 	typ := prm.hdr.Type()
 	withBroadcast := !prm.common.LocalOnly() && (typ == object.TypeTombstone || typ == object.TypeLock)
 
+	var ec *erasureCoding
+	if prm.ecScheme != nil {
+		ec = &erasureCoding{
+			ctx:                    p.ctx,
+			scheme:                 *prm.ecScheme,
+			keyStorage:             p.keyStorage,
+			netState:               p.networkState,
+			clientConstructor:      p.clientConstructor,
+			withoutHomomorphicHash: containerSDK.IsHomomorphicHashingDisabled(prm.cnr),
+		}
+	}
+
 	return &distributedTarget{
 		traversal: traversal{
 			opts: prm.traverseOpts,
@@ -221,6 +257,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		nodeTargetInitializer: func(node nodeDesc) preparedObjectTarget {
 			if node.local {
 				return &localTarget{
+					ctx:     p.ctx,
 					storage: p.localStore,
 				}
 			}
@@ -237,6 +274,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 			return rt
 		},
 		relay: relay,
+		ec:    ec,
 		fmt:   p.fmtValidator,
 		log:   p.log,
 
@@ -256,11 +294,13 @@ func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
 	return nil
 }
 
-func (p *Streamer) Close() (*PutResponse, error) {
+func (p *Streamer) Close() (_ *PutResponse, err error) {
 	if p.target == nil {
 		return nil, errNotInit
 	}
 
+	defer func() { tracing.Finish(p.span, err) }()
+
 	ids, err := p.target.Close()
 	if err != nil {
 		return nil, fmt.Errorf("(%T) could not close object target: %w", p, err)
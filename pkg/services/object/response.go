@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/util/response"
 )
@@ -37,6 +38,10 @@ type putStreamResponser struct {
 	stream *response.ClientMessageStreamer
 }
 
+type patchStreamResponser struct {
+	stream *response.ClientMessageStreamer
+}
+
 // NewResponseService returns object service instance that passes internal service
 // call to response service.
 func NewResponseService(objSvc ServiceServer, respSvc *response.Service) *ResponseService {
@@ -154,3 +159,34 @@ func (s *ResponseService) GetRangeHash(ctx context.Context, req *object.GetRange
 
 	return resp.(*object.GetRangeHashResponse), nil
 }
+
+func (s *patchStreamResponser) Send(req *patchsvc.Request) error {
+	return s.stream.Send(req)
+}
+
+func (s *patchStreamResponser) CloseAndRecv() (*patchsvc.Response, error) {
+	r, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not receive response: %w", s, err)
+	}
+
+	return r.(*patchsvc.Response), nil
+}
+
+func (s *ResponseService) Patch(ctx context.Context) (PatchObjectStream, error) {
+	stream, err := s.svc.Patch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not create Patch object streamer: %w", err)
+	}
+
+	return &patchStreamResponser{
+		stream: s.respSvc.CreateRequestStreamer(
+			func(req interface{}) error {
+				return stream.Send(req.(*patchsvc.Request))
+			},
+			func() (util.ResponseMessage, error) {
+				return stream.CloseAndRecv()
+			},
+		),
+	}, nil
+}
@@ -99,7 +99,7 @@ func (exec *execCtx) processCurrentEpoch() bool {
 					return
 				}
 
-				ids, err := c.searchObjects(exec, info)
+				ids, attrs, err := c.searchObjects(exec, info)
 				if err != nil {
 					exec.log.Debug("remote operation failed",
 						zap.String("error", err.Error()))
@@ -108,7 +108,7 @@ func (exec *execCtx) processCurrentEpoch() bool {
 				}
 
 				mtx.Lock()
-				exec.writeIDList(ids)
+				exec.writeIDList(ids, attrs)
 				mtx.Unlock()
 			}(i)
 		}
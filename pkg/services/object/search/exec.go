@@ -1,7 +1,9 @@
 package searchsvc
 
 import (
+	"bytes"
 	"context"
+	"sort"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
@@ -28,6 +30,10 @@ type execCtx struct {
 	log *logger.Logger
 
 	curProcEpoch uint64
+
+	// found contains the found identifiers with the attribute values
+	// if the results are written at once, see paged.
+	found map[oid.ID][]*string
 }
 
 const (
@@ -39,6 +45,20 @@ func (exec *execCtx) prepare() {
 	if _, ok := exec.prm.writer.(*uniqueIDWriter); !ok {
 		exec.prm.writer = newUniqueAddressWriter(exec.prm.writer)
 	}
+
+	if exec.paged() {
+		exec.found = make(map[oid.ID][]*string)
+	}
+}
+
+// attributeChunkSize is the maximum number of identifiers written
+// with the attribute values at once.
+const attributeChunkSize = 1000
+
+// paged returns true if the found identifiers must be collected
+// and written at once.
+func (exec *execCtx) paged() bool {
+	return exec.prm.limit > 0
 }
 
 func (exec *execCtx) setLogger(l *logger.Logger) {
@@ -117,9 +137,120 @@ func (exec *execCtx) generateTraverser(cnr cid.ID) (*placement.Traverser, bool)
 	}
 }
 
-func (exec *execCtx) writeIDList(ids []oid.ID) {
-	err := exec.prm.writer.WriteIDs(ids)
+func (exec *execCtx) writeIDList(ids []oid.ID, attrs [][]*string) {
+	if exec.paged() {
+		exec.collect(ids, attrs)
+		return
+	}
+
+	if len(exec.prm.attrs) > 0 {
+		exec.writeAttributes(ids, attrs)
+		return
+	}
+
+	exec.writeResult(exec.prm.writer.WriteIDs(ids))
+}
+
+// writeAttributes writes the identifiers after the cursor with the attribute
+// values in chunks. Values are nil if they are unknown.
+func (exec *execCtx) writeAttributes(ids []oid.ID, attrs [][]*string) {
+	res := make([]oid.ID, 0, len(ids))
+	vals := make([][]*string, 0, len(ids))
+
+	for i := range ids {
+		if exec.prm.cursor != nil && bytes.Compare(ids[i][:], exec.prm.cursor[:]) <= 0 {
+			continue
+		}
+
+		res = append(res, ids[i])
+		vals = append(vals, exec.attributeValues(attrs, i))
+	}
+
+	exec.writeChunks(res, vals)
+}
+
+// attributeValues returns the values of the requested attributes of the i-th
+// identifier, nil if they are unknown.
+func (exec *execCtx) attributeValues(attrs [][]*string, i int) []*string {
+	if i < len(attrs) && len(attrs[i]) == len(exec.prm.attrs) {
+		return attrs[i]
+	}
+	return nil
+}
+
+// writeChunks writes the identifiers with the attribute values
+// by attributeChunkSize ones.
+func (exec *execCtx) writeChunks(ids []oid.ID, attrs [][]*string) {
+	w, ok := exec.prm.writer.(AttributeListWriter)
+	if !ok {
+		exec.writeResult(exec.prm.writer.WriteIDs(ids))
+		return
+	}
+
+	for len(ids) > attributeChunkSize {
+		exec.writeResult(w.WriteAttributes(ids[:attributeChunkSize], attrs[:attributeChunkSize]))
+		if exec.status != statusOK {
+			return
+		}
+		ids, attrs = ids[attributeChunkSize:], attrs[attributeChunkSize:]
+	}
+
+	exec.writeResult(w.WriteAttributes(ids, attrs))
+}
+
+// collect saves the identifiers after the cursor to be written
+// by flush. Remote nodes may not support the limit, so it is
+// applied on flush too.
+func (exec *execCtx) collect(ids []oid.ID, attrs [][]*string) {
+	for i := range ids {
+		if exec.prm.cursor != nil && bytes.Compare(ids[i][:], exec.prm.cursor[:]) <= 0 {
+			continue
+		}
+
+		vals := exec.attributeValues(attrs, i)
+
+		if prev, ok := exec.found[ids[i]]; !ok || prev == nil {
+			exec.found[ids[i]] = vals
+		}
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+}
+
+// flush writes the collected identifiers sorted and limited.
+func (exec *execCtx) flush() {
+	if !exec.paged() || exec.status != statusOK {
+		return
+	}
+
+	ids := make([]oid.ID, 0, len(exec.found))
+	for id := range exec.found {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	if exec.prm.limit > 0 && len(ids) > exec.prm.limit {
+		ids = ids[:exec.prm.limit]
+	}
+
+	if len(exec.prm.attrs) == 0 {
+		exec.writeResult(exec.prm.writer.WriteIDs(ids))
+		return
+	}
+
+	attrs := make([][]*string, len(ids))
+	for i := range ids {
+		attrs[i] = exec.found[ids[i]]
+	}
+
+	exec.writeChunks(ids, attrs)
+}
 
+func (exec *execCtx) writeResult(err error) {
 	switch {
 	default:
 		exec.status = statusUndefined
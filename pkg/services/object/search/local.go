@@ -5,7 +5,7 @@ import (
 )
 
 func (exec *execCtx) executeLocal() {
-	ids, err := exec.svc.localStorage.search(exec)
+	ids, attrs, err := exec.svc.localStorage.search(exec)
 
 	if err != nil {
 		exec.status = statusUndefined
@@ -18,5 +18,5 @@ func (exec *execCtx) executeLocal() {
 		return
 	}
 
-	exec.writeIDList(ids)
+	exec.writeIDList(ids, attrs)
 }
@@ -19,6 +19,10 @@ type Prm struct {
 	filters object.SearchFilters
 
 	forwarder RequestForwarder
+
+	limit  int
+	cursor *oid.ID
+	attrs  []string
 }
 
 // IDListWriter is an interface of target component
@@ -27,9 +31,20 @@ type IDListWriter interface {
 	WriteIDs([]oid.ID) error
 }
 
+// AttributeListWriter is an IDListWriter which also accepts the values
+// of the requested attributes (see Prm.SetAttributes).
+type AttributeListWriter interface {
+	IDListWriter
+
+	// WriteAttributes writes the identifiers with the attribute values.
+	// The i-th list of values corresponds to the i-th identifier.
+	WriteAttributes([]oid.ID, [][]*string) error
+}
+
 // RequestForwarder is a callback for forwarding of the
-// original Search requests.
-type RequestForwarder func(coreclient.NodeInfo, coreclient.MultiAddressClient) ([]oid.ID, error)
+// original Search requests. It returns the found identifiers
+// and the values of the requested attributes if they are known.
+type RequestForwarder func(coreclient.NodeInfo, coreclient.MultiAddressClient) ([]oid.ID, [][]*string, error)
 
 // SetCommonParameters sets common parameters of the operation.
 func (p *Prm) SetCommonParameters(common *util.CommonPrm) {
@@ -56,3 +71,27 @@ func (p *Prm) WithContainerID(id cid.ID) {
 func (p *Prm) WithSearchFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
+
+// SetLimit limits the number of the found objects. If the limit
+// is set, the found identifiers are sorted and written at once.
+// Zero limit means no limit.
+func (p *Prm) SetLimit(n int) {
+	p.limit = n
+}
+
+// SetCursor sets the identifier the search continues after.
+func (p *Prm) SetCursor(id oid.ID) {
+	p.cursor = &id
+}
+
+// SetAttributes sets the attribute keys which values must be returned with
+// the found identifiers. Values are written if the writer implements
+// AttributeListWriter.
+func (p *Prm) SetAttributes(keys []string) {
+	p.attrs = keys
+}
+
+// Attributes returns the attribute keys set by SetAttributes.
+func (p *Prm) Attributes() []string {
+	return p.attrs
+}
@@ -19,6 +19,7 @@ func (s *Service) Search(ctx context.Context, prm Prm) error {
 	exec.setLogger(s.log)
 
 	exec.execute()
+	exec.flush()
 
 	return exec.statusError.err
 }
@@ -1,11 +1,13 @@
 package searchsvc
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -24,8 +26,9 @@ import (
 )
 
 type idsErr struct {
-	ids []oid.ID
-	err error
+	ids   []oid.ID
+	attrs [][]*string
+	err   error
 }
 
 type testStorage struct {
@@ -60,6 +63,28 @@ func (s *simpleIDWriter) WriteIDs(ids []oid.ID) error {
 	return nil
 }
 
+type attributeWriter struct {
+	simpleIDWriter
+	attrs [][]*string
+}
+
+func (s *attributeWriter) WriteAttributes(ids []oid.ID, attrs [][]*string) error {
+	s.attrs = append(s.attrs, attrs...)
+	return s.WriteIDs(ids)
+}
+
+type chunkedAttributeWriter struct {
+	attributeWriter
+	maxChunk int
+}
+
+func (s *chunkedAttributeWriter) WriteAttributes(ids []oid.ID, attrs [][]*string) error {
+	if len(ids) > s.maxChunk {
+		s.maxChunk = len(ids)
+	}
+	return s.attributeWriter.WriteAttributes(ids, attrs)
+}
+
 func newTestStorage() *testStorage {
 	return &testStorage{
 		items: make(map[string]idsErr),
@@ -102,22 +127,22 @@ func (c *testClientCache) get(info clientcore.NodeInfo) (searchClient, error) {
 	return v, nil
 }
 
-func (s *testStorage) search(exec *execCtx) ([]oid.ID, error) {
+func (s *testStorage) search(exec *execCtx) ([]oid.ID, [][]*string, error) {
 	v, ok := s.items[exec.containerID().EncodeToString()]
 	if !ok {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	return v.ids, v.err
+	return v.ids, v.attrs, v.err
 }
 
-func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oid.ID, error) {
+func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oid.ID, [][]*string, error) {
 	v, ok := c.items[exec.containerID().EncodeToString()]
 	if !ok {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	return v.ids, v.err
+	return v.ids, v.attrs, v.err
 }
 
 func (c *testStorage) addResult(addr cid.ID, ids []oid.ID, err error) {
@@ -314,6 +339,119 @@ func TestGetRemoteSmall(t *testing.T) {
 			require.Contains(t, w.ids, id)
 		}
 	})
+
+	t.Run("limit and cursor", func(t *testing.T) {
+		var addr oid.Address
+		addr.SetContainer(id)
+
+		ns, as := testNodeMatrix(t, placementDim)
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][][]netmap.NodeInfo{
+				addr.EncodeToString(): ns,
+			},
+		}
+
+		ids := generateIDs(10)
+		sort.Slice(ids, func(i, j int) bool {
+			return bytes.Compare(ids[i][:], ids[j][:]) < 0
+		})
+
+		attrs := make([][]*string, len(ids))
+		for i := range ids {
+			val := ids[i].EncodeToString()
+			attrs[i] = []*string{&val}
+		}
+
+		// nodes return overlapping unsorted results,
+		// the second one doesn't return attributes
+		c1 := newTestStorage()
+		c1.items[id.EncodeToString()] = idsErr{
+			ids:   []oid.ID{ids[5], ids[0], ids[3], ids[7], ids[9]},
+			attrs: [][]*string{attrs[5], attrs[0], attrs[3], attrs[7], attrs[9]},
+		}
+
+		c2 := newTestStorage()
+		c2.addResult(id, []oid.ID{ids[8], ids[1], ids[2], ids[3], ids[4], ids[6]}, nil)
+
+		svc := newSvc(builder, &testClientCache{
+			clients: map[string]*testStorage{
+				as[0][0]: c1,
+				as[0][1]: c2,
+			},
+		})
+
+		w := new(attributeWriter)
+
+		p := newPrm(id, w)
+		p.SetLimit(4)
+		p.SetCursor(ids[0])
+		p.SetAttributes([]string{"id"})
+
+		err := svc.Search(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, ids[1:5], w.ids)
+		require.Equal(t, [][]*string{nil, nil, attrs[3], nil}, w.attrs)
+
+		w = new(attributeWriter)
+		p = newPrm(id, w)
+		p.SetLimit(4)
+		p.SetCursor(ids[8])
+
+		err = svc.Search(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, ids[9:], w.ids)
+		require.Empty(t, w.attrs)
+	})
+
+	t.Run("attributes without limit", func(t *testing.T) {
+		var addr oid.Address
+		addr.SetContainer(id)
+
+		ns, as := testNodeMatrix(t, placementDim)
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][][]netmap.NodeInfo{
+				addr.EncodeToString(): ns,
+			},
+		}
+
+		ids := generateIDs(attributeChunkSize + 1)
+		attrs := make([][]*string, len(ids))
+		for i := range ids {
+			val := ids[i].EncodeToString()
+			attrs[i] = []*string{&val}
+		}
+
+		c1 := newTestStorage()
+		c1.items[id.EncodeToString()] = idsErr{ids: ids, attrs: attrs}
+
+		c2 := newTestStorage()
+		c2.addResult(id, ids[:1], nil)
+
+		svc := newSvc(builder, &testClientCache{
+			clients: map[string]*testStorage{
+				as[0][0]: c1,
+				as[0][1]: c2,
+			},
+		})
+
+		w := &chunkedAttributeWriter{}
+
+		p := newPrm(id, w)
+		p.SetAttributes([]string{"id"})
+
+		err := svc.Search(ctx, p)
+		require.NoError(t, err)
+		require.Len(t, w.ids, len(ids))
+		require.LessOrEqual(t, w.maxChunk, attributeChunkSize)
+
+		for i := range w.ids {
+			if w.attrs[i] != nil {
+				require.Equal(t, w.ids[i].EncodeToString(), *w.attrs[i][0])
+			}
+		}
+	})
 }
 
 func TestGetFromPastEpoch(t *testing.T) {
@@ -24,7 +24,7 @@ type Option func(*cfg)
 type searchClient interface {
 	// searchObjects searches objects on the specified node.
 	// MUST NOT modify execCtx as it can be accessed concurrently.
-	searchObjects(*execCtx, client.NodeInfo) ([]oid.ID, error)
+	searchObjects(*execCtx, client.NodeInfo) ([]oid.ID, [][]*string, error)
 }
 
 type ClientConstructor interface {
@@ -35,7 +35,7 @@ type cfg struct {
 	log *logger.Logger
 
 	localStorage interface {
-		search(*execCtx) ([]oid.ID, error)
+		search(*execCtx) ([]oid.ID, [][]*string, error)
 	}
 
 	clientConstructor interface {
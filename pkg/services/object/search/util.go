@@ -1,14 +1,29 @@
 package searchsvc
 
 import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
 	"sync"
 
+	"github.com/TrueCloudLab/frostfs-api-go/v2/acl"
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc"
+	rpcclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/signature"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/network"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal"
 	internalclient "github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
@@ -66,6 +81,29 @@ func (w *uniqueIDWriter) WriteIDs(list []oid.ID) error {
 	return w.writer.WriteIDs(list)
 }
 
+// WriteAttributes writes identifiers with the attribute values to the
+// underlying writer if it supports attributes.
+func (w *uniqueIDWriter) WriteAttributes(list []oid.ID, attrs [][]*string) error {
+	aw, ok := w.writer.(AttributeListWriter)
+	if !ok {
+		return w.WriteIDs(list)
+	}
+
+	w.mtx.Lock()
+	ids := make([]oid.ID, 0, len(list))
+	vals := make([][]*string, 0, len(list))
+	for i := range list {
+		if _, ok := w.written[list[i]]; !ok {
+			w.written[list[i]] = struct{}{}
+			ids = append(ids, list[i])
+			vals = append(vals, attrs[i])
+		}
+	}
+	w.mtx.Unlock()
+
+	return aw.WriteAttributes(ids, vals)
+}
+
 func (c *clientConstructorWrapper) get(info client.NodeInfo) (searchClient, error) {
 	clt, err := c.constructor.Get(info)
 	if err != nil {
@@ -77,7 +115,7 @@ func (c *clientConstructorWrapper) get(info client.NodeInfo) (searchClient, erro
 	}, nil
 }
 
-func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oid.ID, error) {
+func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oid.ID, [][]*string, error) {
 	if exec.prm.forwarder != nil {
 		return exec.prm.forwarder(info, c.client)
 	}
@@ -93,7 +131,11 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 
 	key, err := exec.svc.keyStore.GetKey(sessionInfo)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if len(exec.prm.attrs) != 0 {
+		return c.searchObjectsRaw(exec, info, key)
 	}
 
 	var prm internalclient.SearchObjectsPrm
@@ -104,30 +146,140 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 	prm.SetSessionToken(exec.prm.common.SessionToken())
 	prm.SetBearerToken(exec.prm.common.BearerToken())
 	prm.SetTTL(exec.prm.common.TTL())
-	prm.SetXHeaders(exec.prm.common.XHeaders())
+	prm.SetXHeaders(exec.xHeaders())
 	prm.SetNetmapEpoch(exec.curProcEpoch)
 	prm.SetContainerID(exec.containerID())
 	prm.SetFilters(exec.searchFilters())
 
 	res, err := internalclient.SearchObjects(prm)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return res.IDList(), nil
+	return res.IDList(), nil, nil
 }
 
-func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
+// searchObjectsRaw searches objects through the raw protocol client to read
+// the values of the requested attributes from the response meta headers
+// which the SDK client doesn't expose.
+func (c *clientWrapper) searchObjectsRaw(exec *execCtx, info client.NodeInfo, key *ecdsa.PrivateKey) ([]oid.ID, [][]*string, error) {
+	var cnrV2 refs.ContainerID
+	exec.containerID().WriteToV2(&cnrV2)
+
+	var body objectV2.SearchRequestBody
+	body.SetVersion(1)
+	body.SetContainerID(&cnrV2)
+	body.SetFilters(objectcore.SearchFiltersToV2(exec.searchFilters()))
+
+	xHdrs := exec.xHeaders()
+	xs := make([]session.XHeader, len(xHdrs)/2)
+	for i := range xs {
+		xs[i].SetKey(xHdrs[2*i])
+		xs[i].SetValue(xHdrs[2*i+1])
+	}
+
+	var meta session.RequestMetaHeader
+	meta.SetTTL(exec.prm.common.TTL())
+	meta.SetEpoch(exec.curProcEpoch)
+	meta.SetXHeaders(xs)
+
+	if tok := exec.prm.common.SessionToken(); tok != nil {
+		var tokV2 session.Token
+		tok.WriteToV2(&tokV2)
+		meta.SetSessionToken(&tokV2)
+	}
+
+	if tok := exec.prm.common.BearerToken(); tok != nil {
+		var tokV2 acl.BearerToken
+		tok.WriteToV2(&tokV2)
+		meta.SetBearerToken(&tokV2)
+	}
+
+	var req objectV2.SearchRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(&meta)
+
+	if err := signature.SignServiceMessage(key, &req); err != nil {
+		return nil, nil, fmt.Errorf("could not sign request: %w", err)
+	}
+
+	var (
+		ids   []oid.ID
+		attrs [][]*string
+		err   error
+	)
+
+	info.AddressGroup().IterateAddresses(func(addr network.Address) bool {
+		ids, attrs = nil, nil
+		err = c.client.RawForAddress(addr, func(cli *rpcclient.Client) error {
+			stream, err := rpc.SearchObjects(cli, &req, rpcclient.WithContext(exec.context()))
+			if err != nil {
+				return err
+			}
+
+			for {
+				var resp objectV2.SearchResponse
+				if err := stream.Read(&resp); err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return fmt.Errorf("reading the response failed: %w", err)
+				}
+
+				if err := internal.VerifyResponseKeyV2(info.PublicKey(), &resp); err != nil {
+					return err
+				}
+
+				if err := signature.VerifyServiceMessage(&resp); err != nil {
+					return fmt.Errorf("could not verify %T: %w", &resp, err)
+				}
+
+				st := apistatus.FromStatusV2(resp.GetMetaHeader().GetStatus())
+				if err := apistatus.ErrFromStatus(st); err != nil {
+					return err
+				}
+
+				chunk := resp.GetBody().GetIDList()
+				for i := range chunk {
+					var id oid.ID
+					if err := id.ReadFromV2(chunk[i]); err != nil {
+						return fmt.Errorf("invalid object ID: %w", err)
+					}
+					ids = append(ids, id)
+				}
+
+				vals, err := AttributeValuesFromMeta(resp.GetMetaHeader(), len(chunk), len(exec.prm.attrs))
+				if err != nil {
+					return err
+				}
+				attrs = append(attrs, vals...)
+			}
+		})
+		return err == nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("read object list: %w", err)
+	}
+
+	return ids, attrs, nil
+}
+
+func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, [][]*string, error) {
 	var selectPrm engine.SelectPrm
 	selectPrm.WithFilters(exec.searchFilters())
 	selectPrm.WithContainerID(exec.containerID())
+	selectPrm.WithLimit(exec.prm.limit)
+	selectPrm.WithAttributes(exec.prm.attrs...)
+	if exec.prm.cursor != nil {
+		selectPrm.WithCursor(*exec.prm.cursor)
+	}
 
 	r, err := e.storage.Select(selectPrm)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return idsFromAddresses(r.AddressList()), nil
+	return idsFromAddresses(r.AddressList()), r.AttributeList(), nil
 }
 
 func idsFromAddresses(addrs []oid.Address) []oid.ID {
@@ -3,7 +3,9 @@ package searchsvc
 import (
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
 	objectSvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object"
+	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
@@ -27,3 +29,28 @@ func (s *streamWriter) WriteIDs(ids []oid.ID) error {
 
 	return s.stream.Send(r)
 }
+
+func (s *streamWriter) WriteAttributes(ids []oid.ID, attrs [][]*string) error {
+	r := new(object.SearchResponse)
+
+	body := new(object.SearchResponseBody)
+	r.SetBody(body)
+
+	idsV2 := make([]refs.ObjectID, len(ids))
+	xhdrs := make([]session.XHeader, len(ids))
+
+	for i := range ids {
+		ids[i].WriteToV2(&idsV2[i])
+
+		xhdrs[i].SetKey(searchsvc.XHeaderAttributeValues)
+		xhdrs[i].SetValue(searchsvc.EncodeAttributeValues(attrs[i]))
+	}
+
+	body.SetIDList(idsV2)
+
+	meta := new(session.ResponseMetaHeader)
+	meta.SetXHeaders(xhdrs)
+	r.SetMetaHeader(meta)
+
+	return s.stream.Send(r)
+}
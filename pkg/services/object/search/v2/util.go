@@ -12,13 +12,13 @@ import (
 	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
 	"github.com/TrueCloudLab/frostfs-api-go/v2/signature"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/network"
 	objectSvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal"
 	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
-	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
@@ -47,6 +47,10 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 	p := new(searchsvc.Prm)
 	p.SetCommonParameters(commonPrm)
 
+	if err := p.ParseXHeaders(commonPrm.XHeaders()); err != nil {
+		return nil, err
+	}
+
 	p.SetWriter(&streamWriter{
 		stream: stream,
 	})
@@ -59,7 +63,7 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 			return nil, err
 		}
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte) ([]oid.ID, error) {
+		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte) ([]oid.ID, [][]*string, error) {
 			var err error
 
 			// once compose and resign forwarding request
@@ -76,7 +80,7 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 			})
 
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
 			var searchStream *rpc.SearchResponseReader
@@ -85,13 +89,14 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 				return err
 			})
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
 			// code below is copy-pasted from c.SearchObjects implementation,
 			// perhaps it is worth highlighting the utility function in frostfs-api-go
 			var (
 				searchResult []oid.ID
+				attrs        [][]*string
 				resp         = new(objectV2.SearchResponse)
 			)
 
@@ -103,17 +108,17 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 						break
 					}
 
-					return nil, fmt.Errorf("reading the response failed: %w", err)
+					return nil, nil, fmt.Errorf("reading the response failed: %w", err)
 				}
 
 				// verify response key
 				if err = internal.VerifyResponseKeyV2(pubkey, resp); err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 
 				// verify response structure
 				if err := signature.VerifyServiceMessage(resp); err != nil {
-					return nil, fmt.Errorf("could not verify %T: %w", resp, err)
+					return nil, nil, fmt.Errorf("could not verify %T: %w", resp, err)
 				}
 
 				chunk := resp.GetBody().GetIDList()
@@ -122,28 +127,35 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 				for i := range chunk {
 					err = id.ReadFromV2(chunk[i])
 					if err != nil {
-						return nil, fmt.Errorf("invalid object ID: %w", err)
+						return nil, nil, fmt.Errorf("invalid object ID: %w", err)
 					}
 
 					searchResult = append(searchResult, id)
 				}
+
+				vals, err := searchsvc.AttributeValuesFromMeta(resp.GetMetaHeader(), len(chunk), len(p.Attributes()))
+				if err != nil {
+					return nil, nil, err
+				}
+				attrs = append(attrs, vals...)
 			}
 
-			return searchResult, nil
+			return searchResult, attrs, nil
 		}))
 	}
 
 	p.WithContainerID(id)
-	p.WithSearchFilters(object.NewSearchFiltersFromV2(body.GetFilters()))
+	p.WithSearchFilters(objectcore.SearchFiltersFromV2(body.GetFilters()))
 
 	return p, nil
 }
 
-func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) ([]oid.ID, error)) searchsvc.RequestForwarder {
-	return func(info client.NodeInfo, c client.MultiAddressClient) ([]oid.ID, error) {
+func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) ([]oid.ID, [][]*string, error)) searchsvc.RequestForwarder {
+	return func(info client.NodeInfo, c client.MultiAddressClient) ([]oid.ID, [][]*string, error) {
 		var (
 			firstErr error
 			res      []oid.ID
+			attrs    [][]*string
 
 			key = info.PublicKey()
 		)
@@ -161,11 +173,11 @@ func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressCli
 				// would be nice to log otherwise
 			}()
 
-			res, err = f(addr, c, key)
+			res, attrs, err = f(addr, c, key)
 
 			return
 		})
 
-		return res, firstErr
+		return res, attrs, firstErr
 	}
 }
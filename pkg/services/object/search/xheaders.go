@@ -0,0 +1,176 @@
+package searchsvc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// X-headers extending Search requests and responses until the protocol
+// supports paged search natively.
+//
+// Request headers are set by the client and forwarded to the container
+// nodes with the values of the operation. Each of XHeaderLimit and
+// XHeaderCursor may be set at most once, XHeaderAttribute may be repeated
+// with distinct non-empty keys. Requests with malformed values are rejected.
+//
+// If attributes are requested, every identifier list of the response stream
+// is accompanied by XHeaderAttributeValues headers, one per identifier in the
+// same order. Responses with any other number of the headers are malformed.
+const (
+	// XHeaderLimit is a request X-header with the maximum number
+	// of the returned identifiers as a decimal integer.
+	XHeaderLimit = session.ReservedXHeaderPrefix + "SEARCH_LIMIT"
+
+	// XHeaderCursor is a request X-header with the cursor returned by
+	// CursorAfter for the last identifier of the previous page.
+	XHeaderCursor = session.ReservedXHeaderPrefix + "SEARCH_CURSOR"
+
+	// XHeaderAttribute is a request X-header with the attribute key
+	// which values must be returned. It can be repeated.
+	XHeaderAttribute = session.ReservedXHeaderPrefix + "SEARCH_ATTRIBUTE"
+
+	// XHeaderAttributeValues is a response X-header with the values of
+	// the requested attributes of a single object encoded as a JSON array
+	// with an element per requested key in the request order. The element
+	// is a string with the attribute value or null if the object has no
+	// such attribute. The header value is null if the attributes of the
+	// object are unknown, e.g. it was found by a node not supporting them.
+	XHeaderAttributeValues = session.ReservedXHeaderPrefix + "SEARCH_ATTRIBUTE_VALUES"
+)
+
+// CursorAfter returns the cursor to continue the search after the identifier.
+// Search results are sorted, so the cursor is opaque for the client.
+func CursorAfter(id oid.ID) string {
+	return id.EncodeToString()
+}
+
+// ParseXHeaders sets the paged search parameters from the request X-headers
+// given as key-value pairs.
+func (p *Prm) ParseXHeaders(xhdrs []string) error {
+	var withLimit, withCursor bool
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		switch key, val := xhdrs[i], xhdrs[i+1]; key {
+		case XHeaderLimit:
+			if withLimit {
+				return fmt.Errorf("duplicated %s header", key)
+			}
+			withLimit = true
+
+			n, err := strconv.ParseUint(val, 10, 31)
+			if err != nil {
+				return fmt.Errorf("invalid %s header: %w", key, err)
+			}
+			p.SetLimit(int(n))
+		case XHeaderCursor:
+			if withCursor {
+				return fmt.Errorf("duplicated %s header", key)
+			}
+			withCursor = true
+
+			var id oid.ID
+			if err := id.DecodeString(val); err != nil {
+				return fmt.Errorf("invalid %s header: %w", key, err)
+			}
+			p.SetCursor(id)
+		case XHeaderAttribute:
+			if val == "" {
+				return fmt.Errorf("invalid %s header: empty attribute key", key)
+			}
+			for j := range p.attrs {
+				if p.attrs[j] == val {
+					return fmt.Errorf("invalid %s header: duplicated attribute key %s", key, val)
+				}
+			}
+			p.attrs = append(p.attrs, val)
+		}
+	}
+	return nil
+}
+
+// xHeaders returns the X-headers of the request to the container nodes:
+// the original ones with the paged search parameters of the operation.
+func (exec *execCtx) xHeaders() []string {
+	orig := exec.prm.common.XHeaders()
+	res := make([]string, 0, len(orig)+2*(2+len(exec.prm.attrs)))
+
+	for i := 0; i+1 < len(orig); i += 2 {
+		switch orig[i] {
+		case XHeaderLimit, XHeaderCursor, XHeaderAttribute:
+		default:
+			res = append(res, orig[i], orig[i+1])
+		}
+	}
+
+	if exec.prm.limit > 0 {
+		res = append(res, XHeaderLimit, strconv.Itoa(exec.prm.limit))
+	}
+	if exec.prm.cursor != nil {
+		res = append(res, XHeaderCursor, CursorAfter(*exec.prm.cursor))
+	}
+	for i := range exec.prm.attrs {
+		res = append(res, XHeaderAttribute, exec.prm.attrs[i])
+	}
+
+	return res
+}
+
+// EncodeAttributeValues encodes attribute values of a single object
+// for the XHeaderAttributeValues header. Nil values are encoded as the
+// missing attributes, nil list as the unknown attributes.
+func EncodeAttributeValues(vals []*string) string {
+	data, _ := json.Marshal(vals)
+	return string(data)
+}
+
+// DecodeAttributeValues decodes the XHeaderAttributeValues header value
+// with the values of n attributes. Nil list is returned if the values
+// are unknown.
+func DecodeAttributeValues(s string, n int) ([]*string, error) {
+	var vals []*string
+	if err := json.Unmarshal([]byte(s), &vals); err != nil {
+		return nil, fmt.Errorf("invalid %s header: %w", XHeaderAttributeValues, err)
+	}
+	if vals != nil && len(vals) != n {
+		return nil, fmt.Errorf("invalid %s header: %d values instead of %d", XHeaderAttributeValues, len(vals), n)
+	}
+	return vals, nil
+}
+
+// AttributeValuesFromMeta returns values of the given number of the requested
+// attributes of n objects from the response meta header. Nil lists are returned if the
+// values are missing, e.g. the remote node doesn't support them.
+func AttributeValuesFromMeta(meta *session.ResponseMetaHeader, n, keys int) ([][]*string, error) {
+	res := make([][]*string, n)
+
+	for ; meta != nil; meta = meta.GetOrigin() {
+		var i int
+		for _, xhdr := range meta.GetXHeaders() {
+			if xhdr.GetKey() != XHeaderAttributeValues {
+				continue
+			}
+			if i == n {
+				return nil, fmt.Errorf("more %s headers than identifiers: %d", XHeaderAttributeValues, n)
+			}
+
+			vals, err := DecodeAttributeValues(xhdr.GetValue(), keys)
+			if err != nil {
+				return nil, err
+			}
+
+			res[i] = vals
+			i++
+		}
+		if i != 0 {
+			if i != n {
+				return nil, fmt.Errorf("%d %s headers instead of %d", i, XHeaderAttributeValues, n)
+			}
+			break
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,130 @@
+package searchsvc
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrm_ParseXHeaders(t *testing.T) {
+	id := oidtest.ID()
+
+	var p Prm
+	require.NoError(t, p.ParseXHeaders([]string{
+		"Other", "value",
+		XHeaderLimit, "10",
+		XHeaderCursor, CursorAfter(id),
+		XHeaderAttribute, "FileName",
+		XHeaderAttribute, "Timestamp",
+	}))
+	require.Equal(t, 10, p.limit)
+	require.Equal(t, id, *p.cursor)
+	require.Equal(t, []string{"FileName", "Timestamp"}, p.attrs)
+
+	require.Error(t, new(Prm).ParseXHeaders([]string{XHeaderLimit, "-1"}))
+	require.Error(t, new(Prm).ParseXHeaders([]string{XHeaderLimit, strconv.FormatUint(1<<31, 10)}))
+	require.Error(t, new(Prm).ParseXHeaders([]string{XHeaderCursor, "not an ID"}))
+	require.Error(t, new(Prm).ParseXHeaders([]string{XHeaderLimit, "1", XHeaderLimit, "2"}))
+	require.Error(t, new(Prm).ParseXHeaders([]string{XHeaderCursor, CursorAfter(id), XHeaderCursor, CursorAfter(id)}))
+	require.Error(t, new(Prm).ParseXHeaders([]string{XHeaderAttribute, ""}))
+	require.Error(t, new(Prm).ParseXHeaders([]string{XHeaderAttribute, "FileName", XHeaderAttribute, "FileName"}))
+}
+
+func TestExecCtx_XHeaders(t *testing.T) {
+	id := oidtest.ID()
+
+	xhdrs := make([]session.XHeader, 3)
+	xhdrs[0].SetKey("Other")
+	xhdrs[0].SetValue("value")
+	xhdrs[1].SetKey(XHeaderLimit)
+	xhdrs[1].SetValue("100")
+	xhdrs[2].SetKey(XHeaderAttribute)
+	xhdrs[2].SetValue("FileName")
+
+	var meta session.RequestMetaHeader
+	meta.SetTTL(2)
+	meta.SetXHeaders(xhdrs)
+
+	var req object.SearchRequest
+	req.SetMetaHeader(&meta)
+
+	common, err := util.CommonPrmFromV2(&req)
+	require.NoError(t, err)
+
+	var p Prm
+	p.SetCommonParameters(common)
+	p.SetLimit(10)
+	p.SetCursor(id)
+	p.SetAttributes([]string{"Timestamp"})
+
+	exec := execCtx{prm: p}
+	require.Equal(t, []string{
+		"Other", "value",
+		XHeaderLimit, "10",
+		XHeaderCursor, CursorAfter(id),
+		XHeaderAttribute, "Timestamp",
+	}, exec.xHeaders())
+}
+
+func TestAttributeValues(t *testing.T) {
+	val, empty := "a", ""
+
+	vals := []*string{&val, nil, &empty}
+	require.Equal(t, `["a",null,""]`, EncodeAttributeValues(vals))
+	require.Equal(t, "null", EncodeAttributeValues(nil))
+
+	res, err := DecodeAttributeValues(EncodeAttributeValues(vals), 3)
+	require.NoError(t, err)
+	require.Equal(t, vals, res)
+
+	res, err = DecodeAttributeValues("null", 3)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	for _, s := range []string{"", "a", `"a"`, `[1]`, `{}`, `["a",null]`} {
+		_, err := DecodeAttributeValues(s, 3)
+		require.Error(t, err, s)
+	}
+}
+
+func TestAttributeValuesFromMeta(t *testing.T) {
+	newMeta := func(vals ...[]*string) *session.ResponseMetaHeader {
+		xhdrs := make([]session.XHeader, len(vals)+1)
+		xhdrs[0].SetKey("Other")
+		for i := range vals {
+			xhdrs[i+1].SetKey(XHeaderAttributeValues)
+			xhdrs[i+1].SetValue(EncodeAttributeValues(vals[i]))
+		}
+
+		meta := new(session.ResponseMetaHeader)
+		meta.SetXHeaders(xhdrs)
+		return meta
+	}
+
+	a, b, c := "a", "b", ""
+	origin := newMeta([]*string{&a, nil}, nil, []*string{&b, &c})
+	meta := new(session.ResponseMetaHeader)
+	meta.SetOrigin(origin)
+
+	res, err := AttributeValuesFromMeta(meta, 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]*string{{&a, nil}, nil, {&b, &c}}, res)
+
+	res, err = AttributeValuesFromMeta(nil, 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, make([][]*string, 3), res)
+
+	_, err = AttributeValuesFromMeta(meta, 2, 2)
+	require.Error(t, err)
+
+	_, err = AttributeValuesFromMeta(meta, 4, 2)
+	require.Error(t, err)
+
+	_, err = AttributeValuesFromMeta(meta, 3, 1)
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/util"
 )
 
@@ -31,6 +32,12 @@ type PutObjectStream interface {
 	CloseAndRecv() (*object.PutResponse, error)
 }
 
+// PatchObjectStream is an interface of client's object patch streamer.
+type PatchObjectStream interface {
+	Send(*patchsvc.Request) error
+	CloseAndRecv() (*patchsvc.Response, error)
+}
+
 // ServiceServer is an interface of utility
 // serving v2 Object service.
 type ServiceServer interface {
@@ -41,4 +48,5 @@ type ServiceServer interface {
 	Delete(context.Context, *object.DeleteRequest) (*object.DeleteResponse, error)
 	GetRange(*object.GetRangeRequest, GetObjectRangeStream) error
 	GetRangeHash(context.Context, *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error)
+	Patch(context.Context) (PatchObjectStream, error)
 }
@@ -1,11 +1,14 @@
 package object
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	patchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/patch"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/util"
 )
 
@@ -35,6 +38,14 @@ type putStreamSigner struct {
 	stream *util.RequestMessageStreamer
 }
 
+type patchStreamSigner struct {
+	key *ecdsa.PrivateKey
+
+	stream PatchObjectStream
+
+	signer []byte // set on first Send call
+}
+
 type getRangeStreamSigner struct {
 	util.ServerStream
 
@@ -207,3 +218,46 @@ func (s *SignService) GetRangeHash(ctx context.Context, req *object.GetRangeHash
 
 	return resp.(*object.GetRangeHashResponse), nil
 }
+
+// Patch messages are not the part of NeoFS API, so they are verified and
+// signed in the Patch service format: the signature covers the body and the
+// meta header, all the requests of the stream must be signed by the same key.
+func (s *patchStreamSigner) Send(req *patchsvc.Request) error {
+	if err := req.Verify(); err != nil {
+		return fmt.Errorf("could not verify request: %w", err)
+	}
+
+	key := req.GetSignature().GetKey()
+	if s.signer == nil {
+		s.signer = key
+	} else if !bytes.Equal(s.signer, key) {
+		return errors.New("requests of the stream are signed by different keys")
+	}
+
+	return s.stream.Send(req)
+}
+
+func (s *patchStreamSigner) CloseAndRecv() (*patchsvc.Response, error) {
+	resp, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("could not receive response: %w", err)
+	}
+
+	if err := resp.Sign(s.key); err != nil {
+		return nil, fmt.Errorf("could not sign response: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *SignService) Patch(ctx context.Context) (PatchObjectStream, error) {
+	stream, err := s.svc.Patch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not create Patch object streamer: %w", err)
+	}
+
+	return &patchStreamSigner{
+		key:    s.key,
+		stream: stream,
+	}, nil
+}
@@ -148,6 +148,10 @@ func (c TransportSplitter) GetRangeHash(ctx context.Context, request *object.Get
 	return c.next.GetRangeHash(ctx, request)
 }
 
+func (c TransportSplitter) Patch(ctx context.Context) (PatchObjectStream, error) {
+	return c.next.Patch(ctx)
+}
+
 func (s *searchStreamMsgSizeCtrl) Send(resp *object.SearchResponse) error {
 	body := resp.GetBody()
 	ids := body.GetIDList()
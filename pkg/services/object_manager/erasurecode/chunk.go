@@ -0,0 +1,143 @@
+package erasurecode
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Attributes of the chunk object.
+const (
+	// AttributeParent contains identifier of the original object.
+	AttributeParent = objectV2.SysAttributePrefix + "EC_PARENT"
+	// AttributeIndex contains chunk index in [0; Scheme.Total()) range.
+	// Chunks with index less than Scheme.DataCount() contain data.
+	AttributeIndex = objectV2.SysAttributePrefix + "EC_INDEX"
+	// AttributeScheme contains erasure coding scheme in `<data>.<parity>` format.
+	AttributeScheme = objectV2.SysAttributePrefix + "EC_SCHEME"
+	// AttributeParentHeader contains base64-encoded binary header of the
+	// original object.
+	AttributeParentHeader = objectV2.SysAttributePrefix + "EC_PARENT_HEADER"
+)
+
+// ErrNotChunk is returned when object is expected to be a chunk but it is not.
+var ErrNotChunk = errors.New("object is not an erasure-coded chunk")
+
+// Info groups erasure coding information about the chunk.
+type Info struct {
+	parent oid.ID
+	index  int
+	scheme Scheme
+}
+
+// Parent returns identifier of the original object.
+func (x Info) Parent() oid.ID {
+	return x.parent
+}
+
+// Index returns chunk index.
+func (x Info) Index() int {
+	return x.index
+}
+
+// Scheme returns erasure coding scheme used for the chunk.
+func (x Info) Scheme() Scheme {
+	return x.scheme
+}
+
+// IsChunk checks whether obj is an erasure-coded chunk of some other object.
+func IsChunk(obj *objectSDK.Object) bool {
+	for _, a := range obj.Attributes() {
+		if a.Key() == AttributeParent {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InfoFromObject reads erasure coding information from the chunk header.
+// Returns ErrNotChunk if obj is not a chunk.
+func InfoFromObject(obj *objectSDK.Object) (Info, error) {
+	var (
+		res                       Info
+		par, idx, sch             string
+		hasPar, hasIdx, hasScheme bool
+	)
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeParent:
+			par, hasPar = a.Value(), true
+		case AttributeIndex:
+			idx, hasIdx = a.Value(), true
+		case AttributeScheme:
+			sch, hasScheme = a.Value(), true
+		}
+	}
+
+	if !hasPar {
+		return res, ErrNotChunk
+	} else if !hasIdx || !hasScheme {
+		return res, fmt.Errorf("incomplete chunk header: missing %s or %s", AttributeIndex, AttributeScheme)
+	}
+
+	if err := res.parent.DecodeString(par); err != nil {
+		return res, fmt.Errorf("invalid parent ID: %w", err)
+	}
+
+	var err error
+	if res.scheme, err = ParseScheme(sch); err != nil {
+		return res, err
+	}
+
+	res.index, err = strconv.Atoi(idx)
+	if err != nil {
+		return res, fmt.Errorf("invalid chunk index: %w", err)
+	} else if res.index < 0 || res.index >= res.scheme.Total() {
+		return res, fmt.Errorf("chunk index %d is out of [0; %d) range", res.index, res.scheme.Total())
+	}
+
+	return res, nil
+}
+
+// ParentHeader returns header of the original object stored in the chunk.
+func ParentHeader(chunk *objectSDK.Object) (*objectSDK.Object, error) {
+	for _, a := range chunk.Attributes() {
+		if a.Key() != AttributeParentHeader {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(a.Value())
+		if err != nil {
+			return nil, fmt.Errorf("decode parent header: %w", err)
+		}
+
+		par := objectSDK.New()
+		if err := par.Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("unmarshal parent header: %w", err)
+		}
+
+		return par, nil
+	}
+
+	return nil, ErrNotChunk
+}
+
+// SearchFilters returns search filters to select chunks of the parent object.
+// If index is negative, chunks with any index are selected.
+func SearchFilters(parent oid.ID, index int) objectSDK.SearchFilters {
+	var fs objectSDK.SearchFilters
+	fs.AddFilter(AttributeParent, parent.EncodeToString(), objectSDK.MatchStringEqual)
+
+	if index >= 0 {
+		fs.AddFilter(AttributeIndex, strconv.Itoa(index), objectSDK.MatchStringEqual)
+	}
+
+	return fs
+}
@@ -0,0 +1,301 @@
+package erasurecode
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/checksum"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"github.com/TrueCloudLab/frostfs-sdk-go/version"
+	"github.com/klauspost/reedsolomon"
+)
+
+// ChunkPrm groups parameters of the chunk objects formation.
+type ChunkPrm struct {
+	// Key is used to sign chunk objects, chunk owner is derived from it.
+	Key *ecdsa.PrivateKey
+
+	// Epoch is a creation epoch of the chunks.
+	Epoch uint64
+
+	// WithoutHomomorphicHash disables homomorphic hash calculation.
+	WithoutHomomorphicHash bool
+}
+
+var errNotEnoughChunks = errors.New("not enough chunks to restore the object")
+
+// Applicable checks whether object must be stored in erasure-coded form in
+// the container with erasure coding enabled. Objects without payload, linking
+// objects, non-regular objects and chunks themselves are stored as is.
+func Applicable(obj *objectSDK.Object) bool {
+	return obj.Type() == objectSDK.TypeRegular &&
+		obj.PayloadSize() > 0 &&
+		len(obj.Children()) == 0 &&
+		!IsChunk(obj)
+}
+
+// Encode splits parent object payload into data chunks, calculates parity
+// chunks and returns all of them as signed objects ordered by index.
+func Encode(s Scheme, parent *objectSDK.Object, prm ChunkPrm) ([]*objectSDK.Object, error) {
+	enc, err := reedsolomon.New(s.data, s.parity)
+	if err != nil {
+		return nil, fmt.Errorf("init Reed-Solomon encoder: %w", err)
+	}
+
+	shards, err := enc.Split(parent.Payload())
+	if err != nil {
+		return nil, fmt.Errorf("split payload: %w", err)
+	}
+
+	if err := enc.Encode(shards); err != nil {
+		return nil, fmt.Errorf("calculate parity: %w", err)
+	}
+
+	f, err := newChunkFormer(s, parent, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*objectSDK.Object, len(shards))
+	for i := range shards {
+		if res[i], err = f.form(i, shards[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// Reconstruct restores missing chunks. Chunks must have Scheme.Total() length,
+// missing chunks are represented by nil elements. At least Scheme.DataCount()
+// chunks must be present.
+//
+// Returns restored chunk objects only, present chunks are left untouched.
+func Reconstruct(s Scheme, chunks []*objectSDK.Object, prm ChunkPrm) ([]*objectSDK.Object, error) {
+	parent, shards, err := shardsFromChunks(s, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := reedsolomon.New(s.data, s.parity)
+	if err != nil {
+		return nil, fmt.Errorf("init Reed-Solomon encoder: %w", err)
+	}
+
+	if err := enc.Reconstruct(shards); err != nil {
+		return nil, fmt.Errorf("reconstruct chunks: %w", err)
+	}
+
+	f, err := newChunkFormer(s, parent, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*objectSDK.Object
+	for i := range chunks {
+		if chunks[i] != nil {
+			continue
+		}
+
+		chunk, err := f.form(i, shards[i])
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, chunk)
+	}
+
+	return res, nil
+}
+
+// Decode restores original object from the chunks. Chunks must have
+// Scheme.Total() length, missing chunks are represented by nil elements.
+// At least Scheme.DataCount() chunks must be present.
+//
+// Payload checksum of the restored object is verified.
+func Decode(s Scheme, chunks []*objectSDK.Object) (*objectSDK.Object, error) {
+	parent, shards, err := shardsFromChunks(s, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := reedsolomon.New(s.data, s.parity)
+	if err != nil {
+		return nil, fmt.Errorf("init Reed-Solomon encoder: %w", err)
+	}
+
+	if err := enc.ReconstructData(shards); err != nil {
+		return nil, fmt.Errorf("reconstruct data chunks: %w", err)
+	}
+
+	sz := parent.PayloadSize()
+
+	var buf bytes.Buffer
+	buf.Grow(int(sz))
+
+	if err := enc.Join(&buf, shards, int(sz)); err != nil {
+		return nil, fmt.Errorf("join data chunks: %w", err)
+	}
+
+	payload := buf.Bytes()
+
+	if cs, ok := parent.PayloadChecksum(); ok {
+		var actual checksum.Checksum
+		checksum.Calculate(&actual, cs.Type(), payload)
+
+		if !bytes.Equal(actual.Value(), cs.Value()) {
+			return nil, errors.New("payload checksum mismatch of the restored object")
+		}
+	}
+
+	parent.SetPayload(payload)
+
+	return parent, nil
+}
+
+// shardsFromChunks checks chunks consistency and returns parent header along
+// with chunk payloads.
+func shardsFromChunks(s Scheme, chunks []*objectSDK.Object) (*objectSDK.Object, [][]byte, error) {
+	if len(chunks) != s.Total() {
+		return nil, nil, fmt.Errorf("invalid number of chunks: expected %d, got %d", s.Total(), len(chunks))
+	}
+
+	var (
+		parent   *objectSDK.Object
+		parentID oid.ID
+		present  int
+		shardSz  = -1
+		shards   = make([][]byte, len(chunks))
+	)
+
+	for i := range chunks {
+		if chunks[i] == nil {
+			continue
+		}
+
+		info, err := InfoFromObject(chunks[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if info.Index() != i {
+			return nil, nil, fmt.Errorf("chunk with index %d at position %d", info.Index(), i)
+		} else if info.Scheme() != s {
+			return nil, nil, fmt.Errorf("chunk %d has scheme %s, expected %s", i, info.Scheme(), s)
+		}
+
+		if parent == nil {
+			if parent, err = ParentHeader(chunks[i]); err != nil {
+				return nil, nil, err
+			}
+
+			parentID = info.Parent()
+		} else if !parentID.Equals(info.Parent()) {
+			return nil, nil, fmt.Errorf("chunk %d belongs to another object %s", i, info.Parent())
+		}
+
+		payload := chunks[i].Payload()
+		if shardSz < 0 {
+			shardSz = len(payload)
+		} else if len(payload) != shardSz {
+			return nil, nil, fmt.Errorf("chunk %d has size %d, expected %d", i, len(payload), shardSz)
+		}
+
+		shards[i] = payload
+		present++
+	}
+
+	if present < s.data {
+		return nil, nil, fmt.Errorf("%w: %d of %d", errNotEnoughChunks, present, s.data)
+	}
+
+	return parent, shards, nil
+}
+
+type chunkFormer struct {
+	prm ChunkPrm
+
+	parent *objectSDK.Object
+
+	common []objectSDK.Attribute
+}
+
+func newChunkFormer(s Scheme, parent *objectSDK.Object, prm ChunkPrm) (*chunkFormer, error) {
+	parentID, ok := parent.ID()
+	if !ok {
+		return nil, errors.New("missing parent object ID")
+	}
+
+	hdr, err := parent.CutPayload().Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal parent header: %w", err)
+	}
+
+	common := make([]objectSDK.Attribute, 3, 4)
+	common[0].SetKey(AttributeParent)
+	common[0].SetValue(parentID.EncodeToString())
+	common[1].SetKey(AttributeScheme)
+	common[1].SetValue(s.String())
+	common[2].SetKey(AttributeParentHeader)
+	common[2].SetValue(base64.StdEncoding.EncodeToString(hdr))
+
+	// chunks must expire together with the original object
+	for _, a := range parent.Attributes() {
+		if a.Key() == objectV2.SysAttributeExpEpoch {
+			common = append(common, a)
+			break
+		}
+	}
+
+	return &chunkFormer{
+		prm:    prm,
+		parent: parent,
+		common: common,
+	}, nil
+}
+
+func (f *chunkFormer) form(index int, payload []byte) (*objectSDK.Object, error) {
+	var owner user.ID
+	user.IDFromKey(&owner, f.prm.Key.PublicKey)
+
+	ver := version.Current()
+	cnr, _ := f.parent.ContainerID()
+
+	attrs := make([]objectSDK.Attribute, len(f.common)+1)
+	copy(attrs, f.common)
+	attrs[len(f.common)].SetKey(AttributeIndex)
+	attrs[len(f.common)].SetValue(strconv.Itoa(index))
+
+	chunk := objectSDK.New()
+	chunk.SetVersion(&ver)
+	chunk.SetContainerID(cnr)
+	chunk.SetOwnerID(&owner)
+	chunk.SetType(objectSDK.TypeRegular)
+	chunk.SetCreationEpoch(f.prm.Epoch)
+	chunk.SetAttributes(attrs...)
+	chunk.SetPayload(payload)
+	chunk.SetPayloadSize(uint64(len(payload)))
+
+	var cs checksum.Checksum
+
+	checksum.Calculate(&cs, checksum.SHA256, payload)
+	chunk.SetPayloadChecksum(cs)
+
+	if !f.prm.WithoutHomomorphicHash {
+		checksum.Calculate(&cs, checksum.TZ, payload)
+		chunk.SetPayloadHomomorphicHash(cs)
+	}
+
+	if err := objectSDK.SetIDWithSignature(*f.prm.Key, chunk); err != nil {
+		return nil, fmt.Errorf("finalize chunk %d: %w", index, err)
+	}
+
+	return chunk, nil
+}
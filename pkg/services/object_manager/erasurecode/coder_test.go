@@ -0,0 +1,133 @@
+package erasurecode
+
+import (
+	"crypto/rand"
+	"testing"
+
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheme(t *testing.T) {
+	s, err := ParseScheme("4.2")
+	require.NoError(t, err)
+	require.Equal(t, 4, s.DataCount())
+	require.Equal(t, 2, s.ParityCount())
+	require.Equal(t, 6, s.Total())
+	require.Equal(t, "4.2", s.String())
+
+	for _, v := range []string{"", "4", "4.", ".2", "0.2", "4.0", "-1.2", "a.b", "200.100"} {
+		_, err := ParseScheme(v)
+		require.ErrorIs(t, err, ErrInvalidScheme, v)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	s, err := NewScheme(3, 2)
+	require.NoError(t, err)
+
+	parent := testParent(t, pk, 1001)
+	prm := ChunkPrm{Key: &pk.PrivateKey, Epoch: 10}
+
+	chunks, err := Encode(s, parent, prm)
+	require.NoError(t, err)
+	require.Len(t, chunks, s.Total())
+
+	parentID, _ := parent.ID()
+	for i := range chunks {
+		require.True(t, IsChunk(chunks[i]))
+		require.False(t, Applicable(chunks[i]))
+		require.NoError(t, objectSDK.CheckHeaderVerificationFields(chunks[i]))
+
+		info, err := InfoFromObject(chunks[i])
+		require.NoError(t, err)
+		require.Equal(t, i, info.Index())
+		require.Equal(t, s, info.Scheme())
+		require.Equal(t, parentID, info.Parent())
+	}
+
+	t.Run("decode from any data count chunks", func(t *testing.T) {
+		part := make([]*objectSDK.Object, len(chunks))
+		copy(part, chunks)
+		part[0], part[3] = nil, nil
+
+		res, err := Decode(s, part)
+		require.NoError(t, err)
+		require.Equal(t, parent.Payload(), res.Payload())
+
+		resID, _ := res.ID()
+		require.Equal(t, parentID, resID)
+		require.NoError(t, objectSDK.CheckHeaderVerificationFields(res))
+	})
+
+	t.Run("not enough chunks", func(t *testing.T) {
+		part := make([]*objectSDK.Object, len(chunks))
+		copy(part, chunks)
+		part[0], part[2], part[4] = nil, nil, nil
+
+		_, err := Decode(s, part)
+		require.ErrorIs(t, err, errNotEnoughChunks)
+	})
+
+	t.Run("reconstruct", func(t *testing.T) {
+		part := make([]*objectSDK.Object, len(chunks))
+		copy(part, chunks)
+		part[1], part[4] = nil, nil
+
+		restored, err := Reconstruct(s, part, prm)
+		require.NoError(t, err)
+		require.Len(t, restored, 2)
+		require.Equal(t, chunks[1].Payload(), restored[0].Payload())
+		require.Equal(t, chunks[4].Payload(), restored[1].Payload())
+	})
+
+	t.Run("corrupted chunk", func(t *testing.T) {
+		part := make([]*objectSDK.Object, len(chunks))
+		copy(part, chunks)
+		part[3], part[4] = nil, nil
+
+		bad := objectSDK.NewFromV2(chunks[0].ToV2())
+		payload := append([]byte{}, bad.Payload()...)
+		payload[0]++
+		bad.SetPayload(payload)
+		part[0] = bad
+
+		_, err := Decode(s, part)
+		require.Error(t, err)
+	})
+}
+
+func TestApplicable(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	require.True(t, Applicable(testParent(t, pk, 1)))
+	require.False(t, Applicable(testParent(t, pk, 0)))
+
+	ts := testParent(t, pk, 10)
+	ts.SetType(objectSDK.TypeTombstone)
+	require.False(t, Applicable(ts))
+}
+
+func testParent(t *testing.T, pk *keys.PrivateKey, size int) *objectSDK.Object {
+	payload := make([]byte, size)
+	_, _ = rand.Read(payload)
+
+	owner := usertest.ID()
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwnerID(owner)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(size))
+	objectSDK.CalculateAndSetPayloadChecksum(obj)
+	require.NoError(t, objectSDK.SetIDWithSignature(pk.PrivateKey, obj))
+
+	return obj
+}
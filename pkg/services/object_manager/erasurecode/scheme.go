@@ -0,0 +1,94 @@
+package erasurecode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	containerV2 "github.com/TrueCloudLab/frostfs-api-go/v2/container"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container"
+)
+
+// ContainerAttribute is a container attribute enabling erasure coding
+// of the container objects. Attribute value has `<data>.<parity>` format,
+// e.g. `4.2` means that every object is split into 4 data chunks and
+// 2 parity chunks are calculated for them.
+const ContainerAttribute = containerV2.SysAttributePrefix + "ERASURE_CODING"
+
+// MaxTotalChunks is the maximum supported total number of chunks.
+const MaxTotalChunks = 256
+
+// ErrInvalidScheme is returned when erasure coding scheme is malformed.
+var ErrInvalidScheme = errors.New("invalid erasure coding scheme")
+
+// Scheme describes Reed-Solomon coding parameters.
+type Scheme struct {
+	data, parity int
+}
+
+// NewScheme creates new Scheme with the given number of data and parity chunks.
+func NewScheme(data, parity int) (Scheme, error) {
+	s := Scheme{data: data, parity: parity}
+	if data <= 0 || parity <= 0 || data+parity > MaxTotalChunks {
+		return Scheme{}, fmt.Errorf("%w: %s", ErrInvalidScheme, s)
+	}
+
+	return s, nil
+}
+
+// ParseScheme parses Scheme from the `<data>.<parity>` string.
+func ParseScheme(s string) (Scheme, error) {
+	d, p, ok := strings.Cut(s, ".")
+	if !ok {
+		return Scheme{}, fmt.Errorf("%w: %q", ErrInvalidScheme, s)
+	}
+
+	data, err := strconv.Atoi(d)
+	if err != nil {
+		return Scheme{}, fmt.Errorf("%w: data chunks: %v", ErrInvalidScheme, err)
+	}
+
+	parity, err := strconv.Atoi(p)
+	if err != nil {
+		return Scheme{}, fmt.Errorf("%w: parity chunks: %v", ErrInvalidScheme, err)
+	}
+
+	return NewScheme(data, parity)
+}
+
+// SchemeFromContainer returns erasure coding scheme of the container.
+// Returns false if erasure coding is disabled for the container.
+func SchemeFromContainer(cnr container.Container) (Scheme, bool, error) {
+	v := cnr.Attribute(ContainerAttribute)
+	if v == "" {
+		return Scheme{}, false, nil
+	}
+
+	s, err := ParseScheme(v)
+	if err != nil {
+		return Scheme{}, false, err
+	}
+
+	return s, true, nil
+}
+
+// DataCount returns number of data chunks.
+func (s Scheme) DataCount() int {
+	return s.data
+}
+
+// ParityCount returns number of parity chunks.
+func (s Scheme) ParityCount() int {
+	return s.parity
+}
+
+// Total returns total number of chunks.
+func (s Scheme) Total() int {
+	return s.data + s.parity
+}
+
+// String implements fmt.Stringer.
+func (s Scheme) String() string {
+	return strconv.Itoa(s.data) + "." + strconv.Itoa(s.parity)
+}
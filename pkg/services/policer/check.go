@@ -8,6 +8,7 @@ import (
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	headsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/head"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
 	"github.com/TrueCloudLab/frostfs-sdk-go/client"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
@@ -93,6 +94,18 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 		return
 	}
 
+	if p.chunkReader != nil {
+		scheme, ok, err := erasurecode.SchemeFromContainer(cnr.Value)
+		if err != nil {
+			p.log.Error("invalid container erasure coding scheme",
+				zap.Stringer("cid", idCnr),
+				zap.String("error", err.Error()),
+			)
+		} else if ok && p.processECChunk(ctx, addrWithType, cnr, scheme) {
+			return
+		}
+	}
+
 	policy := cnr.Value.PlacementPolicy()
 
 	nn, err := p.placementBuilder.BuildPlacement(idCnr, &idObj, policy)
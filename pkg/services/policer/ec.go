@@ -0,0 +1,309 @@
+package policer
+
+import (
+	"context"
+	"errors"
+
+	containercore "github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	headsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/head"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
+	"github.com/TrueCloudLab/frostfs-sdk-go/client"
+	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// processECChunk checks placement of the erasure-coded chunk. Chunk with
+// index i must be stored on the i-th node of the parent object placement.
+// Holder of the first present chunk restores missing chunks of the object.
+//
+// Returns false if the object is not a chunk and must be processed as usual.
+func (p *Policer) processECChunk(ctx context.Context, addrWithType objectcore.AddressWithType,
+	cnr *containercore.Container, scheme erasurecode.Scheme) bool {
+	addr := addrWithType.Address
+
+	if addrWithType.Type != object.TypeRegular {
+		return false
+	}
+
+	hdr, err := engine.Head(ctx, p.jobQueue.localStorage, addr)
+	if err != nil {
+		p.log.Error("could not get local object header",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+
+		// object is processed at the next cycle
+		return true
+	}
+
+	info, err := erasurecode.InfoFromObject(hdr)
+	if err != nil {
+		if !errors.Is(err, erasurecode.ErrNotChunk) {
+			p.log.Error("invalid erasure-coded chunk",
+				zap.Stringer("object", addr),
+				zap.String("error", err.Error()),
+			)
+
+			return true
+		}
+
+		return false
+	}
+
+	parentID := info.Parent()
+
+	nn, err := p.placementBuilder.BuildPlacement(addr.Container(), &parentID, cnr.Value.PlacementPolicy())
+	if err != nil {
+		p.log.Error("could not build placement vector for object",
+			zap.Stringer("cid", addr.Container()),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	var nodes []netmap.NodeInfo
+	for i := range nn {
+		nodes = append(nodes, nn[i]...)
+	}
+
+	if len(nodes) == 0 {
+		return true
+	}
+
+	holder := nodes[info.Index()%len(nodes)]
+
+	if !p.netmapKeys.IsLocalKey(holder.PublicKey()) {
+		p.moveChunk(ctx, addr, holder)
+		return true
+	}
+
+	var parent oid.Address
+	parent.SetContainer(addr.Container())
+	parent.SetObject(parentID)
+
+	getLocal := func() (*object.Object, error) {
+		return engine.Get(ctx, p.jobQueue.localStorage, addr)
+	}
+
+	p.restoreChunks(ctx, getLocal, parent, info, nodes, !containerSDK.IsHomomorphicHashingDisabled(cnr.Value))
+
+	return true
+}
+
+// moveChunk replicates the chunk to its holder and drops the local copy once
+// the holder stores it.
+func (p *Policer) moveChunk(ctx context.Context, addr oid.Address, holder netmap.NodeInfo) {
+	if holder.IsMaintenance() {
+		p.log.Debug("chunk holder is under maintenance, keep local chunk",
+			zap.Stringer("object", addr),
+		)
+
+		return
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+
+	_, err := p.remoteHeader.Head(callCtx, new(headsvc.RemoteHeadPrm).
+		WithObjectAddress(addr).
+		WithNodeInfo(holder))
+
+	cancel()
+
+	switch {
+	case err == nil:
+		p.log.Info("redundant local chunk copy detected",
+			zap.Stringer("object", addr),
+		)
+
+		p.cbRedundantCopy(addr)
+	case client.IsErrObjectNotFound(err):
+		p.log.Debug("chunk is not stored on its holder, replicate",
+			zap.Stringer("object", addr),
+		)
+
+		var task replicator.Task
+		task.SetObjectAddress(addr)
+		task.SetNodes([]netmap.NodeInfo{holder})
+		task.SetCopiesNumber(1)
+
+		p.replicator.HandleTask(ctx, task, newNodeCache())
+	default:
+		p.log.Error("receive chunk header from its holder",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+	}
+}
+
+// restoreChunks restores chunks of the parent object missing on their holders.
+// Only the holder of the first present chunk restores the object to prevent
+// concurrent restoring on several nodes. The local chunk is read via getLocal.
+func (p *Policer) restoreChunks(ctx context.Context, getLocal func() (*object.Object, error), parent oid.Address, info erasurecode.Info,
+	nodes []netmap.NodeInfo, withHomomorphicHash bool) {
+	var (
+		scheme  = info.Scheme()
+		chunks  = make([]*object.Object, scheme.Total())
+		stored  = make([]oid.ID, scheme.Total())
+		present = make([]bool, scheme.Total())
+		missing int
+	)
+
+	for i := 0; i < scheme.Total(); i++ {
+		if i == info.Index() {
+			present[i] = true
+			continue
+		}
+
+		holder := nodes[i%len(nodes)]
+		if holder.IsMaintenance() {
+			// consider chunks on nodes under maintenance as OK
+			// to prevent spam with restored chunks
+			present[i] = true
+			continue
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+
+		ids, err := p.chunkReader.Search(callCtx, holder, parent, i)
+
+		cancel()
+
+		if err != nil {
+			p.log.Error("could not search for erasure-coded chunk",
+				zap.Stringer("object", parent),
+				zap.Int("index", i),
+				zap.String("error", err.Error()),
+			)
+
+			// unknown status, do not restore
+			return
+		}
+
+		if len(ids) == 0 {
+			missing++
+			continue
+		}
+
+		if i < info.Index() {
+			// chunk with the lower index is present,
+			// its holder is responsible for restoring
+			return
+		}
+
+		present[i] = true
+		stored[i] = ids[0]
+	}
+
+	if missing == 0 {
+		return
+	}
+
+	p.log.Debug("shortage of erasure-coded chunks detected",
+		zap.Stringer("object", parent),
+		zap.Int("missing", missing),
+	)
+
+	var found int
+
+	for i := 0; i < scheme.Total() && found < scheme.DataCount(); i++ {
+		if !present[i] {
+			continue
+		}
+
+		var (
+			chunk *object.Object
+			err   error
+			addr  oid.Address
+		)
+
+		addr.SetContainer(parent.Container())
+
+		if i == info.Index() {
+			chunk, err = getLocal()
+		} else if !stored[i].Equals(oid.ID{}) {
+			addr.SetObject(stored[i])
+
+			callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+
+			chunk, err = p.chunkReader.Get(callCtx, nodes[i%len(nodes)], addr)
+
+			cancel()
+		} else {
+			continue
+		}
+
+		if err != nil {
+			p.log.Error("could not read erasure-coded chunk",
+				zap.Stringer("object", parent),
+				zap.Int("index", i),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		chunks[i] = chunk
+		found++
+	}
+
+	if found < scheme.DataCount() {
+		p.log.Error("not enough erasure-coded chunks to restore the object",
+			zap.Stringer("object", parent),
+			zap.Int("found", found),
+			zap.Int("required", scheme.DataCount()),
+		)
+
+		return
+	}
+
+	key, err := p.keyStorage.GetKey(nil)
+	if err != nil {
+		p.log.Error("could not receive node key",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	restored, err := erasurecode.Reconstruct(scheme, chunks, erasurecode.ChunkPrm{
+		Key:                    key,
+		Epoch:                  p.netState.CurrentEpoch(),
+		WithoutHomomorphicHash: !withHomomorphicHash,
+	})
+	if err != nil {
+		p.log.Error("could not restore erasure-coded chunks",
+			zap.Stringer("object", parent),
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	for i := range restored {
+		rInfo, err := erasurecode.InfoFromObject(restored[i])
+		if err != nil || present[rInfo.Index()] {
+			continue
+		}
+
+		var addr oid.Address
+
+		id, _ := restored[i].ID()
+		addr.SetContainer(parent.Container())
+		addr.SetObject(id)
+
+		var task replicator.Task
+		task.SetObjectAddress(addr)
+		task.SetObject(restored[i])
+		task.SetNodes([]netmap.NodeInfo{nodes[rInfo.Index()%len(nodes)]})
+		task.SetCopiesNumber(1)
+
+		p.replicator.HandleTask(ctx, task, newNodeCache())
+	}
+}
@@ -0,0 +1,189 @@
+package policer
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/erasurecode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	netmaptest "github.com/TrueCloudLab/frostfs-sdk-go/netmap/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+type testEpochState uint64
+
+func (s testEpochState) CurrentEpoch() uint64 {
+	return uint64(s)
+}
+
+// testChunkReader serves chunks stored on the nodes with the given public keys.
+type testChunkReader struct {
+	chunks map[string][]*objectSDK.Object
+
+	searchErr error
+}
+
+func (r *testChunkReader) Search(_ context.Context, node netmap.NodeInfo, parent oid.Address, index int) ([]oid.ID, error) {
+	if r.searchErr != nil {
+		return nil, r.searchErr
+	}
+
+	var res []oid.ID
+
+	for _, chunk := range r.chunks[string(node.PublicKey())] {
+		info, err := erasurecode.InfoFromObject(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		if info.Parent().Equals(parent.Object()) && (index < 0 || info.Index() == index) {
+			id, _ := chunk.ID()
+			res = append(res, id)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *testChunkReader) Get(_ context.Context, node netmap.NodeInfo, addr oid.Address) (*objectSDK.Object, error) {
+	for _, chunk := range r.chunks[string(node.PublicKey())] {
+		if objectcore.AddressOf(chunk) == addr {
+			return chunk, nil
+		}
+	}
+
+	return nil, errors.New("chunk not found")
+}
+
+type testReplicator struct {
+	tasks []replicator.Task
+}
+
+func (r *testReplicator) HandleTask(_ context.Context, task replicator.Task, _ replicator.TaskResult) {
+	r.tasks = append(r.tasks, task)
+}
+
+func TestPolicer_RestoreChunks(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	scheme, err := erasurecode.NewScheme(2, 2)
+	require.NoError(t, err)
+
+	payload := make([]byte, 1001)
+	_, _ = rand.Read(payload)
+
+	parent := objectSDK.New()
+	parent.SetContainerID(cidtest.ID())
+	parent.SetPayload(payload)
+	parent.SetPayloadSize(uint64(len(payload)))
+	objectSDK.CalculateAndSetPayloadChecksum(parent)
+	require.NoError(t, objectSDK.SetIDWithSignature(pk.PrivateKey, parent))
+
+	chunks, err := erasurecode.Encode(scheme, parent, erasurecode.ChunkPrm{Key: &pk.PrivateKey})
+	require.NoError(t, err)
+
+	parentAddr := objectcore.AddressOf(parent)
+
+	nodes := make([]netmap.NodeInfo, scheme.Total())
+	for i := range nodes {
+		nodes[i] = netmaptest.NodeInfo()
+	}
+
+	// newPolicer returns Policer which finds the chunks with the given
+	// indexes on their remote holders.
+	newPolicer := func(remote ...int) (*Policer, *testReplicator, *testChunkReader) {
+		reader := &testChunkReader{chunks: make(map[string][]*objectSDK.Object)}
+		for _, i := range remote {
+			key := string(nodes[i].PublicKey())
+			reader.chunks[key] = append(reader.chunks[key], chunks[i])
+		}
+
+		repl := new(testReplicator)
+
+		p := New(
+			WithLogger(test.NewLogger(false)),
+			WithHeadTimeout(time.Second),
+			WithRemoteChunkReader(reader),
+			WithReplicator(repl),
+			WithKeyStorage(util.NewKeyStorage(&pk.PrivateKey, nil, nil)),
+			WithNetworkState(testEpochState(10)),
+		)
+
+		return p, repl, reader
+	}
+
+	restore := func(p *Policer, local int) {
+		info, err := erasurecode.InfoFromObject(chunks[local])
+		require.NoError(t, err)
+
+		getLocal := func() (*objectSDK.Object, error) {
+			return chunks[local], nil
+		}
+
+		p.restoreChunks(context.Background(), getLocal, parentAddr, info, nodes, true)
+	}
+
+	t.Run("missing chunks", func(t *testing.T) {
+		p, repl, _ := newPolicer(2)
+
+		restore(p, 0)
+
+		require.Len(t, repl.tasks, 2)
+
+		for i, idx := range []int{1, 3} {
+			restored := repl.tasks[i].Object()
+			require.NotNil(t, restored)
+
+			info, err := erasurecode.InfoFromObject(restored)
+			require.NoError(t, err)
+			require.Equal(t, idx, info.Index())
+			require.Equal(t, chunks[idx].Payload(), restored.Payload())
+			require.Equal(t, []netmap.NodeInfo{nodes[idx]}, repl.tasks[i].Nodes())
+		}
+	})
+
+	t.Run("all chunks present", func(t *testing.T) {
+		p, repl, _ := newPolicer(1, 2, 3)
+
+		restore(p, 0)
+
+		require.Empty(t, repl.tasks)
+	})
+
+	t.Run("lower chunk present", func(t *testing.T) {
+		p, repl, _ := newPolicer(1)
+
+		restore(p, 2)
+
+		require.Empty(t, repl.tasks, "holder of the first present chunk must restore the object")
+	})
+
+	t.Run("not enough chunks", func(t *testing.T) {
+		p, repl, _ := newPolicer()
+
+		restore(p, 0)
+
+		require.Empty(t, repl.tasks)
+	})
+
+	t.Run("search failure", func(t *testing.T) {
+		p, repl, reader := newPolicer(2)
+		reader.searchErr = errors.New("any error")
+
+		restore(p, 0)
+
+		require.Empty(t, repl.tasks, "chunks with unknown status must not be restored")
+	})
+}
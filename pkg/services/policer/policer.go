@@ -1,6 +1,7 @@
 package policer
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -8,9 +9,12 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	headsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/head"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	netmapSDK "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/panjf2000/ants/v2"
@@ -23,6 +27,22 @@ type nodeLoader interface {
 	ObjectServiceLoad() float64
 }
 
+// remoteChunkReader reads erasure-coded chunks from the remote nodes.
+type remoteChunkReader interface {
+	// Search returns identifiers of the chunks with the given index of
+	// the parent object stored on the node. Negative index means any chunk.
+	Search(ctx context.Context, node netmapSDK.NodeInfo, parent oid.Address, index int) ([]oid.ID, error)
+	// Get reads the chunk object from the node.
+	Get(ctx context.Context, node netmapSDK.NodeInfo, addr oid.Address) (*objectSDK.Object, error)
+}
+
+// taskReplicator replicates objects to the remote nodes.
+type taskReplicator interface {
+	// HandleTask replicates the object according to the task and
+	// submits the nodes that accepted the object to res.
+	HandleTask(ctx context.Context, task replicator.Task, res replicator.TaskResult)
+}
+
 type objectsInWork struct {
 	m    sync.RWMutex
 	objs map[oid.Address]struct{}
@@ -78,9 +98,15 @@ type cfg struct {
 
 	remoteHeader *headsvc.RemoteHeader
 
+	chunkReader remoteChunkReader
+
+	keyStorage *util.KeyStorage
+
+	netState netmap.State
+
 	netmapKeys netmap.AnnouncedKeys
 
-	replicator *replicator.Replicator
+	replicator taskReplicator
 
 	cbRedundantCopy RedundantCopyCallback
 
@@ -171,6 +197,28 @@ func WithRemoteHeader(v *headsvc.RemoteHeader) Option {
 	}
 }
 
+// WithRemoteChunkReader returns option to set erasure-coded chunk reader of Policer.
+func WithRemoteChunkReader(v remoteChunkReader) Option {
+	return func(c *cfg) {
+		c.chunkReader = v
+	}
+}
+
+// WithKeyStorage returns option to set local private key storage.
+// Node key is used to sign restored erasure-coded chunks.
+func WithKeyStorage(v *util.KeyStorage) Option {
+	return func(c *cfg) {
+		c.keyStorage = v
+	}
+}
+
+// WithNetworkState returns option to set current network state.
+func WithNetworkState(v netmap.State) Option {
+	return func(c *cfg) {
+		c.netState = v
+	}
+}
+
 // WithNetmapKeys returns option to set tool to work with announced public keys.
 func WithNetmapKeys(v netmap.AnnouncedKeys) Option {
 	return func(c *cfg) {
@@ -179,7 +227,7 @@ func WithNetmapKeys(v netmap.AnnouncedKeys) Option {
 }
 
 // WithReplicator returns option to set object replicator of Policer.
-func WithReplicator(v *replicator.Replicator) Option {
+func WithReplicator(v taskReplicator) Option {
 	return func(c *cfg) {
 		c.replicator = v
 	}
@@ -27,7 +27,7 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 
 	if task.obj == nil {
 		var err error
-		task.obj, err = engine.Get(p.localStorage, task.addr)
+		task.obj, err = engine.Get(ctx, p.localStorage, task.addr)
 		if err != nil {
 			p.log.Error("could not get object from local storage",
 				zap.Stringer("object", task.addr),
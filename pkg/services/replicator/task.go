@@ -36,3 +36,13 @@ func (t *Task) SetObject(obj *objectSDK.Object) {
 func (t *Task) SetNodes(v []netmap.NodeInfo) {
 	t.nodes = v
 }
+
+// Object returns object set by SetObject.
+func (t Task) Object() *objectSDK.Object {
+	return t.obj
+}
+
+// Nodes returns a list of potential object holders set by SetNodes.
+func (t Task) Nodes() []netmap.NodeInfo {
+	return t.nodes
+}
@@ -0,0 +1,144 @@
+package tree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+)
+
+var errEmptyBatch = errors.New("batch contains no operations")
+
+// Batch applies client operations to the specified tree atomically and pushes
+// them in queue for replication on other nodes.
+func (s *Service) Batch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return nil, err
+	}
+
+	err := s.verifyClient(req, cid, b.GetBearerToken(), acl.OpObjectPut)
+	if err != nil {
+		return nil, err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return nil, err
+	}
+	if pos < 0 {
+		var resp *BatchResponse
+		var outErr error
+		err = s.forEachNode(ctx, ns, func(c TreeServiceClient) bool {
+			resp, outErr = c.Batch(ctx, req)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp, outErr
+	}
+
+	ops, err := protoToBatch(b.GetOperations())
+	if err != nil {
+		return nil, err
+	}
+
+	d := pilorama.CIDDescriptor{CID: cid, Position: pos, Size: len(ns)}
+	logs, err := s.forest.TreeBatch(d, b.GetTreeId(), ops)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []*pilorama.Move
+	for i := range logs {
+		for j := range logs[i] {
+			s.watchers.notify(cid, b.GetTreeId(), &logs[i][j])
+			applied = append(applied, &logs[i][j])
+		}
+	}
+	s.pushToQueue(cid, b.GetTreeId(), applied...)
+
+	return &BatchResponse{
+		Body: &BatchResponse_Body{
+			Results: batchResults(b.GetOperations(), logs),
+		},
+	}, nil
+}
+
+// protoToBatch validates the batch operations and converts them to the pilorama ones.
+func protoToBatch(ops []*BatchOperation) ([]pilorama.BatchOperation, error) {
+	if len(ops) == 0 {
+		return nil, errEmptyBatch
+	}
+
+	res := make([]pilorama.BatchOperation, len(ops))
+	for i, op := range ops {
+		meta := pilorama.Meta{Items: protoToMeta(op.GetMeta())}
+
+		switch op.GetType() {
+		case BatchOperationType_ADD:
+			res[i].Move = pilorama.Move{
+				Parent: op.GetParentId(),
+				Child:  pilorama.RootID,
+				Meta:   meta,
+			}
+		case BatchOperationType_ADD_BY_PATH:
+			attr := op.GetPathAttribute()
+			if len(attr) == 0 {
+				attr = pilorama.AttributeFilename
+			}
+			res[i].PathAttr = attr
+			res[i].Path = op.GetPath()
+			res[i].Meta = meta
+		case BatchOperationType_REMOVE:
+			if op.GetNodeId() == pilorama.RootID {
+				return nil, fmt.Errorf("operation %d: node with ID %d is root and can't be removed", i, op.GetNodeId())
+			}
+			res[i].Move = pilorama.Move{
+				Parent: pilorama.TrashID,
+				Child:  op.GetNodeId(),
+			}
+		case BatchOperationType_MOVE:
+			if op.GetNodeId() == pilorama.RootID {
+				return nil, fmt.Errorf("operation %d: node with ID %d is root and can't be moved", i, op.GetNodeId())
+			}
+			res[i].Move = pilorama.Move{
+				Parent: op.GetParentId(),
+				Child:  op.GetNodeId(),
+				Meta:   meta,
+			}
+		default:
+			return nil, fmt.Errorf("operation %d: unknown operation type %d", i, op.GetType())
+		}
+	}
+	return res, nil
+}
+
+// batchResults converts the log operations to the batch operation results.
+// Created nodes are listed the same way as in AddResponse and AddByPathResponse.
+func batchResults(ops []*BatchOperation, logs [][]pilorama.Move) []*BatchResult {
+	res := make([]*BatchResult, len(logs))
+	for i := range logs {
+		res[i] = new(BatchResult)
+
+		switch ops[i].GetType() {
+		case BatchOperationType_ADD:
+			res[i].Nodes = []uint64{logs[i][0].Child}
+		case BatchOperationType_ADD_BY_PATH:
+			lm := logs[i]
+			nodes := make([]uint64, len(lm))
+			nodes[0] = lm[len(lm)-1].Child
+			for j, l := range lm[:len(lm)-1] {
+				nodes[j+1] = l.Child
+			}
+			res[i].Nodes = nodes
+		}
+	}
+	return res
+}
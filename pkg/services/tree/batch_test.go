@@ -0,0 +1,71 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+	p := pilorama.NewMemoryForest()
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := protoToBatch(nil)
+		require.ErrorIs(t, err, errEmptyBatch)
+
+		for _, op := range []*BatchOperation{
+			{Type: BatchOperationType_REMOVE, NodeId: pilorama.RootID},
+			{Type: BatchOperationType_MOVE, NodeId: pilorama.RootID, ParentId: 1},
+			{Type: BatchOperationType(42)},
+		} {
+			_, err := protoToBatch([]*BatchOperation{{Type: BatchOperationType_ADD}, op})
+			require.Error(t, err)
+		}
+	})
+
+	meta := []*KeyValue{{Key: pilorama.AttributeFilename, Value: []byte("file")}}
+	ops := []*BatchOperation{
+		{Type: BatchOperationType_ADD_BY_PATH, Path: []string{"dir1", "dir2"}, Meta: meta},
+		{Type: BatchOperationType_ADD, ParentId: pilorama.RootID, Meta: meta},
+	}
+
+	logs := applyBatch(t, p, d, treeID, ops)
+	res := batchResults(ops, logs)
+	require.Equal(t, 2, len(res))
+	require.Equal(t, 3, len(res[0].GetNodes()))
+	require.Equal(t, 1, len(res[1].GetNodes()))
+
+	nodes, err := p.TreeGetByPath(d.CID, treeID, pilorama.AttributeFilename, []string{"dir1", "dir2", "file"}, false)
+	require.NoError(t, err)
+	require.Equal(t, res[0].GetNodes()[:1], nodes)
+
+	dir2 := res[0].GetNodes()[2]
+	ops = []*BatchOperation{
+		{Type: BatchOperationType_MOVE, ParentId: dir2, NodeId: res[1].GetNodes()[0], Meta: meta},
+		{Type: BatchOperationType_REMOVE, NodeId: res[0].GetNodes()[0]},
+	}
+
+	logs = applyBatch(t, p, d, treeID, ops)
+	res = batchResults(ops, logs)
+	require.Equal(t, 2, len(res))
+	require.Empty(t, res[0].GetNodes())
+	require.Empty(t, res[1].GetNodes())
+
+	nodes, err = p.TreeGetByPath(d.CID, treeID, pilorama.AttributeFilename, []string{"dir1", "dir2", "file"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{logs[0][0].Child}, nodes)
+}
+
+func applyBatch(t *testing.T, p pilorama.Forest, d pilorama.CIDDescriptor, treeID string, ops []*BatchOperation) [][]pilorama.Move {
+	bs, err := protoToBatch(ops)
+	require.NoError(t, err)
+
+	logs, err := p.TreeBatch(d, treeID, bs)
+	require.NoError(t, err)
+	require.Equal(t, len(ops), len(logs))
+	return logs
+}
@@ -0,0 +1,159 @@
+package tree
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	netmapSDK "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"go.uber.org/zap"
+)
+
+const snapshotBatchSize = 1000
+
+var errSnapshotChanged = errors.New("tree snapshot has been changed")
+
+// syncAcks stores the heights the other container nodes have synchronized
+// the trees from the local node to. The node requesting the operation log
+// starting from some height has already received all the operations below.
+type syncAcks struct {
+	mtx sync.Mutex
+	// heights maps container, tree ID and node public key to the height.
+	heights map[cidSDK.ID]map[string]map[string]uint64
+}
+
+func (a *syncAcks) init() {
+	a.heights = make(map[cidSDK.ID]map[string]map[string]uint64)
+}
+
+// ack saves the height synchronized by the node with the public key.
+func (a *syncAcks) ack(cid cidSDK.ID, treeID string, key []byte, height uint64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	trees, ok := a.heights[cid]
+	if !ok {
+		trees = make(map[string]map[string]uint64)
+		a.heights[cid] = trees
+	}
+
+	nodes, ok := trees[treeID]
+	if !ok {
+		nodes = make(map[string]uint64)
+		trees[treeID] = nodes
+	}
+
+	if nodes[string(key)] < height {
+		nodes[string(key)] = height
+	}
+}
+
+// minHeight returns the minimum height synchronized by all the nodes.
+// Zero is returned if some node hasn't synchronized the tree yet.
+func (a *syncAcks) minHeight(cid cidSDK.ID, treeID string, nodes []netmapSDK.NodeInfo) uint64 {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	heights := a.heights[cid][treeID]
+
+	var res uint64
+	for i := range nodes {
+		h := heights[string(nodes[i].PublicKey())]
+		if h == 0 {
+			return 0
+		}
+		if i == 0 || h < res {
+			res = h
+		}
+	}
+	return res
+}
+
+// drop removes all the heights for the container.
+func (a *syncAcks) drop(cid cidSDK.ID) {
+	a.mtx.Lock()
+	delete(a.heights, cid)
+	a.mtx.Unlock()
+}
+
+// ackOpLogRequest saves the height of the operation log request
+// if it is signed by a container node.
+func (s *Service) ackOpLogRequest(cid cidSDK.ID, req *GetOpLogRequest, nodes []netmapSDK.NodeInfo) {
+	if verifyMessage(req) != nil {
+		return
+	}
+
+	key := req.GetSignature().GetKey()
+	for i := range nodes {
+		if bytes.Equal(nodes[i].PublicKey(), key) {
+			s.acks.ack(cid, req.GetBody().GetTreeId(), key, req.GetBody().GetHeight())
+			return
+		}
+	}
+}
+
+// compactTree compacts the tree log up to the height synchronized by all the
+// other container nodes from the local one. Height is the height the local node
+// has synchronized the tree from all the other container nodes to, so no new
+// operations can appear below the resulting height.
+func (s *Service) compactTree(cid cidSDK.ID, treeID string, height uint64, nodes []netmapSDK.NodeInfo) {
+	if h := s.acks.minHeight(cid, treeID, nodes); h < height {
+		height = h
+	}
+	if height == 0 {
+		return
+	}
+
+	err := s.forest.TreeCompact(cid, treeID, height)
+	if err != nil {
+		s.log.Warn("could not compact tree log",
+			zap.Stringer("cid", cid),
+			zap.String("tree", treeID),
+			zap.Uint64("height", height),
+			zap.Error(err))
+		return
+	}
+
+	s.log.Debug("tree log is compacted",
+		zap.Stringer("cid", cid),
+		zap.String("tree", treeID),
+		zap.Uint64("height", height))
+}
+
+// getSnapshot sends the tree snapshot if it is above the requested height.
+func (s *Service) getSnapshot(cid cidSDK.ID, b *GetSnapshotRequest_Body, srv TreeService_GetSnapshotServer) error {
+	var height uint64
+	var cursor pilorama.SnapshotCursor
+	for {
+		h, nodes, err := s.forest.TreeGetSnapshot(cid, b.GetTreeId(), height, &cursor, snapshotBatchSize)
+		if err != nil || h <= b.GetHeight() || len(nodes) == 0 {
+			return err
+		}
+		if height == 0 {
+			height = h
+		} else if h != height {
+			return errSnapshotChanged
+		}
+
+		for i := range nodes {
+			err := srv.Send(&GetSnapshotResponse{
+				Body: &GetSnapshotResponse_Body{
+					Height:    height,
+					NodeId:    nodes[i].ID,
+					ParentId:  nodes[i].Parent,
+					Timestamp: nodes[i].Time,
+					Meta:      nodes[i].Meta.Bytes(),
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(nodes) < snapshotBatchSize {
+			return nil
+		}
+	}
+}
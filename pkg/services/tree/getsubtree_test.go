@@ -2,6 +2,7 @@ package tree
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
@@ -118,6 +119,68 @@ func TestGetSubTree(t *testing.T) {
 	})
 }
 
+func TestGetSubTreeOrderBy(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+	p := pilorama.NewMemoryForest()
+
+	ids := make(map[string]uint64)
+	for _, path := range []string{"c", "a", "b", "b/z", "b/x", "b/y", "a/a"} {
+		dir, name := "", path
+		if i := strings.LastIndexByte(path, '/'); i >= 0 {
+			dir, name = path[:i], path[i+1:]
+		}
+
+		var parent []string
+		if dir != "" {
+			parent = strings.Split(dir, "/")
+		}
+		meta := []pilorama.KeyValue{{Key: pilorama.AttributeFilename, Value: []byte(name)}}
+
+		lm, err := p.TreeAddByPath(d, treeID, pilorama.AttributeFilename, parent, meta)
+		require.NoError(t, err)
+		ids[path] = lm[len(lm)-1].Child
+	}
+
+	testGetSubTree := func(t *testing.T, b *GetSubTreeRequest_Body, expected ...string) {
+		b.TreeId = treeID
+		b.OrderAttribute = pilorama.AttributeFilename
+
+		acc := subTreeAcc{errIndex: -1}
+		require.NoError(t, getSubTree(&acc, d.CID, b, p))
+
+		actual := make([]uint64, len(acc.seen))
+		for i := range acc.seen {
+			actual[i] = acc.seen[i].Body.NodeId
+		}
+
+		expectedIDs := []uint64{0}
+		for _, path := range expected {
+			expectedIDs = append(expectedIDs, ids[path])
+		}
+		require.Equal(t, expectedIDs, actual)
+	}
+
+	t.Run("sorted", func(t *testing.T) {
+		testGetSubTree(t, &GetSubTreeRequest_Body{}, "a", "a/a", "b", "b/x", "b/y", "b/z", "c")
+		testGetSubTree(t, &GetSubTreeRequest_Body{Depth: 2}, "a", "b", "c")
+	})
+	t.Run("limit", func(t *testing.T) {
+		testGetSubTree(t, &GetSubTreeRequest_Body{Limit: 4}, "a", "a/a", "b")
+		testGetSubTree(t, &GetSubTreeRequest_Body{Depth: 2, Limit: 3}, "a", "b")
+	})
+	t.Run("cursor", func(t *testing.T) {
+		testGetSubTree(t, &GetSubTreeRequest_Body{Depth: 2, StartAfter: []byte("a"), StartAfterId: ids["a"]}, "b", "c")
+		testGetSubTree(t, &GetSubTreeRequest_Body{StartAfter: []byte("b"), StartAfterId: ids["b"]}, "c")
+		testGetSubTree(t, &GetSubTreeRequest_Body{StartAfter: []byte("a"), StartAfterId: ids["a"], Limit: 3}, "b", "b/x")
+	})
+	t.Run("cursor without order", func(t *testing.T) {
+		acc := subTreeAcc{errIndex: -1}
+		err := getSubTree(&acc, d.CID, &GetSubTreeRequest_Body{TreeId: treeID, StartAfter: []byte("a")}, p)
+		require.ErrorIs(t, err, errCursorWithoutOrder)
+	})
+}
+
 var errSubTreeSend = errors.New("test error")
 
 type subTreeAcc struct {
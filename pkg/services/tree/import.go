@@ -0,0 +1,41 @@
+package tree
+
+import (
+	"io"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+)
+
+// ImportTree applies the tree written by pilorama.ExportTree from r to the forest
+// and notifies the tree watchers about the imported snapshot and operations.
+func (s *Service) ImportTree(r io.Reader, forest pilorama.Forest) (pilorama.ExportInfo, error) {
+	return pilorama.ImportTree(r, notifyingForest{Forest: forest, s: s})
+}
+
+// notifyingForest notifies the tree watchers about the operations
+// and the snapshots applied to the forest.
+type notifyingForest struct {
+	pilorama.Forest
+
+	s *Service
+}
+
+func (f notifyingForest) TreeApply(d pilorama.CIDDescriptor, treeID string, m *pilorama.Move, backgroundSync bool) error {
+	if err := f.Forest.TreeApply(d, treeID, m, backgroundSync); err != nil {
+		return err
+	}
+
+	f.s.watchers.notify(d.CID, treeID, m)
+	return nil
+}
+
+func (f notifyingForest) TreeApplySnapshot(d pilorama.CIDDescriptor, treeID string, height uint64, nodes []pilorama.SnapshotNode, more bool) error {
+	if err := f.Forest.TreeApplySnapshot(d, treeID, height, nodes, more); err != nil {
+		return err
+	}
+
+	if !more {
+		f.s.notifySnapshot(f.Forest, d.CID, treeID, height)
+	}
+	return nil
+}
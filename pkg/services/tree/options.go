@@ -33,6 +33,8 @@ type cfg struct {
 	replicatorWorkerCount     int
 	replicatorTimeout         time.Duration
 	containerCacheSize        int
+	// compactLog enables compaction of the tree operation logs.
+	compactLog bool
 }
 
 // Option represents configuration option for a tree service.
@@ -116,3 +118,11 @@ func WithReplicationTimeout(t time.Duration) Option {
 		}
 	}
 }
+
+// WithLogCompaction enables compaction of the tree operation logs. The log
+// is compacted up to the height synchronized by all the container nodes.
+func WithLogCompaction(enabled bool) Option {
+	return func(c *cfg) {
+		c.compactLog = enabled
+	}
+}
@@ -14,15 +14,17 @@ import (
 	"go.uber.org/zap"
 )
 
+// movePair contains the operations applied to the tree together,
+// they are replicated in order.
 type movePair struct {
 	cid    cidSDK.ID
 	treeID string
-	op     *pilorama.Move
+	ops    []*pilorama.Move
 }
 
 type replicationTask struct {
-	n   netmapSDK.NodeInfo
-	req *ApplyRequest
+	n    netmapSDK.NodeInfo
+	reqs []*ApplyRequest
 }
 
 type applyOp struct {
@@ -47,6 +49,8 @@ func (s *Service) localReplicationWorker() {
 			if err != nil {
 				s.log.Error("failed to apply replicated operation",
 					zap.String("err", err.Error()))
+			} else {
+				s.watchers.notify(op.CID, op.treeID, &op.Move)
 			}
 		}
 	}
@@ -70,11 +74,16 @@ func (s *Service) replicationWorker() {
 					return false
 				}
 
-				ctx, cancel := context.WithTimeout(context.Background(), s.replicatorTimeout)
-				_, lastErr = c.Apply(ctx, task.req)
-				cancel()
+				for _, req := range task.reqs {
+					ctx, cancel := context.WithTimeout(context.Background(), s.replicatorTimeout)
+					_, lastErr = c.Apply(ctx, req)
+					cancel()
 
-				return lastErr == nil
+					if lastErr != nil {
+						return false
+					}
+				}
+				return true
 			})
 
 			if lastErr != nil {
@@ -122,10 +131,13 @@ func (s *Service) replicateLoop(ctx context.Context) {
 }
 
 func (s *Service) replicate(op movePair) error {
-	req := newApplyRequest(&op)
-	err := SignMessage(req, s.key)
-	if err != nil {
-		return fmt.Errorf("can't sign data: %w", err)
+	reqs := make([]*ApplyRequest, len(op.ops))
+	for i := range op.ops {
+		reqs[i] = newApplyRequest(op.cid, op.treeID, op.ops[i])
+		err := SignMessage(reqs[i], s.key)
+		if err != nil {
+			return fmt.Errorf("can't sign data: %w", err)
+		}
 	}
 
 	nodes, localIndex, err := s.getContainerNodes(op.cid)
@@ -135,36 +147,163 @@ func (s *Service) replicate(op movePair) error {
 
 	for i := range nodes {
 		if i != localIndex {
-			s.replicationTasks <- replicationTask{nodes[i], req}
+			s.replicationTasks <- replicationTask{nodes[i], reqs}
 		}
 	}
 	return nil
 }
 
-func (s *Service) pushToQueue(cid cidSDK.ID, treeID string, op *pilorama.Move) {
+func (s *Service) pushToQueue(cid cidSDK.ID, treeID string, ops ...*pilorama.Move) {
 	select {
 	case s.replicateCh <- movePair{
 		cid:    cid,
 		treeID: treeID,
-		op:     op,
+		ops:    ops,
 	}:
 	default:
 	}
 }
 
-func newApplyRequest(op *movePair) *ApplyRequest {
+func newApplyRequest(cid cidSDK.ID, treeID string, op *pilorama.Move) *ApplyRequest {
 	rawCID := make([]byte, sha256.Size)
-	op.cid.Encode(rawCID)
+	cid.Encode(rawCID)
 
 	return &ApplyRequest{
 		Body: &ApplyRequest_Body{
 			ContainerId: rawCID,
-			TreeId:      op.treeID,
+			TreeId:      treeID,
 			Operation: &LogMove{
-				ParentId: op.op.Parent,
-				Meta:     op.op.Meta.Bytes(),
-				ChildId:  op.op.Child,
+				ParentId: op.Parent,
+				Meta:     op.Meta.Bytes(),
+				ChildId:  op.Child,
 			},
 		},
 	}
 }
+
+// ReplicateTree sends the whole operation log of the tree stored in the forest
+// to other container nodes. It succeeds if at least one node has accepted all operations.
+func (s *Service) ReplicateTree(ctx context.Context, cid cidSDK.ID, treeID string, forest pilorama.Forest) error {
+	nodes, localIndex, err := s.getContainerNodes(cid)
+	if err != nil {
+		return fmt.Errorf("can't get container nodes: %w", err)
+	}
+
+	lastErr := errors.New("no remote container nodes")
+	for i := range nodes {
+		if i == localIndex {
+			continue
+		}
+
+		nodes[i].IterateNetworkEndpoints(func(addr string) bool {
+			c, err := s.cache.get(ctx, addr)
+			if err != nil {
+				lastErr = fmt.Errorf("can't create client: %w", err)
+				return false
+			}
+
+			lastErr = s.replicateTreeOpLog(ctx, c, cid, treeID, forest)
+			return lastErr == nil
+		})
+		if lastErr == nil {
+			return nil
+		}
+
+		s.log.Warn("failed to replicate tree to the node",
+			zap.Stringer("cid", cid),
+			zap.String("tree_id", treeID),
+			zap.String("key", hex.EncodeToString(nodes[i].PublicKey())),
+			zap.String("last_error", lastErr.Error()))
+	}
+	return lastErr
+}
+
+func (s *Service) replicateTreeOpLog(ctx context.Context, c TreeServiceClient, cid cidSDK.ID, treeID string, forest pilorama.Forest) error {
+	// The operations below the snapshot height are removed from the log,
+	// so the snapshot is sent first.
+	height, err := s.replicateTreeSnapshot(ctx, c, cid, treeID, forest)
+	if err != nil {
+		return fmt.Errorf("can't replicate tree snapshot: %w", err)
+	}
+
+	for {
+		lm, err := forest.TreeGetOpLog(cid, treeID, height)
+		if err != nil {
+			return err
+		}
+		if lm.Time == 0 {
+			return nil
+		}
+
+		req := newApplyRequest(cid, treeID, &lm)
+		if err := SignMessage(req, s.key); err != nil {
+			return fmt.Errorf("can't sign data: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, s.replicatorTimeout)
+		_, err = c.Apply(ctx, req)
+		cancel()
+		if err != nil {
+			return err
+		}
+
+		height = lm.Time + 1
+	}
+}
+
+// replicateTreeSnapshot sends the tree snapshot stored in the forest to the remote node
+// and returns its height. Zero is returned if the tree log is not compacted.
+func (s *Service) replicateTreeSnapshot(ctx context.Context, c TreeServiceClient, cid cidSDK.ID, treeID string, forest pilorama.Forest) (uint64, error) {
+	height, _, err := forest.TreeGetSnapshot(cid, treeID, 0, nil, 0)
+	if err != nil || height == 0 {
+		return 0, err
+	}
+
+	rawCID := make([]byte, sha256.Size)
+	cid.Encode(rawCID)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cli, err := c.ApplySnapshot(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("can't initialize client: %w", err)
+	}
+
+	var cursor pilorama.SnapshotCursor
+	for {
+		_, nodes, err := forest.TreeGetSnapshot(cid, treeID, height, &cursor, snapshotBatchSize)
+		if err != nil {
+			return 0, err
+		}
+
+		for i := range nodes {
+			req := &ApplySnapshotRequest{
+				Body: &ApplySnapshotRequest_Body{
+					ContainerId: rawCID,
+					TreeId:      treeID,
+					Height:      height,
+					NodeId:      nodes[i].ID,
+					ParentId:    nodes[i].Parent,
+					Timestamp:   nodes[i].Time,
+					Meta:        nodes[i].Meta.Bytes(),
+				},
+			}
+			if err := SignMessage(req, s.key); err != nil {
+				return 0, fmt.Errorf("can't sign data: %w", err)
+			}
+			if err := cli.Send(req); err != nil {
+				return 0, err
+			}
+		}
+
+		if len(nodes) < snapshotBatchSize {
+			break
+		}
+	}
+
+	if _, err := cli.CloseAndRecv(); err != nil {
+		return 0, err
+	}
+	return height, nil
+}
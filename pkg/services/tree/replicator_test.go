@@ -0,0 +1,116 @@
+package tree
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// replicationAcc applies the pushed snapshot and operations to the forest.
+type replicationAcc struct {
+	TreeServiceClient
+
+	forest pilorama.Forest
+	d      pilorama.CIDDescriptor
+	treeID string
+
+	snapshotHeight uint64
+	snapshot       []pilorama.SnapshotNode
+	ops            []*LogMove
+}
+
+func (c *replicationAcc) Apply(_ context.Context, req *ApplyRequest, _ ...grpc.CallOption) (*ApplyResponse, error) {
+	if err := verifyMessage(req); err != nil {
+		return nil, err
+	}
+	c.ops = append(c.ops, req.GetBody().GetOperation())
+	return &ApplyResponse{}, nil
+}
+
+func (c *replicationAcc) ApplySnapshot(context.Context, ...grpc.CallOption) (TreeService_ApplySnapshotClient, error) {
+	return &snapshotAcc{c: c}, nil
+}
+
+type snapshotAcc struct {
+	grpc.ClientStream
+
+	c *replicationAcc
+}
+
+func (s *snapshotAcc) Send(req *ApplySnapshotRequest) error {
+	if err := verifyMessage(req); err != nil {
+		return err
+	}
+
+	b := req.GetBody()
+	n := pilorama.SnapshotNode{ID: b.GetNodeId(), Parent: b.GetParentId(), Time: b.GetTimestamp()}
+	if err := n.Meta.FromBytes(b.GetMeta()); err != nil {
+		return err
+	}
+
+	s.c.snapshotHeight = b.GetHeight()
+	s.c.snapshot = append(s.c.snapshot, n)
+	return nil
+}
+
+func (s *snapshotAcc) CloseAndRecv() (*ApplySnapshotResponse, error) {
+	return &ApplySnapshotResponse{}, s.c.forest.TreeApplySnapshot(s.c.d, s.c.treeID, s.c.snapshotHeight, s.c.snapshot, false)
+}
+
+func TestReplicateTreeOpLog(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	s := &Service{cfg: cfg{key: &pk.PrivateKey, replicatorTimeout: time.Second}}
+
+	src := pilorama.NewMemoryForest()
+
+	var ops []*pilorama.Move
+	for _, name := range []string{"a", "b", "c", "d"} {
+		lm, err := src.TreeMove(d, treeID, &pilorama.Move{
+			Parent: pilorama.RootID,
+			Child:  pilorama.RootID,
+			Meta: pilorama.Meta{Items: []pilorama.KeyValue{
+				{Key: pilorama.AttributeFilename, Value: []byte(name)},
+			}},
+		})
+		require.NoError(t, err)
+		ops = append(ops, lm)
+	}
+
+	height := ops[2].Time
+	require.NoError(t, src.TreeCompact(d.CID, treeID, height))
+
+	c := &replicationAcc{forest: pilorama.NewMemoryForest(), d: d, treeID: treeID}
+	require.NoError(t, s.replicateTreeOpLog(context.Background(), c, d.CID, treeID, src))
+
+	require.Equal(t, height, c.snapshotHeight)
+	require.Len(t, c.snapshot, 2, "nodes below the height must be sent in the snapshot")
+	require.Len(t, c.ops, 2, "operations above the height must be sent after the snapshot")
+	require.Equal(t, ops[2].Child, c.ops[0].GetChildId())
+	require.Equal(t, ops[3].Child, c.ops[1].GetChildId())
+
+	h, _, err := c.forest.TreeGetSnapshot(d.CID, treeID, 0, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, height, h, "snapshot must be applied on the remote node")
+
+	t.Run("not compacted", func(t *testing.T) {
+		src := pilorama.NewMemoryForest()
+		_, err := src.TreeMove(d, treeID, ops[0])
+		require.NoError(t, err)
+
+		c := &replicationAcc{forest: pilorama.NewMemoryForest(), d: d, treeID: treeID}
+		require.NoError(t, s.replicateTreeOpLog(context.Background(), c, d.CID, treeID, src))
+		require.Empty(t, c.snapshot)
+		require.Len(t, c.ops, 1)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sync"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
@@ -37,6 +38,12 @@ type Service struct {
 	cnrMap map[cidSDK.ID]map[string]uint64
 	// cnrMapMtx protects cnrMap
 	cnrMapMtx sync.Mutex
+
+	// acks contains the heights other container nodes have synchronized the trees to.
+	acks syncAcks
+
+	// watchers contains the subscribers for the operations applied to the local trees.
+	watchers treeWatchers
 }
 
 var _ TreeServiceServer = (*Service)(nil)
@@ -64,6 +71,8 @@ func New(opts ...Option) *Service {
 	s.replicationTasks = make(chan replicationTask, s.replicatorWorkerCount)
 	s.containerCache.init(s.containerCacheSize)
 	s.cnrMap = make(map[cidSDK.ID]map[string]uint64)
+	s.acks.init()
+	s.watchers.init()
 	s.syncChan = make(chan struct{})
 	s.syncPool, _ = ants.NewPool(defaultSyncWorkerCount)
 
@@ -130,6 +139,7 @@ func (s *Service) Add(ctx context.Context, req *AddRequest) (*AddResponse, error
 		return nil, err
 	}
 
+	s.watchers.notify(cid, b.GetTreeId(), log)
 	s.pushToQueue(cid, b.GetTreeId(), log)
 	return &AddResponse{
 		Body: &AddResponse_Body{
@@ -182,6 +192,7 @@ func (s *Service) AddByPath(ctx context.Context, req *AddByPathRequest) (*AddByP
 	}
 
 	for i := range logs {
+		s.watchers.notify(cid, b.GetTreeId(), &logs[i])
 		s.pushToQueue(cid, b.GetTreeId(), &logs[i])
 	}
 
@@ -241,6 +252,7 @@ func (s *Service) Remove(ctx context.Context, req *RemoveRequest) (*RemoveRespon
 		return nil, err
 	}
 
+	s.watchers.notify(cid, b.GetTreeId(), log)
 	s.pushToQueue(cid, b.GetTreeId(), log)
 	return new(RemoveResponse), nil
 }
@@ -291,6 +303,7 @@ func (s *Service) Move(ctx context.Context, req *MoveRequest) (*MoveResponse, er
 		return nil, err
 	}
 
+	s.watchers.notify(cid, b.GetTreeId(), log)
 	s.pushToQueue(cid, b.GetTreeId(), log)
 	return new(MoveResponse), nil
 }
@@ -411,13 +424,28 @@ func (s *Service) GetSubTree(req *GetSubTreeRequest, srv TreeService_GetSubTreeS
 	return getSubTree(srv, cid, b, s.forest)
 }
 
+var errCursorWithoutOrder = errors.New("start-after cursor requires order attribute")
+
 func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRequest_Body, forest pilorama.Forest) error {
+	attr := b.GetOrderAttribute()
+
+	var after *pilorama.SortCursor
+	if len(b.GetStartAfter()) != 0 || b.GetStartAfterId() != 0 {
+		if attr == "" {
+			return errCursorWithoutOrder
+		}
+		after = &pilorama.SortCursor{Value: b.GetStartAfter(), ID: b.GetStartAfterId()}
+	}
+
+	limit := int(b.GetLimit())
+	var sent int
+
 	// Traverse the tree in a DFS manner. Because we need to support arbitrary depth,
 	// recursive implementation is not suitable here, so we maintain explicit stack.
 	stack := [][]uint64{{b.GetRootId()}}
 
 	for {
-		if len(stack) == 0 {
+		if len(stack) == 0 || limit != 0 && sent == limit {
 			break
 		} else if len(stack[len(stack)-1]) == 0 {
 			stack = stack[:len(stack)-1]
@@ -442,9 +470,27 @@ func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRe
 		if err != nil {
 			return err
 		}
+		sent++
 
 		if b.GetDepth() == 0 || uint32(len(stack)) < b.GetDepth() {
-			children, err := forest.TreeGetChildren(cid, b.GetTreeId(), nodeID)
+			var children []uint64
+			if attr == "" {
+				children, err = forest.TreeGetChildren(cid, b.GetTreeId(), nodeID)
+			} else {
+				// Nodes which can't be sent because of the limit are not fetched.
+				var count int
+				if limit != 0 {
+					count = limit - sent
+				}
+
+				// The cursor is applied to the children of the root node only,
+				// the deeper levels are not paged.
+				var cursor *pilorama.SortCursor
+				if sent == 1 {
+					cursor = after
+				}
+				children, err = forest.TreeGetSortedChildren(cid, b.GetTreeId(), nodeID, attr, cursor, count)
+			}
 			if err != nil {
 				return err
 			}
@@ -500,6 +546,76 @@ func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, e
 	return &ApplyResponse{Body: &ApplyResponse_Body{}, Signature: &Signature{}}, nil
 }
 
+// ApplySnapshot locally applies the tree snapshot from the remote node.
+func (s *Service) ApplySnapshot(srv TreeService_ApplySnapshotServer) error {
+	var first *ApplySnapshotRequest
+	var d pilorama.CIDDescriptor
+	nodes := make([]pilorama.SnapshotNode, 0, snapshotBatchSize)
+
+	for {
+		req, err := srv.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if err := verifyMessage(req); err != nil {
+			return err
+		}
+
+		b := req.GetBody()
+		if first == nil {
+			if err := d.CID.Decode(b.GetContainerId()); err != nil {
+				return err
+			}
+
+			_, pos, size, err := s.getContainerInfo(d.CID, req.GetSignature().GetKey())
+			if err != nil {
+				return err
+			}
+			if pos < 0 {
+				return errors.New("`ApplySnapshot` request must be signed by a container node")
+			}
+
+			first = req
+			d.Position, d.Size = pos, size
+		} else if !bytes.Equal(b.GetContainerId(), first.GetBody().GetContainerId()) ||
+			b.GetTreeId() != first.GetBody().GetTreeId() ||
+			b.GetHeight() != first.GetBody().GetHeight() ||
+			!bytes.Equal(req.GetSignature().GetKey(), first.GetSignature().GetKey()) {
+			return errors.New("`ApplySnapshot` requests must be sent for the same snapshot by the same node")
+		}
+
+		n := pilorama.SnapshotNode{
+			ID:     b.GetNodeId(),
+			Parent: b.GetParentId(),
+			Time:   b.GetTimestamp(),
+		}
+		if err := n.Meta.FromBytes(b.GetMeta()); err != nil {
+			return fmt.Errorf("can't parse meta-information: %w", err)
+		}
+		nodes = append(nodes, n)
+
+		if len(nodes) == snapshotBatchSize {
+			err := s.forest.TreeApplySnapshot(d, b.GetTreeId(), b.GetHeight(), nodes, true)
+			if err != nil {
+				return fmt.Errorf("can't apply snapshot: %w", err)
+			}
+			nodes = nodes[:0]
+		}
+	}
+
+	if first != nil {
+		b := first.GetBody()
+		if err := s.forest.TreeApplySnapshot(d, b.GetTreeId(), b.GetHeight(), nodes, false); err != nil {
+			return fmt.Errorf("can't apply snapshot: %w", err)
+		}
+		s.notifySnapshot(s.forest, d.CID, b.GetTreeId(), b.GetHeight())
+	}
+	return srv.SendAndClose(&ApplySnapshotResponse{Body: &ApplySnapshotResponse_Body{}, Signature: &Signature{}})
+}
+
 func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer) error {
 	b := req.GetBody()
 
@@ -532,6 +648,10 @@ func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer)
 		return nil
 	}
 
+	if s.compactLog {
+		s.ackOpLogRequest(cid, req, ns)
+	}
+
 	h := b.GetHeight()
 	for {
 		lm, err := s.forest.TreeGetOpLog(cid, b.GetTreeId(), h)
@@ -556,6 +676,45 @@ func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer)
 	}
 }
 
+func (s *Service) GetSnapshot(req *GetSnapshotRequest, srv TreeService_GetSnapshotServer) error {
+	var cid cidSDK.ID
+	if err := cid.Decode(req.GetBody().GetContainerId()); err != nil {
+		return err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return err
+	}
+	if pos < 0 {
+		var cli TreeService_GetSnapshotClient
+		var outErr error
+		err := s.forEachNode(srv.Context(), ns, func(c TreeServiceClient) bool {
+			cli, outErr = c.GetSnapshot(srv.Context(), req)
+			return true
+		})
+		if err != nil {
+			return err
+		} else if outErr != nil {
+			return outErr
+		}
+		for {
+			resp, err := cli.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			if err := srv.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.getSnapshot(cid, req.GetBody(), srv)
+}
+
 func (s *Service) TreeList(ctx context.Context, req *TreeListRequest) (*TreeListResponse, error) {
 	var cid cidSDK.ID
 
@@ -105,6 +105,10 @@ func (s *Service) synchronizeAllTrees(ctx context.Context, cid cid.ID) error {
 		if syncStatus[tid] < h {
 			syncStatus[tid] = h
 		}
+
+		if s.compactLog {
+			s.compactTree(cid, tid, syncStatus[tid], nodes)
+		}
 	}
 
 	s.cnrMapMtx.Lock()
@@ -163,6 +167,19 @@ func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor,
 			defer cc.Close()
 
 			treeClient := NewTreeServiceClient(cc)
+
+			h, err := s.synchronizeSnapshot(ctx, d, treeID, height, treeClient)
+			if err != nil {
+				s.log.Debug("could not synchronize tree snapshot",
+					zap.Stringer("cid", d.CID),
+					zap.String("tree", treeID),
+					zap.String("address", addr),
+					zap.Error(err))
+			}
+			if height < h {
+				height = h
+			}
+
 			for {
 				h, err := s.synchronizeSingle(ctx, d, treeID, height, treeClient)
 				if height < h {
@@ -221,6 +238,7 @@ func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescripto
 			if err := s.forest.TreeApply(d, treeID, m, true); err != nil {
 				return newHeight, err
 			}
+			s.watchers.notify(d.CID, treeID, m)
 			if m.Time > newHeight {
 				newHeight = m.Time + 1
 			} else {
@@ -234,6 +252,86 @@ func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescripto
 	}
 }
 
+// synchronizeSnapshot applies the tree snapshot if the node has compacted
+// the tree log above the height. Returns the height the tree is synchronized to.
+func (s *Service) synchronizeSnapshot(ctx context.Context, d pilorama.CIDDescriptor, treeID string, height uint64, treeClient TreeServiceClient) (uint64, error) {
+	// The log is compacted only when all the operations below are received.
+	localHeight, _, err := s.forest.TreeGetSnapshot(d.CID, treeID, 0, nil, 0)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return height, err
+	}
+	if height < localHeight {
+		height = localHeight
+	}
+
+	rawCID := make([]byte, sha256.Size)
+	d.CID.Encode(rawCID)
+
+	req := &GetSnapshotRequest{
+		Body: &GetSnapshotRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+			Height:      height,
+		},
+	}
+	if err := SignMessage(req, s.key); err != nil {
+		return height, err
+	}
+
+	c, err := treeClient.GetSnapshot(ctx, req)
+	if err != nil {
+		return height, fmt.Errorf("can't initialize client: %w", err)
+	}
+
+	var snapshotHeight uint64
+	nodes := make([]pilorama.SnapshotNode, 0, snapshotBatchSize)
+
+	res, err := c.Recv()
+	for ; err == nil; res, err = c.Recv() {
+		b := res.GetBody()
+		if snapshotHeight == 0 {
+			snapshotHeight = b.GetHeight()
+		} else if snapshotHeight != b.GetHeight() {
+			return height, errSnapshotChanged
+		}
+
+		n := pilorama.SnapshotNode{
+			ID:     b.GetNodeId(),
+			Parent: b.GetParentId(),
+			Time:   b.GetTimestamp(),
+		}
+		if err := n.Meta.FromBytes(b.GetMeta()); err != nil {
+			return height, err
+		}
+		nodes = append(nodes, n)
+
+		if len(nodes) == snapshotBatchSize && snapshotHeight > height {
+			if err := s.forest.TreeApplySnapshot(d, treeID, snapshotHeight, nodes, true); err != nil {
+				return height, fmt.Errorf("can't apply snapshot: %w", err)
+			}
+			nodes = nodes[:0]
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		return height, err
+	}
+	if snapshotHeight <= height {
+		return height, nil
+	}
+
+	if err := s.forest.TreeApplySnapshot(d, treeID, snapshotHeight, nodes, false); err != nil {
+		return height, fmt.Errorf("can't apply snapshot: %w", err)
+	}
+	s.notifySnapshot(s.forest, d.CID, treeID, snapshotHeight)
+
+	s.log.Debug("tree snapshot is applied",
+		zap.Stringer("cid", d.CID),
+		zap.String("tree", treeID),
+		zap.Uint64("height", snapshotHeight))
+
+	return snapshotHeight, nil
+}
+
 // ErrAlreadySyncing is returned when a service synchronization has already
 // been started.
 var ErrAlreadySyncing = errors.New("service is being synchronized")
@@ -342,6 +440,10 @@ func (s *Service) syncLoop(ctx context.Context) {
 			}
 			s.cnrMapMtx.Unlock()
 
+			for i := range removed {
+				s.acks.drop(removed[i])
+			}
+
 			for _, cnr := range removed {
 				s.log.Debug("removing redundant trees...", zap.Stringer("cid", cnr))
 
@@ -0,0 +1,254 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"go.uber.org/zap"
+)
+
+// watcherCapacity is the number of the applied operations buffered
+// for a single watcher.
+const watcherCapacity = 1024
+
+var errWatcherOverflow = errors.New("watcher is too slow, some operations were dropped")
+
+var errWatchBelowSnapshot = errors.New("requested height is below the tree snapshot height, the snapshot must be fetched with GetSnapshot")
+
+// watcher receives the operations applied to a single tree.
+type watcher struct {
+	ch       chan pilorama.Move
+	overflow chan struct{}
+	once     sync.Once
+}
+
+// treeWatchers contains the watchers of the local trees.
+type treeWatchers struct {
+	mtx sync.RWMutex
+	// m maps container and tree ID to the set of the tree watchers.
+	m map[cidSDK.ID]map[string]map[*watcher]struct{}
+}
+
+func (t *treeWatchers) init() {
+	t.m = make(map[cidSDK.ID]map[string]map[*watcher]struct{})
+}
+
+func (t *treeWatchers) subscribe(cid cidSDK.ID, treeID string) *watcher {
+	w := &watcher{
+		ch:       make(chan pilorama.Move, watcherCapacity),
+		overflow: make(chan struct{}),
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	trees, ok := t.m[cid]
+	if !ok {
+		trees = make(map[string]map[*watcher]struct{})
+		t.m[cid] = trees
+	}
+
+	ws, ok := trees[treeID]
+	if !ok {
+		ws = make(map[*watcher]struct{})
+		trees[treeID] = ws
+	}
+
+	ws[w] = struct{}{}
+	return w
+}
+
+func (t *treeWatchers) unsubscribe(cid cidSDK.ID, treeID string, w *watcher) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	trees := t.m[cid]
+	delete(trees[treeID], w)
+	if len(trees[treeID]) == 0 {
+		delete(trees, treeID)
+	}
+	if len(trees) == 0 {
+		delete(t.m, cid)
+	}
+}
+
+// watched checks whether the tree has any watchers.
+func (t *treeWatchers) watched(cid cidSDK.ID, treeID string) bool {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return len(t.m[cid][treeID]) != 0
+}
+
+// notify sends the applied operation to all watchers of the tree.
+// Watchers which can't keep up are notified about the overflow.
+func (t *treeWatchers) notify(cid cidSDK.ID, treeID string, m *pilorama.Move) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	for w := range t.m[cid][treeID] {
+		select {
+		case w.ch <- *m:
+		default:
+			w.once.Do(func() { close(w.overflow) })
+		}
+	}
+}
+
+func (s *Service) Watch(req *WatchRequest, srv TreeService_WatchServer) error {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return err
+	}
+
+	err := s.verifyClient(req, cid, b.GetBearerToken(), acl.OpObjectGet)
+	if err != nil {
+		return err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return err
+	}
+	if pos < 0 {
+		var cli TreeService_WatchClient
+		var outErr error
+		err = s.forEachNode(srv.Context(), ns, func(c TreeServiceClient) bool {
+			cli, outErr = c.Watch(srv.Context(), req)
+			return true
+		})
+		if err != nil {
+			return err
+		} else if outErr != nil {
+			return outErr
+		}
+		for {
+			resp, err := cli.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			if err := srv.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.watch(srv, cid, b)
+}
+
+func (s *Service) watch(srv TreeService_WatchServer, cid cidSDK.ID, b *WatchRequest_Body) error {
+	// Subscribe before reading the log, so that no operation applied
+	// in between is lost.
+	w := s.watchers.subscribe(cid, b.GetTreeId())
+	defer s.watchers.unsubscribe(cid, b.GetTreeId(), w)
+
+	// The operations below the snapshot height are removed from the log.
+	snapshotHeight, _, err := s.forest.TreeGetSnapshot(cid, b.GetTreeId(), 0, nil, 0)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return err
+	}
+	if b.GetHeight() < snapshotHeight {
+		return fmt.Errorf("%w: requested %d, snapshot %d", errWatchBelowSnapshot, b.GetHeight(), snapshotHeight)
+	}
+
+	// The operations applied after the subscription can be read from
+	// the log, they are sent only once.
+	sent := make(map[pilorama.Timestamp]struct{})
+
+	h := b.GetHeight()
+	for {
+		lm, err := s.forest.TreeGetOpLog(cid, b.GetTreeId(), h)
+		if err != nil {
+			return err
+		}
+		if lm.Time == 0 {
+			break
+		}
+
+		if err := sendWatchResponse(srv, &lm); err != nil {
+			return err
+		}
+
+		sent[lm.Time] = struct{}{}
+		h = lm.Time + 1
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return srv.Context().Err()
+		case <-s.closeCh:
+			return nil
+		case <-w.overflow:
+			return errWatcherOverflow
+		case m := <-w.ch:
+			if _, ok := sent[m.Time]; ok {
+				delete(sent, m.Time)
+				continue
+			}
+			if err := sendWatchResponse(srv, &m); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// notifySnapshot sends the nodes of the tree snapshot applied to the forest
+// to the tree watchers as the operations moving the nodes to their state
+// at the snapshot height.
+func (s *Service) notifySnapshot(forest pilorama.Forest, cid cidSDK.ID, treeID string, height uint64) {
+	if !s.watchers.watched(cid, treeID) {
+		return
+	}
+
+	// The snapshot is not applied if the tree is already compacted above.
+	if h, _, err := forest.TreeGetSnapshot(cid, treeID, 0, nil, 0); err != nil || h != height {
+		return
+	}
+
+	var cursor pilorama.SnapshotCursor
+	for {
+		_, nodes, err := forest.TreeGetSnapshot(cid, treeID, height, &cursor, snapshotBatchSize)
+		if err != nil {
+			s.log.Warn("could not notify tree watchers about the snapshot",
+				zap.Stringer("cid", cid),
+				zap.String("tree", treeID),
+				zap.Uint64("height", height),
+				zap.Error(err))
+			return
+		}
+
+		for i := range nodes {
+			s.watchers.notify(cid, treeID, &pilorama.Move{
+				Parent: nodes[i].Parent,
+				Child:  nodes[i].ID,
+				Meta:   nodes[i].Meta,
+			})
+		}
+
+		if len(nodes) < snapshotBatchSize {
+			return
+		}
+	}
+}
+
+func sendWatchResponse(srv TreeService_WatchServer, m *pilorama.Move) error {
+	return srv.Send(&WatchResponse{
+		Body: &WatchResponse_Body{
+			Timestamp: m.Time,
+			ParentId:  m.Parent,
+			ChildId:   m.Child,
+			Meta:      metaToProto(m.Meta.Items),
+		},
+	})
+}
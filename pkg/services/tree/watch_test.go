@@ -0,0 +1,190 @@
+package tree
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestWatch(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+
+	s := &Service{cfg: cfg{forest: pilorama.NewMemoryForest()}, closeCh: make(chan struct{})}
+	s.watchers.init()
+
+	addNode := func(name string) *pilorama.Move {
+		lm, err := s.forest.TreeMove(d, treeID, &pilorama.Move{
+			Parent: pilorama.RootID,
+			Child:  pilorama.RootID,
+			Meta: pilorama.Meta{Items: []pilorama.KeyValue{
+				{Key: pilorama.AttributeFilename, Value: []byte(name)},
+			}},
+		})
+		require.NoError(t, err)
+		return lm
+	}
+
+	first := addNode("a")
+	second := addNode("b")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := &watchAcc{ctx: ctx, ch: make(chan *WatchResponse)}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.watch(srv, d.CID, &WatchRequest_Body{TreeId: treeID, Height: second.Time})
+	}()
+
+	requireOp := func(expected *pilorama.Move) {
+		select {
+		case resp := <-srv.ch:
+			require.Equal(t, expected.Time, resp.GetBody().GetTimestamp())
+			require.Equal(t, expected.Parent, resp.GetBody().GetParentId())
+			require.Equal(t, expected.Child, resp.GetBody().GetChildId())
+			require.Equal(t, metaToProto(expected.Meta.Items), resp.GetBody().GetMeta())
+		case <-time.After(time.Second):
+			t.Fatal("operation was not sent")
+		}
+	}
+
+	// Operations below the height are not sent.
+	// The watcher is subscribed before the log is read.
+	requireOp(second)
+
+	// Operations sent while reading the log are not sent twice.
+	s.watchers.notify(d.CID, treeID, second)
+
+	// Memory forest is not thread-safe, so only notify about new operations.
+	third := &pilorama.Move{Parent: second.Child, Child: first.Child, Meta: pilorama.Meta{Time: second.Time + 1}}
+	s.watchers.notify(d.CID, treeID, third)
+	requireOp(third)
+
+	// Late operations are sent even if they are below the height.
+	s.watchers.notify(d.CID, treeID, first)
+	requireOp(first)
+	fourth := &pilorama.Move{Parent: pilorama.TrashID, Child: first.Child, Meta: pilorama.Meta{Time: second.Time + 2}}
+	s.watchers.notify(d.CID, treeID, fourth)
+	requireOp(fourth)
+
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	require.Empty(t, s.watchers.m)
+}
+
+func TestWatchBelowSnapshot(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+
+	s := &Service{cfg: cfg{forest: pilorama.NewMemoryForest()}, closeCh: make(chan struct{})}
+	s.watchers.init()
+
+	var last *pilorama.Move
+	for i := 0; i < 3; i++ {
+		var err error
+		last, err = s.forest.TreeMove(d, treeID, &pilorama.Move{Parent: pilorama.RootID, Child: pilorama.RootID})
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.forest.TreeCompact(d.CID, treeID, last.Time))
+
+	srv := &watchAcc{ctx: context.Background(), ch: make(chan *WatchResponse)}
+	err := s.watch(srv, d.CID, &WatchRequest_Body{TreeId: treeID, Height: last.Time - 1})
+	require.ErrorIs(t, err, errWatchBelowSnapshot)
+	require.Empty(t, s.watchers.m)
+}
+
+func TestWatchImport(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+
+	src := pilorama.NewMemoryForest()
+
+	var ops []*pilorama.Move
+	for i := 0; i < 3; i++ {
+		lm, err := src.TreeMove(d, treeID, &pilorama.Move{Parent: pilorama.RootID, Child: pilorama.RootID})
+		require.NoError(t, err)
+		ops = append(ops, lm)
+	}
+	require.NoError(t, src.TreeCompact(d.CID, treeID, ops[2].Time))
+
+	var buf bytes.Buffer
+	_, err := pilorama.ExportTree(&buf, src, d.CID, treeID, true)
+	require.NoError(t, err)
+
+	s := &Service{cfg: cfg{log: test.NewLogger(false)}}
+	s.watchers.init()
+
+	w := s.watchers.subscribe(d.CID, treeID)
+
+	_, err = s.ImportTree(&buf, pilorama.NewMemoryForest())
+	require.NoError(t, err)
+
+	// The snapshot nodes are sent after the snapshot is applied, then the operations.
+	var children []pilorama.Node
+	for i := 0; i < 3; i++ {
+		select {
+		case m := <-w.ch:
+			children = append(children, m.Child)
+		default:
+			t.Fatal("imported node was not sent")
+		}
+	}
+	require.ElementsMatch(t, []pilorama.Node{ops[0].Child, ops[1].Child}, children[:2])
+	require.Equal(t, ops[2].Child, children[2])
+}
+
+func TestWatchOverflow(t *testing.T) {
+	var ws treeWatchers
+	ws.init()
+
+	cid := cidtest.ID()
+	w := ws.subscribe(cid, "sometree")
+
+	for i := 0; i < watcherCapacity; i++ {
+		ws.notify(cid, "sometree", &pilorama.Move{})
+	}
+
+	select {
+	case <-w.overflow:
+		t.Fatal("watcher must not overflow")
+	default:
+	}
+
+	ws.notify(cid, "sometree", &pilorama.Move{})
+	ws.notify(cid, "sometree", &pilorama.Move{})
+
+	select {
+	case <-w.overflow:
+	default:
+		t.Fatal("watcher must overflow")
+	}
+
+	ws.unsubscribe(cid, "sometree", w)
+	require.Empty(t, ws.m)
+}
+
+type watchAcc struct {
+	grpc.ServerStream // to satisfy the interface
+	ctx               context.Context
+	ch                chan *WatchResponse
+}
+
+func (s *watchAcc) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchAcc) Send(r *WatchResponse) error {
+	select {
+	case s.ch <- r:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
@@ -0,0 +1,31 @@
+package tracing
+
+// Exporter is a type of the spans exporter.
+type Exporter string
+
+const (
+	// OTLPgRPCExporter sends spans to the OpenTelemetry collector
+	// (e.g. Jaeger) via OTLP over gRPC.
+	OTLPgRPCExporter Exporter = "otlp_grpc"
+	// FileExporter writes spans to the local file in JSON format,
+	// one span per line.
+	FileExporter Exporter = "file"
+)
+
+// Config groups tracing parameters.
+type Config struct {
+	// Enabled turns tracing on. If tracing is disabled, spans
+	// are created by no-op tracer and are not exported.
+	Enabled bool
+	// Exporter is a type of the spans exporter.
+	Exporter Exporter
+	// Endpoint is an address of the OTLP collector for OTLPgRPCExporter
+	// or a path to the output file for FileExporter.
+	Endpoint string
+	// Service is a name of the traced service.
+	Service string
+	// InstanceID identifies the service instance, e.g. node public key.
+	InstanceID string
+	// Version is a version of the traced service.
+	Version string
+}
@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// fileExporter writes finished spans to the file in JSON format,
+// one span per line.
+type fileExporter struct {
+	mtx sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+type fileSpan struct {
+	TraceID    string            `json:"trace_id"`
+	SpanID     string            `json:"span_id"`
+	ParentID   string            `json:"parent_span_id,omitempty"`
+	Name       string            `json:"name"`
+	Kind       string            `json:"kind"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Status     string            `json:"status,omitempty"`
+	Message    string            `json:"status_message,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+func newFileExporter(path string) (*fileExporter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("could not open tracing output file: %w", err)
+	}
+
+	return &fileExporter{
+		f:   f,
+		enc: json.NewEncoder(f),
+	}, nil
+}
+
+// ExportSpans implements sdktrace.SpanExporter.
+func (e *fileExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for i := range spans {
+		s := fileSpan{
+			TraceID: spans[i].SpanContext().TraceID().String(),
+			SpanID:  spans[i].SpanContext().SpanID().String(),
+			Name:    spans[i].Name(),
+			Kind:    spans[i].SpanKind().String(),
+			Start:   spans[i].StartTime(),
+			End:     spans[i].EndTime(),
+			Message: spans[i].Status().Description,
+		}
+
+		if parent := spans[i].Parent(); parent.IsValid() {
+			s.ParentID = parent.SpanID().String()
+		}
+
+		if code := spans[i].Status().Code; code != 0 {
+			s.Status = code.String()
+		}
+
+		if attrs := spans[i].Attributes(); len(attrs) > 0 {
+			s.Attributes = make(map[string]string, len(attrs))
+			for _, a := range attrs {
+				s.Attributes[string(a.Key)] = a.Value.Emit()
+			}
+		}
+
+		if err := e.enc.Encode(s); err != nil {
+			return fmt.Errorf("could not write span: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *fileExporter) Shutdown(context.Context) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	return e.f.Close()
+}
@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataCarrier adapts gRPC metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	vs := metadata.MD(c).Get(key)
+	if len(vs) == 0 {
+		return ""
+	}
+
+	return vs[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	res := make([]string, 0, len(c))
+	for k := range c {
+		res = append(res, k)
+	}
+
+	return res
+}
+
+// InjectIntoOutgoingContext returns a copy of the context with trace context
+// of the current span attached to the outgoing gRPC metadata.
+func InjectIntoOutgoingContext(ctx context.Context) context.Context {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// extractFromIncomingContext returns a copy of the context with trace context
+// from the incoming gRPC metadata.
+func extractFromIncomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// NewUnaryServerInterceptor returns gRPC interceptor starting the server span
+// for every unary call. Span is a child of the remote span if the trace context
+// is passed in the request metadata.
+func NewUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := StartSpanFromContext(extractFromIncomingContext(ctx), info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer))
+
+		resp, err := handler(ctx, req)
+
+		Finish(span, err)
+
+		return resp, err
+	}
+}
+
+// NewStreamServerInterceptor returns gRPC interceptor starting the server span
+// for every streaming call. Span is a child of the remote span if the trace
+// context is passed in the request metadata.
+func NewStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := StartSpanFromContext(extractFromIncomingContext(ss.Context()), info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer))
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+		Finish(span, err)
+
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
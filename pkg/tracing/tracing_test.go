@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestSetup(t *testing.T) {
+	t.Run("unknown exporter", func(t *testing.T) {
+		_, err := Setup(context.Background(), Config{
+			Enabled:  true,
+			Exporter: "unknown",
+		})
+		require.ErrorIs(t, err, errUnknownExporter)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		shutdown, err := Setup(context.Background(), Config{})
+		require.NoError(t, err)
+		require.NoError(t, shutdown(context.Background()))
+
+		_, span := StartSpanFromContext(context.Background(), "test")
+		require.False(t, span.SpanContext().IsValid())
+	})
+}
+
+func TestFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+
+	shutdown, err := Setup(context.Background(), Config{
+		Enabled:  true,
+		Exporter: FileExporter,
+		Endpoint: path,
+		Service:  "test",
+	})
+	require.NoError(t, err)
+
+	ctx, parent := StartSpanFromContext(context.Background(), "parent")
+	_, child := StartSpanFromContext(ctx, "child")
+	Finish(child, errors.New("some error"))
+	parent.End()
+
+	require.NoError(t, shutdown(context.Background()))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	spans := make(map[string]fileSpan)
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var span fileSpan
+		require.NoError(t, json.Unmarshal(s.Bytes(), &span))
+
+		spans[span.Name] = span
+	}
+	require.NoError(t, s.Err())
+	require.Len(t, spans, 2)
+
+	require.Equal(t, spans["parent"].TraceID, spans["child"].TraceID)
+	require.Equal(t, spans["parent"].SpanID, spans["child"].ParentID)
+	require.Empty(t, spans["parent"].ParentID)
+	require.Empty(t, spans["parent"].Status)
+	require.Equal(t, "Error", spans["child"].Status)
+	require.Equal(t, "some error", spans["child"].Message)
+}
+
+func TestPropagation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+
+	shutdown, err := Setup(context.Background(), Config{
+		Enabled:  true,
+		Exporter: FileExporter,
+		Endpoint: path,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, shutdown(context.Background())) })
+
+	t.Run("no span", func(t *testing.T) {
+		ctx := InjectIntoOutgoingContext(context.Background())
+
+		_, ok := metadata.FromOutgoingContext(ctx)
+		require.False(t, ok)
+	})
+
+	ctx, span := StartSpanFromContext(context.Background(), "client")
+	defer span.End()
+
+	md, ok := metadata.FromOutgoingContext(InjectIntoOutgoingContext(ctx))
+	require.True(t, ok)
+
+	srvCtx := extractFromIncomingContext(metadata.NewIncomingContext(context.Background(), md))
+
+	remote := trace.SpanContextFromContext(srvCtx)
+	require.True(t, remote.IsRemote())
+	require.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+}